@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Load the custom compliance rules file that pairs with an existing discovery script
+	rules, err := intune.ParseDeviceComplianceScriptRulesFile("../../device_compliance_scripts/Custom-Compliance-Scripts/Check-AvEnabled/Check-AvEnabled.json")
+	if err != nil {
+		log.Fatalf("Invalid compliance rules file: %v", err)
+	}
+
+	rulesContent, err := rules.EncodeRulesContent()
+	if err != nil {
+		log.Fatalf("Failed to encode compliance rules: %v", err)
+	}
+
+	// Construct the request body
+	requestBody := &intune.ResourceDeviceCompliancePolicy{
+		ODataType:                intune.ODataTypeWindows10CompliancePolicy,
+		DisplayName:              "Windows 10 - Baseline Compliance",
+		Description:              "Baseline compliance for corporate Windows devices",
+		RoleScopeTagIds:          []string{"0"},
+		OsMinimumVersion:         "10.0.19045",
+		BitLockerEnabled:         intune.Bool(true),
+		SecureBootEnabled:        intune.Bool(true),
+		CodeIntegrityEnabled:     intune.Bool(true),
+		StorageRequireEncryption: intune.Bool(true),
+		ActiveFirewallRequired:   intune.Bool(true),
+		DefenderEnabled:          intune.Bool(true),
+		RtpEnabled:               intune.Bool(true),
+		AntivirusRequired:        intune.Bool(true),
+		AntiSpywareRequired:      intune.Bool(true),
+		TpmRequired:              intune.Bool(true),
+		DeviceCompliancePolicyScript: &intune.DeviceCompliancePolicyScript{
+			DeviceComplianceScriptId: "0b8d5e6b-1c1e-4c79-9d38-4f43c1d2a9e4",
+			RulesContent:             rulesContent,
+		},
+		// Block immediately, notify after 1 day and retire after 30 days
+		ScheduledActionsForRule: []intune.DeviceComplianceScheduledActionForRule{
+			{
+				ScheduledActionConfigurations: []intune.DeviceComplianceActionItem{
+					intune.NewDeviceComplianceActionItem(intune.DeviceComplianceActionTypeBlock, 0, ""),
+					intune.NewDeviceComplianceActionItem(intune.DeviceComplianceActionTypeNotification, 1, "b4d4f5e6-7a8b-4c9d-8e0f-1a2b3c4d5e6f"),
+					intune.NewDeviceComplianceActionItem(intune.DeviceComplianceActionTypeRetire, 30, ""),
+				},
+			},
+		},
+	}
+
+	// Create the new policy
+	createdPolicy, err := client.CreateDeviceCompliancePolicy(requestBody)
+	if err != nil {
+		log.Fatalf("Failed to create device compliance policy: %v", err)
+	}
+
+	// Pretty print the device compliance policy
+	jsonData, err := json.MarshalIndent(createdPolicy, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal device compliance policy: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
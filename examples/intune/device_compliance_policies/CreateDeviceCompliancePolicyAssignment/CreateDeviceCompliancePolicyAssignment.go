@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyID := "2b3c4d5e-6f70-4812-9a3b-4c5d6e7f8091"
+
+	// Assign to a group, excluding a second group
+	assignment := &intune.AssignmentDeviceCompliancePolicy{
+		Assignments: []intune.DeviceCompliancePolicyAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetExclusionGroup,
+					GroupID:   "b8c3b7b3-2f0c-4a3e-9d2b-0c0b2d7b6f1a",
+				},
+			},
+		},
+	}
+
+	response, err := client.CreateDeviceCompliancePolicyAssignment(policyID, assignment)
+	if err != nil {
+		log.Fatalf("Failed to assign device compliance policy: %v", err)
+	}
+
+	// Pretty print the response
+	jsonData, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal response: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyID := "2b3c4d5e-6f70-4812-9a3b-4c5d6e7f8091"
+
+	// Get the device compliance policy with its actions for noncompliance and assignments
+	policy, err := client.GetDeviceCompliancePolicyByID(policyID)
+	if err != nil {
+		log.Fatalf("Failed to get device compliance policy: %v", err)
+	}
+
+	// Pretty print the device compliance policy
+	jsonData, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal device compliance policy: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyID := "2b3c4d5e-6f70-4812-9a3b-4c5d6e7f8091"
+
+	overview, err := client.GetDeviceCompliancePolicyDeviceStatusOverview(policyID)
+	if err != nil {
+		log.Fatalf("Failed to get device status overview: %v", err)
+	}
+
+	fmt.Printf("Compliant: %d, Non-compliant: %d, Error: %d, Pending: %d, Not applicable: %d\n",
+		overview.SuccessCount, overview.FailedCount, overview.ErrorCount, overview.PendingCount, overview.NotApplicableCount)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the paths to the discovery script and its JSON rules file
+	scriptFilePath := "../Custom-Compliance-Scripts/Check-AvEnabled/Check-AvEnabled.ps1"
+	rulesFilePath := "../Custom-Compliance-Scripts/Check-AvEnabled/Check-AvEnabled.json"
+
+	// Parse and validate the rules file against the custom compliance schema
+	rules, err := intune.ParseDeviceComplianceScriptRulesFile(rulesFilePath)
+	if err != nil {
+		log.Fatalf("Invalid compliance rules file: %v", err)
+	}
+
+	scriptContent, err := os.ReadFile(scriptFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read discovery script: %v", err)
+	}
+
+	// List the settings emitted by the discovery script
+	fmt.Println("Settings emitted by discovery script:")
+	for _, name := range intune.ExtractDeviceComplianceScriptOutputSettingNames(string(scriptContent)) {
+		fmt.Printf("  - %s\n", name)
+	}
+
+	// Check every rule references a setting the script emits
+	if err := intune.ValidateDeviceComplianceScriptRulesAgainstScript(rules, string(scriptContent)); err != nil {
+		log.Fatalf("Compliance rules do not match discovery script: %v", err)
+	}
+
+	fmt.Printf("All %d compliance rules match the discovery script output\n", len(rules.Rules))
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/utils"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Base64 encode the script file
+	scriptContent, err := utils.Base64Encode("/Users/dafyddwatkins/localtesting/scripts/Get-FileVaultStatus.sh")
+	if err != nil {
+		log.Fatalf("Failed to encode script content: %v", err)
+	}
+
+	newScriptDetails := intune.ResourceDeviceCustomAttributeShellScript{
+		CustomAttributeName: "FileVaultStatus",
+		CustomAttributeType: intune.CustomAttributeTypeString,
+		DisplayName:         "intune SDK macOS custom attribute creation test",
+		Description:         "Reports the FileVault status of the device",
+		ScriptContent:       scriptContent,
+		RunAsAccount:        "system",
+		FileName:            "Get-FileVaultStatus.sh",
+		RoleScopeTagIds:     []string{"0"},
+	}
+
+	// Assign the script to a group
+	assignment := intune.AssignmentDeviceManagementScript{
+		ResourceDeviceManagementScriptGroupAssignments: []intune.ResourceDeviceManagementScriptGroupAssignment{
+			{
+				TargetGroupID: "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+			},
+		},
+	}
+
+	newScript, err := client.CreateDeviceCustomAttributeShellScriptWithAssignment(&newScriptDetails, &assignment)
+	if err != nil {
+		log.Fatalf("Failed to create device custom attribute shell script: %v", err)
+	}
+
+	// Pretty print the created device custom attribute shell script
+	jsonData, err := json.MarshalIndent(newScript, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created device custom attribute shell script: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
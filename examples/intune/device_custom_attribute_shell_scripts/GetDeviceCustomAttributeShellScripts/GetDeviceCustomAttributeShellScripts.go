@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scripts, err := client.GetDeviceCustomAttributeShellScripts()
+	if err != nil {
+		log.Fatalf("Failed to get device custom attribute shell scripts: %v", err)
+	}
+
+	// Pretty print the device custom attribute shell scripts
+	jsonData, err := json.MarshalIndent(scripts, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal device custom attribute shell scripts: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scriptID := "5d3b9c1e-7f2a-4b8d-9e6c-1a0f4d2b8c37"
+
+	values, err := client.GetDeviceCustomAttributeValues(scriptID)
+	if err != nil {
+		log.Fatalf("Failed to get device custom attribute values: %v", err)
+	}
+
+	for _, value := range values {
+		if value.ParseError != nil {
+			fmt.Printf("%-40s invalid value %q: %v\n", value.DeviceName, value.RawValue, value.ParseError)
+			continue
+		}
+
+		switch value.Type {
+		case intune.CustomAttributeTypeInteger:
+			fmt.Printf("%-40s %d\n", value.DeviceName, value.IntegerValue)
+		case intune.CustomAttributeTypeDateTime:
+			fmt.Printf("%-40s %s\n", value.DeviceName, value.DateTimeValue.Format("2006-01-02 15:04:05"))
+		default:
+			fmt.Printf("%-40s %s\n", value.DeviceName, value.StringValue)
+		}
+	}
+}
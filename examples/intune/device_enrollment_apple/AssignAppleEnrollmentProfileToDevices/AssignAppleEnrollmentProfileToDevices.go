@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	token, err := client.GetDepOnboardingSettingByTokenName("Contoso ABM")
+	if err != nil {
+		log.Fatalf("Failed to get dep onboarding setting: %v", err)
+	}
+
+	profile, err := client.GetAppleEnrollmentProfileByDisplayName(token.ID, "macOS Corporate")
+	if err != nil {
+		log.Fatalf("Failed to get apple enrollment profile: %v", err)
+	}
+
+	serialNumbers := []string{"C02XK1ABJG5H", "C02YL2BCKH6J"}
+
+	// Import the serial numbers first so the assignment applies before the devices sync from Apple
+	devices := make([]intune.ResourceImportedAppleDeviceIdentity, len(serialNumbers))
+	for i, serialNumber := range serialNumbers {
+		devices[i] = intune.ResourceImportedAppleDeviceIdentity{
+			SerialNumber: serialNumber,
+			Platform:     intune.AppleDevicePlatformMacOS,
+		}
+	}
+
+	results, err := client.ImportAppleDeviceIdentities(token.ID, devices, false)
+	if err != nil {
+		log.Fatalf("Failed to import apple device identities: %v", err)
+	}
+
+	for _, result := range results.Value {
+		if !result.Status {
+			log.Printf("Failed to import %s", result.SerialNumber)
+		}
+	}
+
+	if err := client.AssignAppleEnrollmentProfileToDevices(token.ID, profile.ID, serialNumbers); err != nil {
+		log.Fatalf("Failed to assign apple enrollment profile: %v", err)
+	}
+
+	fmt.Println("Apple enrollment profile assigned successfully")
+}
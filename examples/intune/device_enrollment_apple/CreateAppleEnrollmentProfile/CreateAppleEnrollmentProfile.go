@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	token, err := client.GetDepOnboardingSettingByTokenName("Contoso ABM")
+	if err != nil {
+		log.Fatalf("Failed to get dep onboarding setting: %v", err)
+	}
+
+	profile := &intune.ResourceAppleEnrollmentProfile{
+		ODataType:                            intune.ODataTypeDepMacOSEnrollmentProfile,
+		DisplayName:                          "macOS Corporate",
+		Description:                          "User affinity with Company Portal authentication",
+		RequiresUserAuthentication:           intune.Bool(true),
+		EnableAuthenticationViaCompanyPortal: intune.Bool(true),
+		SupervisedModeEnabled:                intune.Bool(true),
+		IsMandatory:                          intune.Bool(true),
+		ProfileRemovalDisabled:               intune.Bool(true),
+		SupportDepartment:                    "IT Service Desk",
+		SupportPhoneNumber:                   "+44 20 7946 0000",
+		LocationDisabled:                     intune.Bool(true),
+		AppleIdDisabled:                      intune.Bool(true),
+		SiriDisabled:                         intune.Bool(true),
+		DiagnosticsDisabled:                  intune.Bool(true),
+		ScreenTimeScreenDisabled:             intune.Bool(true),
+		FileVaultDisabled:                    intune.Bool(true),
+		ICloudStorageDisabled:                intune.Bool(true),
+		ICloudDiagnosticsDisabled:            intune.Bool(true),
+	}
+
+	createdProfile, err := client.CreateAppleEnrollmentProfile(token.ID, profile)
+	if err != nil {
+		log.Fatalf("Failed to create apple enrollment profile: %v", err)
+	}
+
+	if err := client.SetDefaultAppleEnrollmentProfileByID(token.ID, createdProfile.ID); err != nil {
+		log.Fatalf("Failed to set default apple enrollment profile: %v", err)
+	}
+
+	// Pretty print the created profile
+	jsonData, err := json.MarshalIndent(createdProfile, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created profile: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Find enrollment program tokens that expire in the next 30 days
+	expiring, err := client.GetDepOnboardingSettingsExpiringWithin(30 * 24 * time.Hour)
+	if err != nil {
+		log.Fatalf("Failed to get dep onboarding settings: %v", err)
+	}
+
+	for _, token := range expiring {
+		fmt.Printf("%s (%s) expires %s, last synced %s\n", token.TokenName, token.AppleIdentifier,
+			token.TokenExpirationDateTime.Format(time.RFC3339), token.LastSuccessfulSyncDateTime.Format(time.RFC3339))
+	}
+
+	// Trigger a sync for the remaining tokens
+	settings, err := client.GetDepOnboardingSettings()
+	if err != nil {
+		log.Fatalf("Failed to get dep onboarding settings: %v", err)
+	}
+
+	for _, token := range settings.Value {
+		if err := client.SyncDepOnboardingSettingByID(token.ID); err != nil {
+			log.Printf("Failed to sync %s: %v", token.TokenName, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Define a Windows enrollment status page
+	enrollmentStatusPage := &intune.ResourceDeviceEnrollmentConfiguration{
+		OdataType:                            intune.ODataTypeWindows10EnrollmentCompletionPageConfiguration,
+		DisplayName:                          "intune SDK enrollment status page creation test",
+		Description:                          "Blocks device use until required apps are installed",
+		ShowInstallationProgress:             intune.Bool(true),
+		BlockDeviceSetupRetryByUser:          intune.Bool(false),
+		AllowDeviceResetOnInstallFailure:     intune.Bool(true),
+		AllowLogCollectionOnInstallFailure:   intune.Bool(true),
+		CustomErrorMessage:                   "Setup could not be completed. Please contact IT support.",
+		InstallProgressTimeoutInMinutes:      60,
+		AllowDeviceUseOnInstallFailure:       intune.Bool(false),
+		SelectedMobileAppIds:                 []string{"0f4c5b2a-6d8e-4a1b-9c3d-7e2f1a0b4c5d"},
+		TrackInstallProgressForAutopilotOnly: intune.Bool(true),
+		InstallQualityUpdates:                intune.Bool(true),
+		RoleScopeTagIds:                      []string{"0"},
+	}
+
+	createdConfiguration, err := client.CreateDeviceEnrollmentConfiguration(enrollmentStatusPage)
+	if err != nil {
+		log.Fatalf("Failed to create device enrollment configuration: %v", err)
+	}
+
+	// Pretty print the created device enrollment configuration
+	jsonData, err := json.MarshalIndent(createdConfiguration, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created device enrollment configuration: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
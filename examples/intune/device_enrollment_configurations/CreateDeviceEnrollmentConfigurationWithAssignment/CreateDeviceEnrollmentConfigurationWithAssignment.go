@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Block personally owned Windows devices and anything older than Windows 10 22H2
+	windowsRestriction := &intune.ResourceDeviceEnrollmentConfiguration{
+		OdataType:    intune.ODataTypeDeviceEnrollmentPlatformRestrictionConfiguration,
+		DisplayName:  "intune SDK Windows platform restriction creation test",
+		PlatformType: intune.EnrollmentRestrictionPlatformTypeWindows,
+		PlatformRestriction: &intune.DeviceEnrollmentPlatformRestriction{
+			PlatformBlocked:                 false,
+			PersonalDeviceEnrollmentBlocked: true,
+			OsMinimumVersion:                "10.0.19045",
+		},
+		RoleScopeTagIds: []string{"0"},
+	}
+
+	assignment := &intune.AssignmentDeviceEnrollmentConfiguration{
+		EnrollmentConfigurationAssignments: []intune.DeviceEnrollmentConfigurationAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdConfiguration, err := client.CreateDeviceEnrollmentConfigurationWithAssignment(windowsRestriction, assignment)
+	if err != nil {
+		log.Fatalf("Failed to create device enrollment configuration: %v", err)
+	}
+
+	// Pretty print the created device enrollment configuration
+	jsonData, err := json.MarshalIndent(createdConfiguration, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created device enrollment configuration: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
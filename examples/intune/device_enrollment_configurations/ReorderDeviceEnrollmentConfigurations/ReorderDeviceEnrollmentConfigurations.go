@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Enrollment configurations in the order they should be evaluated
+	orderedConfigurationIDs := []string{
+		"8a1b2c3d-4e5f-4061-8728-394a5b6c7d8e_Windows10EnrollmentCompletionPageConfiguration",
+		"9b2c3d4e-5f60-4172-8839-4a5b6c7d8e9f_SinglePlatformRestriction",
+	}
+
+	if err := client.ReorderDeviceEnrollmentConfigurations(orderedConfigurationIDs); err != nil {
+		log.Fatalf("Failed to reorder device enrollment configurations: %v", err)
+	}
+
+	fmt.Println("Device enrollment configurations reordered successfully")
+}
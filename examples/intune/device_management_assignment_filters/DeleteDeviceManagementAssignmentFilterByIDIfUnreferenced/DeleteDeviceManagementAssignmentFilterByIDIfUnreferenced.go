@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	filterID := "8c3b8a4e-7d2f-4b6a-9e1c-5f0a2d3b4c6e"
+
+	// Refuse to delete the filter while assignments still reference it
+	err = client.DeleteDeviceManagementAssignmentFilterByIDIfUnreferenced(filterID)
+
+	var inUse *intune.AssignmentFilterInUseError
+	if errors.As(err, &inUse) {
+		fmt.Printf("Assignment filter %s is still in use:\n", inUse.FilterID)
+		for _, payload := range inUse.Payloads {
+			fmt.Printf("  %s %s (group %s, %s)\n", payload.PayloadType, payload.PayloadId, payload.GroupId, payload.AssignmentFilterType)
+		}
+		return
+	}
+	if err != nil {
+		log.Fatalf("Failed to delete assignment filter: %v", err)
+	}
+
+	fmt.Println("Assignment filter deleted successfully")
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	filter, err := client.GetDeviceManagementAssignmentFilterByDisplayName("Windows 11 Surface Devices")
+	if err != nil {
+		log.Fatalf("Failed to get assignment filter: %v", err)
+	}
+
+	rule, err := intune.ParseAssignmentFilterRuleForPlatform(filter.Rule, filter.Platform)
+	if err != nil {
+		log.Fatalf("Invalid assignment filter rule: %v", err)
+	}
+
+	// Device property records, keyed by property name without the device. prefix
+	devices := []map[string]string{
+		{"deviceName": "LAPTOP-001", "model": "Surface Laptop 4", "osVersion": "10.0.22621.2428"},
+		{"deviceName": "LAPTOP-002", "model": "Surface Laptop 4", "osVersion": "10.0.19045.3570"},
+		{"deviceName": "DESKTOP-001", "model": "OptiPlex 7090", "osVersion": "10.0.22631.2428"},
+	}
+
+	for i, matched := range rule.EvaluateAll(devices) {
+		fmt.Printf("%s: %t\n", devices[i]["deviceName"], matched)
+	}
+}
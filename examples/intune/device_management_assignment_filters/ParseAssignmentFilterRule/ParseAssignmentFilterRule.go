@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	rule := `(device.osVersion -startsWith "10.0.22") and (device.model -in ["Surface Pro 8", "Surface Laptop 4"])`
+
+	// Parse the rule and check it against the properties available to Windows devices
+	parsed, err := intune.ParseAssignmentFilterRuleForPlatform(rule, intune.AssignmentFilterPlatformWindows10AndLater)
+	if err != nil {
+		log.Fatalf("Invalid assignment filter rule: %v", err)
+	}
+
+	fmt.Println("Normalised rule:", parsed)
+	for _, comparison := range parsed.Comparisons() {
+		fmt.Printf("  %s.%s %s %v\n", comparison.Entity, comparison.Property, comparison.Operator, comparison.Values)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	filter := &intune.ResourceDeviceManagementAssignmentFilter{
+		DisplayName: "Windows 11 Surface Devices",
+		Platform:    intune.AssignmentFilterPlatformWindows10AndLater,
+		Rule:        `(device.osVersion -startsWith "10.0.22") and (device.manufacturer -eq "Microsoft Corporation")`,
+	}
+
+	validation, err := client.ValidateDeviceManagementAssignmentFilter(filter)
+	if err != nil {
+		log.Fatalf("Failed to validate assignment filter: %v", err)
+	}
+	if !validation.IsValidRule {
+		log.Fatalf("Assignment filter rule is not valid: %s", filter.Rule)
+	}
+
+	// Preview the first 50 devices the filter would include
+	preview, err := client.PreviewDeviceManagementAssignmentFilter(&intune.AssignmentFilterEvaluateRequest{
+		Platform: filter.Platform,
+		Rule:     filter.Rule,
+		Top:      50,
+	})
+	if err != nil {
+		log.Fatalf("Failed to preview assignment filter: %v", err)
+	}
+
+	fmt.Printf("%d devices match the filter\n", preview.TotalRowCount)
+	for _, row := range preview.Rows() {
+		fmt.Println(row)
+	}
+}
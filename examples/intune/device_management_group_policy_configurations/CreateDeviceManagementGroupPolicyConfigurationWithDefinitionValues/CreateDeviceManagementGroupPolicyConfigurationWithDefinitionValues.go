@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	configuration := &intune.ResourceDeviceManagementGroupPolicyConfiguration{
+		DisplayName:     "intune SDK administrative template creation test",
+		Description:     "Edge homepage and startup settings",
+		RoleScopeTagIds: []string{"0"},
+	}
+
+	// Definition and presentation IDs can be found with the group policy definitions endpoints
+	homepageDefinitionID := "4bb9e4ca-29a2-4c28-8e4e-76d63b9d5e0f"
+	startupDefinitionID := "2a6a4a8e-96d1-4c5b-8e2d-0b1f6e8d3c21"
+
+	definitionValues := []intune.ResourceGroupPolicyDefinitionValue{
+		intune.NewGroupPolicyDefinitionValue(homepageDefinitionID, true,
+			intune.NewGroupPolicyPresentationValueText(homepageDefinitionID, "cd4e8a1c-6f4b-4d2e-9a57-3c1b0e7f2d94", "https://intranet.contoso.com"),
+		),
+		intune.NewGroupPolicyDefinitionValue(startupDefinitionID, true,
+			intune.NewGroupPolicyPresentationValueDropdown(startupDefinitionID, "9f2b7c3d-1e4a-4b6c-8d0e-5a7f3c2b1d68", "4"),
+			intune.NewGroupPolicyPresentationValueMultiText(startupDefinitionID, "7e1d3c5b-2a4f-4e6d-9b8c-0f1a2b3c4d5e", []string{
+				"https://intranet.contoso.com",
+				"https://portal.contoso.com",
+			}),
+		),
+	}
+
+	assignment := &intune.AssignmentDeviceManagementGroupPolicyConfiguration{
+		Assignments: []intune.GroupPolicyConfigurationAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdConfiguration, err := client.CreateDeviceManagementGroupPolicyConfigurationWithDefinitionValues(configuration, definitionValues, assignment)
+	if err != nil {
+		log.Fatalf("Failed to create group policy configuration: %v", err)
+	}
+
+	// Pretty print the created group policy configuration
+	jsonData, err := json.MarshalIndent(createdConfiguration, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created group policy configuration: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
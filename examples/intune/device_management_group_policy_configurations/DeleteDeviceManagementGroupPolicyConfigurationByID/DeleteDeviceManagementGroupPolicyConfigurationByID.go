@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyConfigurationID := "b8a1c2d3-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	if err := client.DeleteDeviceManagementGroupPolicyConfigurationByID(policyConfigurationID); err != nil {
+		log.Fatalf("Failed to delete group policy configuration: %v", err)
+	}
+
+	fmt.Println("Group policy configuration deleted successfully")
+}
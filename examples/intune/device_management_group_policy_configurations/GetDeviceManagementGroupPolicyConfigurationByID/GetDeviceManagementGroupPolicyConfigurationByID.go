@@ -18,6 +18,9 @@ func main() {
 		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
 	}
 
+	// Fetch up to 10 definition values' presentation values in parallel
+	client.MaxConcurrentRequests = 10
+
 	// Example policy ID to get
 	groupPolicyConfigurationID := "6f9ba788-f719-46a7-b7c5-d566963d5999" // "7f774f0f-2f2d-4dc3-a76f-6d45af51019e" / "7f774f0f-2f2d-4dc3-a76f-6d45af51019e" / "6f9ba788-f719-46a7-b7c5-d566963d5999"
 
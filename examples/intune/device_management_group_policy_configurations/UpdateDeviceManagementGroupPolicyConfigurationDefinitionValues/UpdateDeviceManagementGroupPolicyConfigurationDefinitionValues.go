@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyConfigurationID := "b8a1c2d3-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	cacheDefinitionID := "0c4e6a8b-1d3f-4a5c-8e7b-9d1f2a3c4e5b"
+
+	// Update an existing presentation value by its ID
+	updatedPresentationValue := intune.NewGroupPolicyPresentationValueDecimal(cacheDefinitionID, "", 512)
+	updatedPresentationValue.ID = "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9"
+
+	request := &intune.GroupPolicyDefinitionValuesUpdate{
+		Added: []intune.ResourceGroupPolicyDefinitionValue{
+			intune.NewGroupPolicyDefinitionValue("5d7f9b1c-3e5a-4c7e-9f1b-2d4f6a8c0e2a", true,
+				intune.NewGroupPolicyPresentationValueBoolean("5d7f9b1c-3e5a-4c7e-9f1b-2d4f6a8c0e2a", "6e8a0c2d-4f6b-4d8f-a0c2-3e5a7b9d1f3b", true),
+			),
+		},
+		Updated: []intune.ResourceGroupPolicyDefinitionValue{
+			{
+				ID:                 "a9b8c7d6-e5f4-4321-9876-5a4b3c2d1e0f",
+				Enabled:            true,
+				PresentationValues: []intune.ResourceGroupPolicyPresentationValue{updatedPresentationValue},
+			},
+		},
+		DeletedIds: []string{"d4c3b2a1-f0e9-4d8c-b7a6-958473625140"},
+	}
+
+	if err := client.UpdateDeviceManagementGroupPolicyConfigurationDefinitionValues(policyConfigurationID, request); err != nil {
+		log.Fatalf("Failed to update group policy definition values: %v", err)
+	}
+
+	fmt.Println("Group policy definition values updated successfully")
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Fetch the catalog once and resolve definitions locally
+	index, err := client.GetGroupPolicyDefinitionIndex()
+	if err != nil {
+		log.Fatalf("Failed to build group policy definition index: %v", err)
+	}
+	fmt.Printf("Indexed %d group policy definitions\n", index.Len())
+
+	definitionID, err := index.LookupID("Configure the home page URL", `\Microsoft Edge\Startup, home page and new tab page`, intune.GroupPolicyDefinitionClassTypeMachine)
+	if err != nil {
+		log.Fatalf("Failed to resolve group policy definition: %v", err)
+	}
+
+	presentation, err := client.GetGroupPolicyDefinitionPresentationByLabel(definitionID, "Home page URL")
+	if err != nil {
+		log.Fatalf("Failed to resolve group policy presentation: %v", err)
+	}
+
+	fmt.Printf("Definition ID: %s\nPresentation ID: %s\n", definitionID, presentation.ID)
+}
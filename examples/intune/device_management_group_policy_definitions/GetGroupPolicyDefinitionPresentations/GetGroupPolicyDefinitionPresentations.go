@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	definitionID := "4bb9e4ca-29a2-4c28-8e4e-76d63b9d5e0f"
+
+	presentations, err := client.GetGroupPolicyDefinitionPresentations(definitionID)
+	if err != nil {
+		log.Fatalf("Failed to get group policy definition presentations: %v", err)
+	}
+
+	for _, presentation := range presentations.Value {
+		fmt.Printf("%s %q -> set with %s\n", presentation.ID, presentation.Label, presentation.PresentationValueODataType())
+	}
+
+	// Pretty print the presentations and their allowed values
+	jsonData, err := json.MarshalIndent(presentations, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal group policy definition presentations: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
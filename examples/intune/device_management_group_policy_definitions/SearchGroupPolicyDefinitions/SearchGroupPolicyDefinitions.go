@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	search := intune.GroupPolicyDefinitionSearch{
+		CategoryPath: `\Microsoft Edge\Startup, home page and new tab page`,
+		ClassType:    intune.GroupPolicyDefinitionClassTypeMachine,
+		DisplayName:  "home page",
+	}
+
+	definitions, err := client.SearchGroupPolicyDefinitions(search)
+	if err != nil {
+		log.Fatalf("Failed to search group policy definitions: %v", err)
+	}
+
+	for _, definition := range definitions.Value {
+		fmt.Printf("%s  %-8s %s\\%s\n", definition.ID, definition.ClassType, definition.CategoryPath, definition.DisplayName)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Parse and validate the ADMX file and its language files locally before uploading
+	request, report, err := intune.NewGroupPolicyUploadedDefinitionFileFromFiles(
+		"/Users/dafyddwatkins/localtesting/admx/chrome.admx",
+		map[string]string{
+			"en-US": "/Users/dafyddwatkins/localtesting/admx/en-US/chrome.adml",
+		},
+		"en-US",
+	)
+	if err != nil {
+		log.Fatalf("ADMX validation failed: %v", err)
+	}
+
+	fmt.Printf("Target namespace: %s (%d policies, %d categories)\n", report.TargetNamespace, report.PolicyCount, report.CategoryCount)
+	for _, namespace := range report.ReferencedNamespaces {
+		fmt.Printf("References namespace %s as %s\n", namespace.Namespace, namespace.Prefix)
+	}
+
+	// Dependent ADMX files, such as google.admx for chrome.admx, must be uploaded first
+	missing, err := client.ValidateGroupPolicyDefinitionFileDependencies(report)
+	if err != nil {
+		log.Fatalf("Failed to check ADMX dependencies: %v", err)
+	}
+	if len(missing) > 0 {
+		log.Fatalf("Upload the ADMX files for these namespaces first: %v", missing)
+	}
+
+	createdFile, err := client.CreateGroupPolicyUploadedDefinitionFile(request)
+	if err != nil {
+		log.Fatalf("Failed to upload ADMX file: %v", err)
+	}
+
+	file, err := client.WaitForGroupPolicyUploadedDefinitionFile(createdFile.ID, 10*time.Second, 10*time.Minute)
+	if err != nil {
+		log.Fatalf("ADMX ingestion failed: %v", err)
+	}
+
+	fmt.Printf("Uploaded %s with status %s\n", file.FileName, file.Status)
+}
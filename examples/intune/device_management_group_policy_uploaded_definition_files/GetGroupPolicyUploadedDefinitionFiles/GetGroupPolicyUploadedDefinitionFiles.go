@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	files, err := client.GetGroupPolicyUploadedDefinitionFiles()
+	if err != nil {
+		log.Fatalf("Failed to get uploaded ADMX files: %v", err)
+	}
+
+	// Pretty print the uploaded ADMX files
+	jsonData, err := json.MarshalIndent(files, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal uploaded ADMX files: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
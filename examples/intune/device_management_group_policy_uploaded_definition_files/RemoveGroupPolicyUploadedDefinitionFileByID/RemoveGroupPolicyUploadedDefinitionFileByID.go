@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	fileID := "c3d4e5f6-a7b8-4c9d-8e0f-1a2b3c4d5e6f"
+
+	if err := client.RemoveGroupPolicyUploadedDefinitionFileByID(fileID); err != nil {
+		log.Fatalf("Failed to remove uploaded ADMX file: %v", err)
+	}
+
+	fmt.Println("Uploaded ADMX file removed successfully")
+}
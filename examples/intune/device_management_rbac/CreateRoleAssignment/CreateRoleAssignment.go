@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	roleDefinition, err := client.GetRoleDefinitionByDisplayName("Service Desk - Read Only")
+	if err != nil {
+		log.Fatalf("Failed to get role definition: %v", err)
+	}
+
+	scopeTagIDs, err := client.ResolveRoleScopeTagIDs([]string{"London"})
+	if err != nil {
+		log.Fatalf("Failed to resolve role scope tags: %v", err)
+	}
+
+	roleAssignment := &intune.ResourceRoleAssignment{
+		DisplayName:      "London Service Desk",
+		Description:      "London service desk engineers",
+		RoleDefinitionID: roleDefinition.ID,
+		// Group containing the administrators receiving the role
+		Members: []string{"3c0f1a2b-4d5e-4f60-8172-93a4b5c6d7e8"},
+		// Groups of users and devices the administrators can manage
+		ResourceScopes:  []string{"ea8e2fb8-e909-44e6-bae7-56757cf6f347"},
+		ScopeType:       intune.RoleAssignmentScopeTypeResourceScope,
+		RoleScopeTagIds: scopeTagIDs,
+	}
+
+	createdRoleAssignment, err := client.CreateRoleAssignment(roleAssignment)
+	if err != nil {
+		log.Fatalf("Failed to create role assignment: %v", err)
+	}
+
+	// Pretty print the created role assignment
+	jsonData, err := json.MarshalIndent(createdRoleAssignment, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created role assignment: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Scope the role itself to the London scope tag
+	scopeTagIDs, err := client.ResolveRoleScopeTagIDs([]string{"London"})
+	if err != nil {
+		log.Fatalf("Failed to resolve role scope tags: %v", err)
+	}
+
+	roleDefinition := &intune.ResourceRoleDefinition{
+		DisplayName:     "Service Desk - Read Only",
+		Description:     "Read devices and configuration, and run a remote sync",
+		RoleScopeTagIds: scopeTagIDs,
+		RolePermissions: []intune.RoleDefinitionRolePermission{
+			{
+				ResourceActions: []intune.RoleDefinitionResourceAction{
+					{
+						AllowedResourceActions: []string{
+							"Microsoft.Intune_ManagedDevices_Read",
+							"Microsoft.Intune_DeviceConfigurations_Read",
+							"Microsoft.Intune_RemoteTasks_SyncDevice",
+						},
+						NotAllowedResourceActions: []string{},
+					},
+				},
+			},
+		},
+	}
+
+	createdRoleDefinition, err := client.CreateRoleDefinition(roleDefinition)
+	if err != nil {
+		log.Fatalf("Failed to create role definition: %v", err)
+	}
+
+	// Pretty print the created role
+	jsonData, err := json.MarshalIndent(createdRoleDefinition, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created role definition: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scopeTag := &intune.ResourceRoleScopeTag{
+		DisplayName: "London",
+		Description: "Devices and policies managed by the London service desk",
+	}
+
+	// Tag the devices of the London devices group automatically
+	assignment := &intune.AssignmentRoleScopeTag{
+		Assignments: []intune.RoleScopeTagAutoAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdScopeTag, err := client.CreateRoleScopeTagWithAssignment(scopeTag, assignment)
+	if err != nil {
+		log.Fatalf("Failed to create role scope tag: %v", err)
+	}
+
+	// Pretty print the created scope tag
+	jsonData, err := json.MarshalIndent(createdScopeTag, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created scope tag: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
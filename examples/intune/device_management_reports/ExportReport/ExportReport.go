@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+// DeviceNonComplianceRow holds the columns of the DeviceNonCompliance report used below.
+type DeviceNonComplianceRow struct {
+	DeviceName        string     `report:"DeviceName"`
+	UserPrincipalName string     `report:"UPN"`
+	OS                string     `report:"OS"`
+	OSVersion         string     `report:"OSVersion"`
+	LastContact       *time.Time `report:"LastContact"`
+}
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	request := &intune.ResourceDeviceManagementExportJob{
+		ReportName: intune.ReportNameDeviceNonCompliance,
+		Filter:     "(OS eq 'Windows')",
+		Select:     []string{"DeviceName", "UPN", "OS", "OSVersion", "LastContact"},
+	}
+
+	// Submit the export job, wait for it and stream the rows of the CSV
+	iterator, err := client.ExportReport(request, &intune.ReportExportJobOptions{Timeout: 30 * time.Minute})
+	if err != nil {
+		log.Fatalf("Failed to export report: %v", err)
+	}
+	defer iterator.Close()
+
+	count := 0
+	for iterator.Next() {
+		var row DeviceNonComplianceRow
+		if err := iterator.Decode(&row); err != nil {
+			log.Fatalf("Failed to decode report row: %v", err)
+		}
+		fmt.Printf("%-24s %-40s %s %s\n", row.DeviceName, row.UserPrincipalName, row.OS, row.OSVersion)
+		count++
+	}
+	if err := iterator.Err(); err != nil {
+		log.Fatalf("Failed to read report: %v", err)
+	}
+
+	fmt.Printf("%d noncompliant devices\n", count)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	policyID := "00000000-0000-0000-0000-000000000000" // Replace with the actual policy ID
+
+	// Retrieve the status of the policy on each device
+	statuses, err := client.GetConfigurationPolicyDeviceStatuses(policyID)
+	if err != nil {
+		log.Fatalf("Failed to get configuration policy device statuses: %v", err)
+	}
+
+	// Pretty print the device statuses
+	jsonData, err := json.MarshalIndent(statuses, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal device statuses: %v", err)
+	}
+	fmt.Println(string(jsonData))
+
+	// Retrieve the number of devices in each state per setting of the policy
+	settingStatuses, err := client.GetConfigurationPolicySettingStatuses(policyID)
+	if err != nil {
+		log.Fatalf("Failed to get configuration policy setting statuses: %v", err)
+	}
+
+	for _, setting := range settingStatuses {
+		fmt.Printf("%s: %d succeeded, %d error, %d conflict\n", setting.SettingName, setting.NumberOfCompliantDevices, setting.NumberOfErrorDevices, setting.NumberOfConflictDevices)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	reusableSettingName := "Corporate proxy servers" // Replace with the actual reusable setting name
+
+	// Refuse the delete while configuration policies still reference the reusable setting
+	err = client.DeleteDeviceManagementReusablePolicySettingByDisplayName(reusableSettingName)
+	var inUseErr *intune.ReusablePolicySettingInUseError
+	if errors.As(err, &inUseErr) {
+		for _, policy := range inUseErr.Policies {
+			fmt.Printf("Referenced by policy %s (%s)\n", policy.Name, policy.ID)
+		}
+		log.Fatalf("Reusable policy setting %s was not deleted", reusableSettingName)
+	}
+	if err != nil {
+		log.Fatalf("Failed to delete reusable policy setting: %v", err)
+	}
+
+	fmt.Printf("Reusable policy setting %s deleted\n", reusableSettingName)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scriptID := "e7a6a4b0-2d4c-4a8e-9bd1-3f6c2a1d5e90"
+
+	file, err := os.Create("script_failures.csv")
+	if err != nil {
+		log.Fatalf("Failed to create export file: %v", err)
+	}
+	defer file.Close()
+
+	// Stream only the failed runs, including the raw script output, page by page
+	iterator := client.NewDeviceManagementScriptDeviceRunStateIterator(scriptID)
+	if err := intune.ExportDeviceManagementScriptRunStatesCSV(file, iterator, true); err != nil {
+		log.Fatalf("Failed to export device management script run states: %v", err)
+	}
+
+	log.Println("Exported failed script runs to script_failures.csv")
+}
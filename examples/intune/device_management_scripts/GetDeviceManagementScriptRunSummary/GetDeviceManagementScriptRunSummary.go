@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scriptID := "e7a6a4b0-2d4c-4a8e-9bd1-3f6c2a1d5e90"
+
+	runSummary, err := client.GetDeviceManagementScriptRunSummary(scriptID)
+	if err != nil {
+		log.Fatalf("Failed to get device management script run summary: %v", err)
+	}
+
+	jsonData, err := json.MarshalIndent(runSummary, "", "    ")
+	if err != nil {
+		log.Fatalf("Failed to marshal run summary into JSON: %v", err)
+	}
+
+	fmt.Println(string(jsonData))
+}
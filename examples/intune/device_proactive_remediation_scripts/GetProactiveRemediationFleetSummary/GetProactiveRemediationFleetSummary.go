@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	summaries, err := client.GetProactiveRemediationFleetSummary()
+	if err != nil {
+		log.Fatalf("Failed to summarise proactive remediations: %v", err)
+	}
+
+	for _, summary := range summaries {
+		fmt.Printf("%-50s devices=%-5d succeeded=%-5d failed=%-5d pending=%d\n",
+			summary.DisplayName, summary.TotalDevices, summary.Succeeded(), summary.Failed(), summary.Pending)
+	}
+}
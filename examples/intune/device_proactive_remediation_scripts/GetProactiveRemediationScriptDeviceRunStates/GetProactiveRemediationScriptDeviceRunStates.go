@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scriptID := "ffd8de7a-e0aa-4f14-b917-f644f781c1fc"
+
+	runStates, err := client.GetProactiveRemediationScriptDeviceRunStates(scriptID)
+	if err != nil {
+		log.Fatalf("Failed to get proactive remediation device run states: %v", err)
+	}
+
+	// Print the devices where detection or remediation did not succeed, with the script output
+	for _, state := range runStates.Value {
+		if state.DetectionState != intune.DeviceHealthScriptDetectionStateScriptError &&
+			state.RemediationState != intune.DeviceHealthScriptRemediationStateRemediationFailed &&
+			state.RemediationState != intune.DeviceHealthScriptRemediationStateScriptError {
+			continue
+		}
+
+		deviceName := state.ID
+		if state.ManagedDevice != nil {
+			deviceName = state.ManagedDevice.DeviceName
+		}
+
+		fmt.Printf("%s: detection=%s remediation=%s\n", deviceName, state.DetectionState, state.RemediationState)
+		fmt.Printf("  pre-remediation output: %s\n", state.PreRemediationDetectionScriptOutput)
+		fmt.Printf("  pre-remediation error:  %s\n", state.PreRemediationDetectionScriptError)
+		fmt.Printf("  remediation error:      %s\n", state.RemediationScriptError)
+		fmt.Printf("  post-remediation output: %s\n", state.PostRemediationDetectionScriptOutput)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	managedDeviceID := "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d"
+	scriptID := "ffd8de7a-e0aa-4f14-b917-f644f781c1fc"
+
+	// Run the proactive remediation on the device now
+	err = client.InitiateOnDemandProactiveRemediation(managedDeviceID, scriptID)
+	if err != nil {
+		log.Fatalf("Failed to initiate on demand proactive remediation: %v", err)
+	}
+
+	fmt.Println("On demand proactive remediation initiated successfully")
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	scriptID := "3b1f0c6e-8a2d-4e57-b7c9-0d4e1f2a6b38"
+
+	// Count failures by device without holding every run state in memory
+	failuresByDevice := make(map[string]int)
+	iterator := client.NewDeviceShellScriptDeviceRunStateIterator(scriptID)
+	for iterator.Next() {
+		state := iterator.RunState()
+		if !state.RunState.IsFailure() || state.ManagedDevice == nil {
+			continue
+		}
+		failuresByDevice[state.ManagedDevice.DeviceName]++
+	}
+	if err := iterator.Err(); err != nil {
+		log.Fatalf("Failed to get device shell script run states: %v", err)
+	}
+
+	for deviceName, failures := range failuresByDevice {
+		fmt.Printf("%-40s failures=%d\n", deviceName, failures)
+	}
+}
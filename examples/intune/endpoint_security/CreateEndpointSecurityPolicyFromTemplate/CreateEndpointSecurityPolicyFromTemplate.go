@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Find the active antivirus templates
+	templates, err := client.GetConfigurationPolicyTemplates(intune.TemplateFamilyEndpointSecurityAntivirus)
+	if err != nil {
+		log.Fatalf("Failed to get configuration policy templates: %v", err)
+	}
+
+	var templateID string
+	for _, template := range templates.Value {
+		if template.DisplayName == "Microsoft Defender Antivirus" && template.Platforms == "windows10" {
+			templateID = template.ID
+			break
+		}
+	}
+	if templateID == "" {
+		log.Fatalf("Microsoft Defender Antivirus template not found")
+	}
+
+	// Template references of the settings are filled in from the template
+	policy := &intune.ResourceDeviceManagementConfigurationPolicy{
+		Name:        "Antivirus - Cloud protection",
+		Description: "Created from the Microsoft Defender Antivirus template",
+		Settings: []intune.DeviceManagementConfigurationSubsetSetting{
+			{
+				SettingInstance: intune.DeviceManagementConfigurationSubsetSettingInstance{
+					OdataType:           "#microsoft.graph.deviceManagementConfigurationChoiceSettingInstance",
+					SettingDefinitionId: "device_vendor_msft_policy_config_defender_allowcloudprotection",
+					ChoiceSettingValue: &intune.DeviceManagementConfigurationSubsetChoiceSettingValue{
+						Value:    "device_vendor_msft_policy_config_defender_allowcloudprotection_1",
+						Children: []intune.DeviceManagementConfigurationSubsetSettingInstance{},
+					},
+				},
+			},
+		},
+	}
+
+	createdPolicy, err := client.CreateEndpointSecurityPolicyFromTemplate(templateID, policy)
+	if err != nil {
+		log.Fatalf("Failed to create endpoint security policy: %v", err)
+	}
+
+	// Pretty print the created policy
+	jsonData, err := json.MarshalIndent(createdPolicy, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created policy: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Level 2 enterprise enhanced data protection for Outlook and Teams on iOS
+	policy := &intune.ResourceManagedAppProtection{
+		ODataType:    intune.ODataTypeIOSManagedAppProtection,
+		DisplayName:  "iOS - Enterprise enhanced data protection",
+		Description:  "App protection framework level 2",
+		AppGroupType: intune.TargetedManagedAppGroupTypeSelectedPublicApps,
+		Apps: []intune.ManagedMobileApp{
+			intune.NewIOSManagedMobileApp("com.microsoft.Office.Outlook"),
+			intune.NewIOSManagedMobileApp("com.microsoft.skype.teams"),
+		},
+
+		// Data protection
+		AllowedInboundDataTransferSources:       intune.ManagedAppDataTransferLevelAllApps,
+		AllowedOutboundDataTransferDestinations: intune.ManagedAppDataTransferLevelManagedApps,
+		AllowedOutboundClipboardSharingLevel:    intune.ManagedAppClipboardSharingLevelManagedAppsWithPasteIn,
+		DataBackupBlocked:                       true,
+		SaveAsBlocked:                           true,
+		AllowedDataStorageLocations: []string{
+			intune.ManagedAppDataStorageLocationOneDriveForBusiness,
+			intune.ManagedAppDataStorageLocationSharePoint,
+		},
+		AppDataEncryptionType: intune.ManagedAppDataEncryptionTypeWhenDeviceLocked,
+
+		// Access requirements
+		PinRequired:                    true,
+		PinCharacterSet:                intune.ManagedAppPinCharacterSetNumeric,
+		MinimumPinLength:               6,
+		SimplePinBlocked:               true,
+		MaximumPinRetries:              5,
+		PeriodOnlineBeforeAccessCheck:  "PT30M",
+		PeriodOfflineBeforeAccessCheck: "PT12H",
+
+		// Conditional launch
+		PeriodOfflineBeforeWipeIsEnforced:    "P90D",
+		AppActionIfMaximumPinRetriesExceeded: intune.ManagedAppRemediationActionBlock,
+		MinimumRequiredOsVersion:             "16.0",
+		MinimumWarningOsVersion:              "17.0",
+		MaximumAllowedDeviceThreatLevel:      intune.ManagedAppDeviceThreatLevelSecured,
+		MobileThreatDefenseRemediationAction: intune.ManagedAppRemediationActionBlock,
+	}
+
+	assignment := &intune.AssignmentTargetedManagedAppPolicy{
+		Assignments: []intune.TargetedManagedAppPolicyAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdPolicy, err := client.CreateManagedAppProtectionWithAssignment(policy, assignment)
+	if err != nil {
+		log.Fatalf("Failed to create app protection policy: %v", err)
+	}
+
+	// Pretty print the created policy
+	jsonData, err := json.MarshalIndent(createdPolicy, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created policy: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
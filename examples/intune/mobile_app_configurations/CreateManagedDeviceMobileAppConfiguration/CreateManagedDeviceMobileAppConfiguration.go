@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Outlook for iOS configuration kept in source control as a plist
+	plist, err := os.ReadFile("/Users/dafyddwatkins/localtesting/msgraph/outlook-ios-config.plist")
+	if err != nil {
+		log.Fatalf("Failed to read plist: %v", err)
+	}
+
+	encodedSettingXml, err := intune.EncodeIOSAppConfigurationXML(plist)
+	if err != nil {
+		log.Fatalf("Failed to encode app configuration XML: %v", err)
+	}
+
+	configuration := &intune.ResourceManagedDeviceMobileAppConfiguration{
+		ODataType:          intune.ODataTypeIOSMobileAppConfiguration,
+		DisplayName:        "iOS - Outlook configuration",
+		TargetedMobileApps: []string{"5d1c8e2a-7b3f-4e6d-8a9c-1f2e3d4c5b6a"},
+		EncodedSettingXml:  encodedSettingXml,
+	}
+
+	createdConfiguration, err := client.CreateManagedDeviceMobileAppConfiguration(configuration)
+	if err != nil {
+		log.Fatalf("Failed to create mobile app configuration: %v", err)
+	}
+
+	// Pretty print the created configuration
+	jsonData, err := json.MarshalIndent(createdConfiguration, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created configuration: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Company Portal from the Microsoft Store (new), delivered through WinGet
+	app := &intune.ResourceMobileApp{
+		ODataType:         intune.ODataTypeWinGetApp,
+		DisplayName:       "Company Portal",
+		Description:       "Install apps and manage your device",
+		Publisher:         "Microsoft Corporation",
+		PackageIdentifier: "9WZDNCRFJ3PZ",
+		InstallExperience: &intune.WinGetAppInstallExperience{
+			RunAsAccount: intune.Win32LobAppRunAsAccountUser,
+		},
+	}
+
+	// Required for all devices, available to a pilot group of users
+	assignment := &intune.AssignmentMobileApp{
+		MobileAppAssignments: []intune.MobileAppAssignment{
+			{
+				Intent: intune.MobileAppAssignmentIntentRequired,
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetAllDevices,
+				},
+				Settings: &intune.MobileAppAssignmentSettings{
+					ODataType:     intune.ODataTypeWinGetAppAssignmentSettings,
+					Notifications: intune.MobileAppNotificationsHideAll,
+				},
+			},
+			{
+				Intent: intune.MobileAppAssignmentIntentAvailable,
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdApp, err := client.CreateMobileAppWithAssignment(app, assignment)
+	if err != nil {
+		log.Fatalf("Failed to create mobile app: %v", err)
+	}
+
+	// Pretty print the created app
+	jsonData, err := json.MarshalIndent(createdApp, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created app: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
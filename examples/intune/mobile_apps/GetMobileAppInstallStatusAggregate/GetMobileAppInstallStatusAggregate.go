@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	appIDs := []string{
+		"0a6bd2f4-3c5e-4d0b-9a0e-7c8f2b1e4d6a",
+		"5d1c8e2a-7b3f-4e6d-8a9c-1f2e3d4c5b6a",
+	}
+
+	aggregates, err := client.GetMobileAppInstallStatusAggregates(appIDs)
+	if err != nil {
+		log.Fatalf("Failed to get mobile app install status: %v", err)
+	}
+
+	for _, aggregate := range aggregates {
+		fmt.Printf("App %s: %d devices\n", aggregate.AppID, aggregate.DeviceCount)
+		for state, count := range aggregate.ByInstallState {
+			fmt.Printf("  %-16s %d\n", state, count)
+		}
+
+		// Most frequent failures first
+		for _, installError := range aggregate.Errors {
+			description := installError.Description
+			if description == "" {
+				description = "unknown error"
+			}
+			fmt.Printf("  %s (%d devices): %s\n", installError.HexErrorCode, installError.DeviceCount, description)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	newAppID := "0a6bd2f4-3c5e-4d0b-9a0e-7c8f2b1e4d6a"      // 7-Zip 23.01
+	previousAppID := "5d1c8e2a-7b3f-4e6d-8a9c-1f2e3d4c5b6a" // 7-Zip 22.01
+	runtimeAppID := "9c8b7a6d-5e4f-4a3b-2c1d-0e9f8a7b6c5d"  // Visual C++ Redistributable
+
+	// The new version updates the previous version and installs the runtime first when it is missing
+	relationships := []intune.ResourceMobileAppRelationship{
+		{
+			ODataType:        intune.ODataTypeMobileAppSupersedence,
+			TargetID:         previousAppID,
+			SupersedenceType: intune.MobileAppSupersedenceTypeUpdate,
+		},
+		{
+			ODataType:      intune.ODataTypeMobileAppDependency,
+			TargetID:       runtimeAppID,
+			DependencyType: intune.MobileAppDependencyTypeAutoInstall,
+		},
+	}
+
+	err = client.UpdateMobileAppRelationships(newAppID, relationships)
+	var cycleErr *intune.MobileAppRelationshipCycleError
+	if errors.As(err, &cycleErr) {
+		log.Fatalf("Relationships would create a cycle through apps: %v", cycleErr.Path)
+	}
+	if err != nil {
+		log.Fatalf("Failed to update mobile app relationships: %v", err)
+	}
+
+	fmt.Println("Mobile app relationships updated successfully")
+}
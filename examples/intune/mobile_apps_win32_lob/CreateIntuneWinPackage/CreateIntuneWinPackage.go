@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Folder holding the installer and any files it needs
+	sourceFolder := "/Users/dafyddwatkins/localtesting/intunewin/source/7zip"
+	setupFile := "7z2301-x64.exe"
+	outputFolder := "/Users/dafyddwatkins/localtesting/intunewin"
+
+	packagePath, err := intune.CreateIntuneWinPackage(sourceFolder, setupFile, outputFolder, nil)
+	if err != nil {
+		log.Fatalf("Failed to create intunewin package: %v", err)
+	}
+
+	// Open the package again to show the metadata written to Detection.xml
+	pkg, err := intune.OpenIntuneWinPackage(packagePath)
+	if err != nil {
+		log.Fatalf("Failed to open intunewin package: %v", err)
+	}
+	defer pkg.Close()
+
+	fmt.Printf("Created %s\n", packagePath)
+	fmt.Printf("Setup file: %s\n", pkg.Detection.SetupFile)
+	fmt.Printf("Unencrypted size: %d bytes, encrypted size: %d bytes\n", pkg.Detection.UnencryptedContentSize, pkg.EncryptedSize())
+	fmt.Printf("File digest (%s): %s\n", pkg.Detection.EncryptionInfo.FileDigestAlgorithm, pkg.Detection.EncryptionInfo.FileDigest)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Package created with the Microsoft Win32 Content Prep Tool
+	intuneWinFilePath := "/Users/dafyddwatkins/localtesting/intunewin/7z2301-x64.intunewin"
+
+	app := &intune.ResourceWin32LobApp{
+		DisplayName:          "7-Zip",
+		Description:          "7-Zip file archiver",
+		Publisher:            "Igor Pavlov",
+		DisplayVersion:       "23.01",
+		InstallCommandLine:   "7z2301-x64.exe /S",
+		UninstallCommandLine: `"%ProgramFiles%\7-Zip\Uninstall.exe" /S`,
+		Rules: []intune.Win32LobAppRule{
+			{
+				ODataType:            intune.ODataTypeWin32LobAppFileSystemRule,
+				RuleType:             intune.Win32LobAppRuleTypeDetection,
+				Path:                 `%ProgramFiles%\7-Zip`,
+				FileOrFolderName:     "7z.exe",
+				Check32BitOn64System: false,
+				OperationType:        intune.Win32LobAppFileSystemOperationTypeVersion,
+				Operator:             intune.Win32LobAppRuleOperatorGreaterThanOrEqual,
+				ComparisonValue:      "23.01",
+			},
+		},
+		MinimumSupportedWindowsRelease: "1607",
+		InstallExperience: &intune.Win32LobAppInstallExperience{
+			RunAsAccount:          intune.Win32LobAppRunAsAccountSystem,
+			DeviceRestartBehavior: intune.Win32LobAppRestartBehaviorSuppress,
+			MaxRunTimeInMinutes:   30,
+		},
+	}
+
+	options := &intune.MobileAppContentUploadOptions{
+		PollInterval: 5 * time.Second,
+		Timeout:      15 * time.Minute,
+	}
+
+	createdApp, err := client.CreateWin32LobAppFromIntuneWinPackage(app, intuneWinFilePath, options)
+	if err != nil {
+		log.Fatalf("Failed to create win32 app: %v", err)
+	}
+
+	// Pretty print the created app
+	jsonData, err := json.MarshalIndent(createdApp, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created app: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	appID := "0a6bd2f4-3c5e-4d0b-9a0e-7c8f2b1e4d6a"
+	intuneWinFilePath := "/Users/dafyddwatkins/localtesting/intunewin/7z2301-x64.intunewin"
+
+	pkg, err := intune.OpenIntuneWinPackage(intuneWinFilePath)
+	if err != nil {
+		log.Fatalf("Failed to open intunewin package: %v", err)
+	}
+	defer pkg.Close()
+
+	fmt.Printf("Uploading %s (%d bytes encrypted) with setup file %s\n", pkg.FileName(), pkg.EncryptedSize(), pkg.Detection.SetupFile)
+
+	// Upload a new content version of an existing app, e.g. one whose earlier upload failed
+	if err := client.UploadWin32LobAppContent(appID, pkg, nil); err != nil {
+		log.Fatalf("Failed to upload win32 app content: %v", err)
+	}
+
+	fmt.Println("Win32 app content uploaded and committed successfully")
+}
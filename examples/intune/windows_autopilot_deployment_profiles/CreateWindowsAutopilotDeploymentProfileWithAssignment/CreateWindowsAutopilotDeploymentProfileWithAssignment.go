@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	profile := &intune.ResourceWindowsAutopilotDeploymentProfile{
+		ODataType:           intune.ODataTypeAzureADWindowsAutopilotDeploymentProfile,
+		DisplayName:         "Corporate User Driven",
+		Description:         "Azure AD joined user driven deployment",
+		Language:            "os-default",
+		DeviceNameTemplate:  "CORP-%SERIAL%",
+		DeviceType:          intune.WindowsAutopilotDeviceTypeWindowsPc,
+		ExtractHardwareHash: true,
+		OutOfBoxExperienceSettings: &intune.WindowsAutopilotOutOfBoxExperienceSettings{
+			HidePrivacySettings:       true,
+			HideEULA:                  true,
+			UserType:                  intune.WindowsAutopilotUserTypeStandard,
+			DeviceUsageType:           intune.WindowsAutopilotDeviceUsageTypeSingleUser,
+			SkipKeyboardSelectionPage: true,
+			HideEscapeLink:            true,
+		},
+		Assignments: []intune.WindowsAutopilotDeploymentProfileAssignment{
+			{
+				Target: intune.DeviceAndAppManagementAssignmentTarget{
+					ODataType: intune.ODataTypeAssignmentTargetGroup,
+					GroupID:   "ea8e2fb8-e909-44e6-bae7-56757cf6f347",
+				},
+			},
+		},
+	}
+
+	createdProfile, err := client.CreateWindowsAutopilotDeploymentProfileWithAssignment(profile)
+	if err != nil {
+		log.Fatalf("Failed to create windows autopilot deployment profile: %v", err)
+	}
+
+	// Pretty print the created profile
+	jsonData, err := json.MarshalIndent(createdProfile, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal created profile: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	// Parse the hardware hash CSV exported by Get-WindowsAutopilotInfo
+	devices, err := intune.ParseWindowsAutopilotHardwareHashCSVFile("/Users/dafyddwatkins/localtesting/autopilot/AutopilotHWID.csv")
+	if err != nil {
+		log.Fatalf("Failed to parse hardware hash csv: %v", err)
+	}
+
+	importedDevices, err := client.ImportWindowsAutopilotDeviceIdentities(devices)
+	if err != nil {
+		log.Fatalf("Failed to import windows autopilot devices: %v", err)
+	}
+
+	var ids []string
+	for _, importedDevice := range importedDevices.Value {
+		ids = append(ids, importedDevice.ID)
+	}
+
+	// Wait for the Autopilot service to process the uploads
+	results, err := client.WaitForImportedWindowsAutopilotDeviceIdentities(ids, 30*time.Second, 30*time.Minute)
+	if err != nil {
+		log.Fatalf("Failed waiting for windows autopilot device import: %v", err)
+	}
+
+	// Pretty print the import results
+	jsonData, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal import results: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	device, err := client.GetWindowsAutopilotDeviceIdentityBySerialNumber("PF3ABCDE")
+	if err != nil {
+		log.Fatalf("Failed to get windows autopilot device: %v", err)
+	}
+
+	if err := client.UpdateWindowsAutopilotDeviceGroupTagByID(device.ID, "Kiosk"); err != nil {
+		log.Fatalf("Failed to update windows autopilot device group tag: %v", err)
+	}
+
+	if err := client.AssignUserToWindowsAutopilotDeviceByID(device.ID, "jane.doe@contoso.com", "Jane Doe"); err != nil {
+		log.Fatalf("Failed to assign user to windows autopilot device: %v", err)
+	}
+
+	fmt.Println("Windows autopilot device updated successfully")
+}
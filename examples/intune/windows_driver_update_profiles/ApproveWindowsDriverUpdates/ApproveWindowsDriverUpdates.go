@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	profileID := "00000000-0000-0000-0000-000000000000" // Replace with the actual driver update profile ID
+
+	inventories, err := client.GetWindowsDriverUpdateInventories(profileID)
+	if err != nil {
+		log.Fatalf("Failed to get driver inventory: %v", err)
+	}
+
+	// Approve the recommended drivers awaiting review
+	var driverIDs []string
+	for _, driver := range inventories.Value {
+		if driver.ApprovalStatus == intune.DriverApprovalStatusNeedsReview && driver.Category == intune.DriverCategoryRecommended {
+			driverIDs = append(driverIDs, driver.ID)
+		}
+	}
+
+	if len(driverIDs) == 0 {
+		fmt.Println("No recommended drivers need review")
+		return
+	}
+
+	result, err := client.ApproveWindowsDriverUpdates(profileID, driverIDs, time.Now().AddDate(0, 0, 7))
+	if err != nil {
+		log.Fatalf("Failed to approve drivers: %v", err)
+	}
+
+	// Pretty print the approval result
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal approval result: %v", err)
+	}
+	fmt.Println(string(jsonData))
+}
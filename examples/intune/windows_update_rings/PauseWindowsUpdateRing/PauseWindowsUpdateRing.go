@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-m365/sdk/m365/intune"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/msgraph/clientconfig.json"
+
+	// Initialize the msgraph client with the HTTP client configuration
+	client, err := intune.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize msgraph client: %v", err)
+	}
+
+	ringName := "Pilot ring" // Replace with the actual update ring name
+
+	ring, err := client.GetWindowsUpdateRingByDisplayName(ringName)
+	if err != nil {
+		log.Fatalf("Failed to get windows update ring: %v", err)
+	}
+
+	// Pause quality updates on the devices of the ring
+	err = client.PauseWindowsUpdateRingByID(ring.ID, intune.WindowsUpdateRingUpdateTypeQuality)
+	if err != nil {
+		log.Fatalf("Failed to pause windows update ring: %v", err)
+	}
+
+	ring, err = client.GetWindowsUpdateRingByID(ring.ID)
+	if err != nil {
+		log.Fatalf("Failed to get windows update ring: %v", err)
+	}
+
+	if ring.QualityUpdatesPauseExpiryDateTime != nil {
+		fmt.Printf("Quality updates of %s are paused until %s\n", ring.DisplayName, ring.QualityUpdatesPauseExpiryDateTime)
+	}
+}
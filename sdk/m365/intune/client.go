@@ -6,8 +6,24 @@ import (
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 )
 
+// defaultMaxConcurrentRequests is the number of requests issued in parallel when a method needs several
+// requests to assemble a resource and Client.MaxConcurrentRequests is not set.
+const defaultMaxConcurrentRequests = 5
+
 type Client struct {
 	HTTP *httpclient.Client
+
+	// MaxConcurrentRequests bounds the number of requests issued in parallel by methods that assemble a
+	// resource from several requests. Zero uses defaultMaxConcurrentRequests; set 1 to issue requests sequentially.
+	MaxConcurrentRequests int
+}
+
+// maxConcurrentRequests returns the configured request concurrency, falling back to the default.
+func (c *Client) maxConcurrentRequests() int {
+	if c.MaxConcurrentRequests > 0 {
+		return c.MaxConcurrentRequests
+	}
+	return defaultMaxConcurrentRequests
 }
 
 // ClientConfig combines authentication and environment settings for the client.
@@ -0,0 +1,116 @@
+// graphbeta_device_app_management_azure_blob_storage.go
+// Graph Beta Api - Intune: App content upload to Azure Storage
+// Documentation: https://learn.microsoft.com/en-us/rest/api/storageservices/put-block
+// Documentation: https://learn.microsoft.com/en-us/rest/api/storageservices/put-block-list
+// App content is not uploaded through Graph. Intune returns a shared access signature (SAS) URI for an Azure
+// Storage blob, the content is uploaded to it in blocks, and the block list is then committed.
+
+package intune
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BlobStorageUploader uploads app content to the SAS URI returned by Intune using block blob semantics.
+// The default implementation talks to Azure Storage; a local stand-in can be supplied in
+// MobileAppContentUploadOptions to test an upload without Azure.
+type BlobStorageUploader interface {
+	// PutBlock uploads a block of the blob. Block IDs are base64 encoded and all have the same length.
+	PutBlock(sasURI, blockID string, data []byte) error
+	// PutBlockList commits the uploaded blocks, in order, as the content of the blob.
+	PutBlockList(sasURI string, blockIDs []string) error
+}
+
+// AzureBlobStorageUploader is the BlobStorageUploader used when none is configured. HTTPClient defaults to
+// http.DefaultClient.
+type AzureBlobStorageUploader struct {
+	HTTPClient *http.Client
+}
+
+// PutBlock uploads a block with the Put Block operation.
+func (u *AzureBlobStorageUploader) PutBlock(sasURI, blockID string, data []byte) error {
+	endpoint := blobStorageURIWithQuery(sasURI, "comp=block&blockid="+url.QueryEscape(blockID))
+
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+
+	return u.do(req, "put block "+blockID)
+}
+
+// PutBlockList commits the blocks with the Put Block List operation.
+func (u *AzureBlobStorageUploader) PutBlockList(sasURI string, blockIDs []string) error {
+	endpoint := blobStorageURIWithQuery(sasURI, "comp=blocklist")
+
+	body, err := blobStorageBlockListXML(blockIDs)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+
+	return u.do(req, "put block list")
+}
+
+func (u *AzureBlobStorageUploader) do(req *http.Request, operation string) error {
+	httpClient := u.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("azure storage %s failed: %v", operation, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("azure storage %s failed with status %s: %s", operation, resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
+
+// blobStorageURIWithQuery appends query parameters to a SAS URI, which already carries the signature in its query.
+func blobStorageURIWithQuery(sasURI, query string) string {
+	if strings.Contains(sasURI, "?") {
+		return sasURI + "&" + query
+	}
+	return sasURI + "?" + query
+}
+
+// blobStorageBlockListXML renders the request body of Put Block List with every block taken from the
+// uncommitted or committed list, whichever is latest.
+func blobStorageBlockListXML(blockIDs []string) ([]byte, error) {
+	blockList := struct {
+		XMLName xml.Name `xml:"BlockList"`
+		Latest  []string `xml:"Latest"`
+	}{Latest: blockIDs}
+
+	body, err := xml.Marshal(blockList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal block list, error: %v", err)
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// blobStorageBlockID returns the block ID for the block at index. Azure requires every block ID of a blob to
+// have the same length, so the index is zero padded.
+func blobStorageBlockID(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%08d", index)))
+}
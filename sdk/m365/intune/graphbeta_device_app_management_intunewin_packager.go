@@ -0,0 +1,443 @@
+// graphbeta_device_app_management_intunewin_packager.go
+// Graph Beta Api - Intune: Win32 app packages (.intunewin packager)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-win32-prepare
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsWindowsMenu/~/windowsApps
+// Produces .intunewin packages in the format of the Microsoft Win32 Content Prep Tool, so apps can be packaged
+// on platforms where the tool does not run. The source folder is zipped, the zip is encrypted with AES-256-CBC
+// and authenticated with HMAC-SHA256, and the encrypted content is wrapped with Detection.xml in the outer zip.
+//
+// The encrypted content is laid out as HMAC (32 bytes) | IV (16 bytes) | ciphertext, where the HMAC is computed
+// over the IV and ciphertext. Detection.xml records the keys, the HMAC and the SHA256 digest of the unencrypted zip.
+
+package intune
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	intuneWinContentFileName       = "IntunePackage.intunewin"
+	intuneWinToolVersion           = "1.8.4.0"
+	intuneWinProfileIdentifier     = "ProfileVersion1"
+	intuneWinFileDigestAlgorithm   = "SHA256"
+	intuneWinEncryptionBufferBytes = 1024 * 1024
+)
+
+// IntuneWinPackagerOptions configures CreateIntuneWinPackage. A nil value packages the setup file without MSI
+// information.
+type IntuneWinPackagerOptions struct {
+	// Name is recorded as the package name in Detection.xml. Defaults to the setup file name.
+	Name string
+	// MsiInfo is recorded in Detection.xml when the setup file is an MSI. MSI properties are not read from the
+	// setup file, so they must be supplied by the caller.
+	MsiInfo *IntuneWinMsiInfo
+}
+
+// CreateIntuneWinPackage packages sourceFolder as <setup file name>.intunewin in outputFolder and returns the
+// path of the package. setupFile is relative to sourceFolder and must exist in it.
+func CreateIntuneWinPackage(sourceFolder, setupFile, outputFolder string, options *IntuneWinPackagerOptions) (string, error) {
+	if options == nil {
+		options = &IntuneWinPackagerOptions{}
+	}
+
+	setupFilePath := filepath.Join(sourceFolder, setupFile)
+	if info, err := os.Stat(setupFilePath); err != nil || info.IsDir() {
+		return "", fmt.Errorf("setup file %s not found in source folder %s", setupFile, sourceFolder)
+	}
+
+	workDir, err := os.MkdirTemp("", "intunewin")
+	if err != nil {
+		return "", fmt.Errorf("failed to create intunewin working folder: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// Zip the source folder, recording the size and digest of the unencrypted zip
+	plainPath := filepath.Join(workDir, "content.zip")
+	unencryptedSize, fileDigest, err := zipIntuneWinSourceFolder(sourceFolder, plainPath)
+	if err != nil {
+		return "", err
+	}
+
+	encryptedPath := filepath.Join(workDir, intuneWinContentFileName)
+	encryptionInfo, err := encryptIntuneWinContent(plainPath, encryptedPath)
+	if err != nil {
+		return "", err
+	}
+	encryptionInfo.FileDigest = base64.StdEncoding.EncodeToString(fileDigest)
+
+	name := options.Name
+	if name == "" {
+		name = filepath.Base(setupFile)
+	}
+
+	detection := &IntuneWinDetection{
+		ToolVersion:            intuneWinToolVersion,
+		Name:                   name,
+		UnencryptedContentSize: unencryptedSize,
+		FileName:               intuneWinContentFileName,
+		SetupFile:              filepath.ToSlash(setupFile),
+		EncryptionInfo:         *encryptionInfo,
+		MsiInfo:                options.MsiInfo,
+	}
+
+	packageName := strings.TrimSuffix(filepath.Base(setupFile), filepath.Ext(setupFile)) + ".intunewin"
+	packagePath := filepath.Join(outputFolder, packageName)
+	if err := writeIntuneWinPackage(packagePath, detection, encryptedPath); err != nil {
+		return "", err
+	}
+
+	return packagePath, nil
+}
+
+// zipIntuneWinSourceFolder zips every file below sourceFolder to zipPath and returns the size and SHA256 digest of
+// the zip. Symbolic links are followed, as devices cannot resolve them: a link to a file is zipped as a regular
+// file with the content of its target, and a link to a folder is rejected rather than silently left out.
+func zipIntuneWinSourceFolder(sourceFolder, zipPath string) (int64, []byte, error) {
+	out, err := os.Create(zipPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create intunewin content zip: %v", err)
+	}
+	defer out.Close()
+
+	digest := sha256.New()
+	counter := &countingWriter{}
+	archive := zip.NewWriter(io.MultiWriter(out, digest, counter))
+
+	err = filepath.Walk(sourceFolder, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			if target.IsDir() {
+				return fmt.Errorf("%s is a symbolic link to a folder", path)
+			}
+			info = target
+		}
+
+		relativePath, err := filepath.Rel(sourceFolder, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+		header.Method = zip.Deflate
+
+		writer, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(writer, file)
+		return err
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to zip intunewin source folder %s: %v", sourceFolder, err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return 0, nil, fmt.Errorf("failed to zip intunewin source folder %s: %v", sourceFolder, err)
+	}
+
+	return counter.n, digest.Sum(nil), nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// encryptIntuneWinContent encrypts plainPath to encryptedPath with freshly generated keys and returns the
+// encryption info, without the file digest.
+func encryptIntuneWinContent(plainPath, encryptedPath string) (*MobileAppFileEncryptionInfo, error) {
+	encryptionKey, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	macKey, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := os.Open(plainPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open intunewin content zip: %v", err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(encryptedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encrypted intunewin content: %v", err)
+	}
+	defer out.Close()
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reserve space for the HMAC, which is only known once the ciphertext has been written
+	if _, err := out.Write(make([]byte, sha256.Size)); err != nil {
+		return nil, fmt.Errorf("failed to write encrypted intunewin content: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, macKey)
+	writer := io.MultiWriter(out, mac)
+	if _, err := writer.Write(iv); err != nil {
+		return nil, fmt.Errorf("failed to write encrypted intunewin content: %v", err)
+	}
+
+	if err := encryptAESCBC(cipher.NewCBCEncrypter(block, iv), in, writer); err != nil {
+		return nil, fmt.Errorf("failed to encrypt intunewin content: %v", err)
+	}
+
+	macValue := mac.Sum(nil)
+	if _, err := out.WriteAt(macValue, 0); err != nil {
+		return nil, fmt.Errorf("failed to write encrypted intunewin content: %v", err)
+	}
+
+	return &MobileAppFileEncryptionInfo{
+		EncryptionKey:        base64.StdEncoding.EncodeToString(encryptionKey),
+		MacKey:               base64.StdEncoding.EncodeToString(macKey),
+		InitializationVector: base64.StdEncoding.EncodeToString(iv),
+		Mac:                  base64.StdEncoding.EncodeToString(macValue),
+		ProfileIdentifier:    intuneWinProfileIdentifier,
+		FileDigestAlgorithm:  intuneWinFileDigestAlgorithm,
+	}, nil
+}
+
+// encryptAESCBC streams in through the CBC encrypter to out, applying PKCS#7 padding to the final block.
+func encryptAESCBC(encrypter cipher.BlockMode, in io.Reader, out io.Writer) error {
+	buffer := make([]byte, intuneWinEncryptionBufferBytes)
+	for {
+		n, err := io.ReadFull(in, buffer)
+		if err == io.ErrUnexpectedEOF || err == io.EOF {
+			padding := aes.BlockSize - n%aes.BlockSize
+			final := append(buffer[:n:n], bytes.Repeat([]byte{byte(padding)}, padding)...)
+			encrypter.CryptBlocks(final, final)
+			_, err = out.Write(final)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		encrypter.CryptBlocks(buffer, buffer)
+		if _, err := out.Write(buffer); err != nil {
+			return err
+		}
+	}
+}
+
+// randomBytes returns n cryptographically random bytes.
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate intunewin encryption keys: %v", err)
+	}
+	return b, nil
+}
+
+// writeIntuneWinPackage writes the outer zip holding Detection.xml and the encrypted content.
+func writeIntuneWinPackage(packagePath string, detection *IntuneWinDetection, encryptedPath string) error {
+	detectionXML, err := xml.MarshalIndent(detection, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal intunewin Detection.xml: %v", err)
+	}
+
+	out, err := os.Create(packagePath)
+	if err != nil {
+		return fmt.Errorf("failed to create intunewin package %s: %v", packagePath, err)
+	}
+	defer out.Close()
+
+	archive := zip.NewWriter(out)
+
+	// The encrypted content does not compress, so it is stored
+	contentWriter, err := archive.CreateHeader(&zip.FileHeader{Name: intuneWinContentsDir + detection.FileName, Method: zip.Store})
+	if err != nil {
+		return fmt.Errorf("failed to write intunewin package %s: %v", packagePath, err)
+	}
+	encrypted, err := os.Open(encryptedPath)
+	if err != nil {
+		return fmt.Errorf("failed to open encrypted intunewin content: %v", err)
+	}
+	defer encrypted.Close()
+	if _, err := io.Copy(contentWriter, encrypted); err != nil {
+		return fmt.Errorf("failed to write intunewin package %s: %v", packagePath, err)
+	}
+
+	detectionWriter, err := archive.Create(intuneWinDetectionXMLPath)
+	if err != nil {
+		return fmt.Errorf("failed to write intunewin package %s: %v", packagePath, err)
+	}
+	if _, err := detectionWriter.Write(append([]byte(xml.Header), detectionXML...)); err != nil {
+		return fmt.Errorf("failed to write intunewin package %s: %v", packagePath, err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to write intunewin package %s: %v", packagePath, err)
+	}
+
+	return nil
+}
+
+// DecryptContent verifies the HMAC of the encrypted content and writes the decrypted content, the zip of the
+// source folder, to w. The SHA256 digest of the decrypted content is checked against Detection.xml.
+func (p *IntuneWinPackage) DecryptContent(w io.Writer) error {
+	info := p.Detection.EncryptionInfo
+	encryptionKey, err := base64.StdEncoding.DecodeString(info.EncryptionKey)
+	if err != nil {
+		return fmt.Errorf("invalid intunewin encryption key: %v", err)
+	}
+	macKey, err := base64.StdEncoding.DecodeString(info.MacKey)
+	if err != nil {
+		return fmt.Errorf("invalid intunewin mac key: %v", err)
+	}
+
+	// Verify the HMAC before decrypting anything
+	if err := p.verifyContentMac(macKey); err != nil {
+		return err
+	}
+
+	content, err := p.OpenContent()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if _, err := io.CopyN(io.Discard, content, sha256.Size); err != nil {
+		return fmt.Errorf("failed to read intunewin content: %v", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(content, iv); err != nil {
+		return fmt.Errorf("failed to read intunewin content: %v", err)
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return fmt.Errorf("invalid intunewin encryption key: %v", err)
+	}
+
+	digest := sha256.New()
+	if err := decryptAESCBC(cipher.NewCBCDecrypter(block, iv), content, io.MultiWriter(w, digest)); err != nil {
+		return fmt.Errorf("failed to decrypt intunewin content: %v", err)
+	}
+
+	return verifyIntuneWinFileDigest(info, digest)
+}
+
+// verifyContentMac checks the HMAC stored at the start of the encrypted content against the IV and ciphertext.
+func (p *IntuneWinPackage) verifyContentMac(macKey []byte) error {
+	content, err := p.OpenContent()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	storedMac := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(content, storedMac); err != nil {
+		return fmt.Errorf("failed to read intunewin content: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, macKey)
+	if _, err := io.Copy(mac, content); err != nil {
+		return fmt.Errorf("failed to read intunewin content: %v", err)
+	}
+	if !hmac.Equal(storedMac, mac.Sum(nil)) {
+		return fmt.Errorf("intunewin content failed HMAC verification")
+	}
+
+	return nil
+}
+
+// decryptAESCBC streams in through the CBC decrypter to out and removes the PKCS#7 padding of the final block.
+func decryptAESCBC(decrypter cipher.BlockMode, in io.Reader, out io.Writer) error {
+	buffer := make([]byte, intuneWinEncryptionBufferBytes)
+	var pending []byte
+	for {
+		n, err := io.ReadFull(in, buffer)
+		if n%aes.BlockSize != 0 {
+			return fmt.Errorf("ciphertext is not a multiple of the block size")
+		}
+		if n > 0 {
+			if _, werr := out.Write(pending); werr != nil {
+				return werr
+			}
+			decrypter.CryptBlocks(buffer[:n], buffer[:n])
+			pending = append(pending[:0], buffer[:n]...)
+		}
+
+		if err == io.ErrUnexpectedEOF || err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pending) == 0 {
+		return fmt.Errorf("ciphertext is empty")
+	}
+	padding := int(pending[len(pending)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(pending) {
+		return fmt.Errorf("invalid padding")
+	}
+
+	_, err := out.Write(pending[:len(pending)-padding])
+	return err
+}
+
+// verifyIntuneWinFileDigest compares the digest of the decrypted content with Detection.xml.
+func verifyIntuneWinFileDigest(info MobileAppFileEncryptionInfo, digest hash.Hash) error {
+	if info.FileDigest == "" {
+		return nil
+	}
+	if !strings.EqualFold(info.FileDigestAlgorithm, intuneWinFileDigestAlgorithm) {
+		return fmt.Errorf("unsupported intunewin file digest algorithm %s", info.FileDigestAlgorithm)
+	}
+
+	if base64.StdEncoding.EncodeToString(digest.Sum(nil)) != info.FileDigest {
+		return fmt.Errorf("intunewin content does not match the file digest in Detection.xml")
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package intune
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestCreateIntuneWinPackage(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		setupFile string
+		// symlinks maps a link name to its target, relative to the source folder
+		symlinks  map[string]string
+		wantFiles map[string]string
+		wantErr   string
+	}{
+		{
+			name:      "single setup file",
+			files:     map[string]string{"setup.exe": "MZ setup"},
+			setupFile: "setup.exe",
+			wantFiles: map[string]string{"setup.exe": "MZ setup"},
+		},
+		{
+			name: "nested folders",
+			files: map[string]string{
+				"install.ps1":         "Start-Process setup.exe",
+				"bin/setup.exe":       strings.Repeat("x", 4096),
+				"config/settings.ini": "",
+			},
+			setupFile: "install.ps1",
+			wantFiles: map[string]string{
+				"install.ps1":         "Start-Process setup.exe",
+				"bin/setup.exe":       strings.Repeat("x", 4096),
+				"config/settings.ini": "",
+			},
+		},
+		{
+			name:      "symbolic link to a file is zipped as its target",
+			files:     map[string]string{"setup.exe": "MZ setup", "shared/license.txt": "license"},
+			setupFile: "setup.exe",
+			symlinks:  map[string]string{"license.txt": "shared/license.txt"},
+			wantFiles: map[string]string{"setup.exe": "MZ setup", "shared/license.txt": "license", "license.txt": "license"},
+		},
+		{
+			name:      "symbolic link to a folder is rejected",
+			files:     map[string]string{"setup.exe": "MZ setup", "shared/license.txt": "license"},
+			setupFile: "setup.exe",
+			symlinks:  map[string]string{"linked": "shared"},
+			wantErr:   "symbolic link to a folder",
+		},
+		{
+			name:      "missing setup file",
+			files:     map[string]string{"setup.exe": "MZ setup"},
+			setupFile: "missing.exe",
+			wantErr:   "not found in source folder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.symlinks) > 0 && runtime.GOOS == "windows" {
+				t.Skip("creating symbolic links needs extra privileges on Windows")
+			}
+
+			sourceFolder := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(sourceFolder, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for link, target := range tt.symlinks {
+				if err := os.Symlink(filepath.Join(sourceFolder, filepath.FromSlash(target)), filepath.Join(sourceFolder, link)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			packagePath, err := CreateIntuneWinPackage(sourceFolder, tt.setupFile, t.TempDir(), nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("CreateIntuneWinPackage() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateIntuneWinPackage() error = %v", err)
+			}
+
+			pkg, err := OpenIntuneWinPackage(packagePath)
+			if err != nil {
+				t.Fatalf("OpenIntuneWinPackage() error = %v", err)
+			}
+			defer pkg.Close()
+
+			if pkg.Detection.SetupFile != tt.setupFile {
+				t.Errorf("SetupFile = %q, want %q", pkg.Detection.SetupFile, tt.setupFile)
+			}
+			if pkg.Detection.FileName != intuneWinContentFileName {
+				t.Errorf("FileName = %q, want %q", pkg.Detection.FileName, intuneWinContentFileName)
+			}
+
+			checkIntuneWinContentLayout(t, pkg)
+
+			var plain bytes.Buffer
+			if err := pkg.DecryptContent(&plain); err != nil {
+				t.Fatalf("DecryptContent() error = %v", err)
+			}
+			if int64(plain.Len()) != pkg.Detection.UnencryptedContentSize {
+				t.Errorf("decrypted %d bytes, UnencryptedContentSize is %d", plain.Len(), pkg.Detection.UnencryptedContentSize)
+			}
+			digest := sha256.Sum256(plain.Bytes())
+			if got := base64.StdEncoding.EncodeToString(digest[:]); got != pkg.Detection.EncryptionInfo.FileDigest {
+				t.Errorf("FileDigest = %q, want the digest of the decrypted zip %q", pkg.Detection.EncryptionInfo.FileDigest, got)
+			}
+
+			archive, err := zip.NewReader(bytes.NewReader(plain.Bytes()), int64(plain.Len()))
+			if err != nil {
+				t.Fatalf("decrypted content is not a zip: %v", err)
+			}
+			gotFiles := make(map[string]string, len(archive.File))
+			for _, file := range archive.File {
+				if file.Mode()&os.ModeSymlink != 0 {
+					t.Errorf("%s is zipped as a symbolic link", file.Name)
+				}
+				reader, err := file.Open()
+				if err != nil {
+					t.Fatal(err)
+				}
+				content, err := io.ReadAll(reader)
+				reader.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotFiles[file.Name] = string(content)
+			}
+			if len(gotFiles) != len(tt.wantFiles) {
+				t.Errorf("zipped %d files, want %d", len(gotFiles), len(tt.wantFiles))
+			}
+			for name, want := range tt.wantFiles {
+				if got, ok := gotFiles[name]; !ok || got != want {
+					t.Errorf("file %s = %q (present %v), want %q", name, got, ok, want)
+				}
+			}
+		})
+	}
+}
+
+// checkIntuneWinContentLayout checks that the encrypted content is HMAC | IV | ciphertext, with the HMAC and IV
+// recorded in Detection.xml and the HMAC computed over the IV and ciphertext.
+func checkIntuneWinContentLayout(t *testing.T, pkg *IntuneWinPackage) {
+	t.Helper()
+
+	content, err := pkg.OpenContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+	encrypted, err := io.ReadAll(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := pkg.Detection.EncryptionInfo
+	if len(encrypted) < sha256.Size+aes.BlockSize || (len(encrypted)-sha256.Size-aes.BlockSize)%aes.BlockSize != 0 {
+		t.Fatalf("encrypted content of %d bytes is not HMAC | IV | whole blocks", len(encrypted))
+	}
+
+	storedMac, iv, ciphertext := encrypted[:sha256.Size], encrypted[sha256.Size:sha256.Size+aes.BlockSize], encrypted[sha256.Size+aes.BlockSize:]
+	if got := base64.StdEncoding.EncodeToString(storedMac); got != info.Mac {
+		t.Errorf("stored HMAC %q does not match Detection.xml %q", got, info.Mac)
+	}
+	if got := base64.StdEncoding.EncodeToString(iv); got != info.InitializationVector {
+		t.Errorf("stored IV %q does not match Detection.xml %q", got, info.InitializationVector)
+	}
+
+	macKey, err := base64.StdEncoding.DecodeString(info.MacKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mac := hmac.New(sha256.New, macKey)
+	mac.Write(iv)
+	mac.Write(ciphertext)
+	if !hmac.Equal(storedMac, mac.Sum(nil)) {
+		t.Error("stored HMAC is not the HMAC of the IV and ciphertext")
+	}
+}
+
+func TestIntuneWinAESCBCPadding(t *testing.T) {
+	key := bytes.Repeat([]byte{0x42}, 32)
+	iv := bytes.Repeat([]byte{0x24}, aes.BlockSize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		length int
+	}{
+		{"empty", 0},
+		{"one byte", 1},
+		{"one short of a block", aes.BlockSize - 1},
+		{"one block", aes.BlockSize},
+		{"one past a block", aes.BlockSize + 1},
+		{"two blocks", 2 * aes.BlockSize},
+		{"one short of the buffer", intuneWinEncryptionBufferBytes - 1},
+		{"one buffer", intuneWinEncryptionBufferBytes},
+		{"one past the buffer", intuneWinEncryptionBufferBytes + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain := make([]byte, tt.length)
+			for i := range plain {
+				plain[i] = byte(i * 7)
+			}
+
+			var encrypted bytes.Buffer
+			if err := encryptAESCBC(cipher.NewCBCEncrypter(block, iv), bytes.NewReader(plain), &encrypted); err != nil {
+				t.Fatalf("encryptAESCBC() error = %v", err)
+			}
+
+			// PKCS#7 always adds padding, a whole block of it when the content fills its last block
+			wantPadding := aes.BlockSize - tt.length%aes.BlockSize
+			if encrypted.Len() != tt.length+wantPadding {
+				t.Fatalf("ciphertext is %d bytes, want %d", encrypted.Len(), tt.length+wantPadding)
+			}
+
+			padded := make([]byte, encrypted.Len())
+			cipher.NewCBCDecrypter(block, iv).CryptBlocks(padded, encrypted.Bytes())
+			if !bytes.Equal(padded[tt.length:], bytes.Repeat([]byte{byte(wantPadding)}, wantPadding)) {
+				t.Errorf("padding = %v, want %d bytes of %d", padded[tt.length:], wantPadding, wantPadding)
+			}
+
+			var decrypted bytes.Buffer
+			if err := decryptAESCBC(cipher.NewCBCDecrypter(block, iv), bytes.NewReader(encrypted.Bytes()), &decrypted); err != nil {
+				t.Fatalf("decryptAESCBC() error = %v", err)
+			}
+			if !bytes.Equal(decrypted.Bytes(), plain) {
+				t.Errorf("decrypted %d bytes that do not match the %d plaintext bytes", decrypted.Len(), len(plain))
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// graphbeta_device_app_management_intunewin_packages.go
+// Graph Beta Api - Intune: Win32 app packages (.intunewin)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-win32-prepare
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsWindowsMenu/~/windowsApps
+// A .intunewin package is a zip archive holding IntuneWinPackage/Metadata/Detection.xml, which describes the
+// package and the keys it was encrypted with, and IntuneWinPackage/Contents/<FileName>, the encrypted content
+// that is uploaded to Intune.
+
+package intune
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+const (
+	intuneWinDetectionXMLPath = "IntuneWinPackage/Metadata/Detection.xml"
+	intuneWinContentsDir      = "IntuneWinPackage/Contents/"
+)
+
+// IntuneWinDetection represents the Detection.xml metadata of a .intunewin package.
+type IntuneWinDetection struct {
+	XMLName                xml.Name                    `xml:"ApplicationInfo"`
+	ToolVersion            string                      `xml:"ToolVersion,attr,omitempty"`
+	Name                   string                      `xml:"Name"`
+	UnencryptedContentSize int64                       `xml:"UnencryptedContentSize"`
+	FileName               string                      `xml:"FileName"`
+	SetupFile              string                      `xml:"SetupFile"`
+	EncryptionInfo         MobileAppFileEncryptionInfo `xml:"EncryptionInfo"`
+	MsiInfo                *IntuneWinMsiInfo           `xml:"MsiInfo,omitempty"`
+}
+
+// MobileAppFileEncryptionInfo represents the keys and digest of an encrypted app content file. It is read from
+// Detection.xml and sent unchanged when the uploaded file is committed.
+type MobileAppFileEncryptionInfo struct {
+	ODataType            string `xml:"-" json:"@odata.type,omitempty"`
+	EncryptionKey        string `xml:"EncryptionKey" json:"encryptionKey"`
+	MacKey               string `xml:"MacKey" json:"macKey"`
+	InitializationVector string `xml:"InitializationVector" json:"initializationVector"`
+	Mac                  string `xml:"Mac" json:"mac"`
+	ProfileIdentifier    string `xml:"ProfileIdentifier" json:"profileIdentifier"`
+	FileDigest           string `xml:"FileDigest" json:"fileDigest"`
+	FileDigestAlgorithm  string `xml:"FileDigestAlgorithm" json:"fileDigestAlgorithm"`
+}
+
+// IntuneWinMsiInfo represents the MSI properties recorded in Detection.xml when the setup file is an MSI.
+type IntuneWinMsiInfo struct {
+	MsiPublisher                  string `xml:"MsiPublisher"`
+	MsiProductCode                string `xml:"MsiProductCode"`
+	MsiProductVersion             string `xml:"MsiProductVersion"`
+	MsiPackageCode                string `xml:"MsiPackageCode"`
+	MsiUpgradeCode                string `xml:"MsiUpgradeCode"`
+	MsiExecutionContext           string `xml:"MsiExecutionContext"`
+	MsiRequiresLogon              bool   `xml:"MsiRequiresLogon"`
+	MsiRequiresReboot             bool   `xml:"MsiRequiresReboot"`
+	MsiIsMachineInstall           bool   `xml:"MsiIsMachineInstall"`
+	MsiIsUserInstall              bool   `xml:"MsiIsUserInstall"`
+	MsiIncludesServices           bool   `xml:"MsiIncludesServices"`
+	MsiIncludesODBCDataSource     bool   `xml:"MsiIncludesODBCDataSource"`
+	MsiContainsSystemRegistryKeys bool   `xml:"MsiContainsSystemRegistryKeys"`
+	MsiContainsSystemFolders      bool   `xml:"MsiContainsSystemFolders"`
+}
+
+// Win32LobAppMsiInformation converts the MSI properties to the msiInformation of a win32LobApp.
+func (m *IntuneWinMsiInfo) Win32LobAppMsiInformation(productName string) *Win32LobAppMsiInformation {
+	packageType := Win32LobAppMsiPackageTypePerMachine
+	switch {
+	case m.MsiIsMachineInstall && m.MsiIsUserInstall:
+		packageType = Win32LobAppMsiPackageTypeDualPurpose
+	case m.MsiIsUserInstall:
+		packageType = Win32LobAppMsiPackageTypePerUser
+	}
+
+	return &Win32LobAppMsiInformation{
+		ProductCode:    m.MsiProductCode,
+		ProductVersion: m.MsiProductVersion,
+		UpgradeCode:    m.MsiUpgradeCode,
+		RequiresReboot: m.MsiRequiresReboot,
+		PackageType:    packageType,
+		ProductName:    productName,
+		Publisher:      m.MsiPublisher,
+	}
+}
+
+// ParseIntuneWinDetectionXML decodes Detection.xml.
+func ParseIntuneWinDetectionXML(data []byte) (*IntuneWinDetection, error) {
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+
+	var detection IntuneWinDetection
+	if err := xml.Unmarshal(data, &detection); err != nil {
+		return nil, fmt.Errorf("failed to parse intunewin Detection.xml: %v", err)
+	}
+
+	if detection.FileName == "" {
+		return nil, fmt.Errorf("intunewin Detection.xml does not name the content file")
+	}
+	if detection.EncryptionInfo.EncryptionKey == "" || detection.EncryptionInfo.MacKey == "" {
+		return nil, fmt.Errorf("intunewin Detection.xml does not contain encryption info")
+	}
+
+	return &detection, nil
+}
+
+// IntuneWinPackage represents an opened .intunewin package. Close must be called when it is no longer needed.
+type IntuneWinPackage struct {
+	Detection *IntuneWinDetection
+
+	archive *zip.ReadCloser
+	content *zip.File
+}
+
+// OpenIntuneWinPackage opens a .intunewin package, parses its Detection.xml and locates the encrypted content.
+func OpenIntuneWinPackage(filePath string) (*IntuneWinPackage, error) {
+	archive, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open intunewin package %s: %v", filePath, err)
+	}
+
+	pkg, err := newIntuneWinPackage(archive)
+	if err != nil {
+		archive.Close()
+		return nil, fmt.Errorf("invalid intunewin package %s: %v", filePath, err)
+	}
+
+	return pkg, nil
+}
+
+func newIntuneWinPackage(archive *zip.ReadCloser) (*IntuneWinPackage, error) {
+	var detectionFile *zip.File
+	for _, file := range archive.File {
+		if strings.EqualFold(file.Name, intuneWinDetectionXMLPath) {
+			detectionFile = file
+			break
+		}
+	}
+	if detectionFile == nil {
+		return nil, fmt.Errorf("%s not found", intuneWinDetectionXMLPath)
+	}
+
+	reader, err := detectionFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	detection, err := ParseIntuneWinDetectionXML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	contentPath := intuneWinContentsDir + detection.FileName
+	for _, file := range archive.File {
+		if strings.EqualFold(file.Name, contentPath) {
+			return &IntuneWinPackage{Detection: detection, archive: archive, content: file}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("encrypted content %s not found", contentPath)
+}
+
+// FileName returns the name of the encrypted content file, which is used as the fileName of the win32LobApp.
+func (p *IntuneWinPackage) FileName() string {
+	return path.Base(p.content.Name)
+}
+
+// EncryptedSize returns the size in bytes of the encrypted content.
+func (p *IntuneWinPackage) EncryptedSize() int64 {
+	return int64(p.content.UncompressedSize64)
+}
+
+// OpenContent opens the encrypted content for reading.
+func (p *IntuneWinPackage) OpenContent() (io.ReadCloser, error) {
+	return p.content.Open()
+}
+
+// Close closes the underlying archive.
+func (p *IntuneWinPackage) Close() error {
+	return p.archive.Close()
+}
@@ -0,0 +1,522 @@
+// graphbeta_device_app_management_managed_app_protections.go
+// Graph Beta Api - Intune: App protection policies (iOS/iPadOS and Android)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-protection-policy
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-protection-policy-settings-ios
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-protection-policy-settings-android
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/protection
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-mam-iosmanagedappprotection?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-mam-androidmanagedappprotection?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// iOS and Android app protection policies live in separate collections. A single resource struct carries the
+// settings of both platforms and the collection is selected by ODataType. Durations such as
+// PeriodOfflineBeforeAccessCheck are ISO 8601 durations, e.g. PT12H or P90D.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaIOSManagedAppProtections     = "/beta/deviceAppManagement/iosManagedAppProtections"
+	uriBetaAndroidManagedAppProtections = "/beta/deviceAppManagement/androidManagedAppProtections"
+
+	ODataTypeIOSManagedAppProtection     = "#microsoft.graph.iosManagedAppProtection"
+	ODataTypeAndroidManagedAppProtection = "#microsoft.graph.androidManagedAppProtection"
+)
+
+// Values for the data transfer settings of an app protection policy.
+const (
+	ManagedAppDataTransferLevelAllApps     = "allApps"
+	ManagedAppDataTransferLevelManagedApps = "managedApps"
+	ManagedAppDataTransferLevelNone        = "none"
+
+	ManagedAppClipboardSharingLevelAllApps                = "allApps"
+	ManagedAppClipboardSharingLevelManagedAppsWithPasteIn = "managedAppsWithPasteIn"
+	ManagedAppClipboardSharingLevelManagedApps            = "managedApps"
+	ManagedAppClipboardSharingLevelBlocked                = "blocked"
+
+	ManagedAppDataStorageLocationOneDriveForBusiness = "oneDriveForBusiness"
+	ManagedAppDataStorageLocationSharePoint          = "sharePoint"
+	ManagedAppDataStorageLocationBox                 = "box"
+	ManagedAppDataStorageLocationLocalStorage        = "localStorage"
+	ManagedAppDataStorageLocationPhotoLibrary        = "photoLibrary"
+
+	ManagedAppNotificationRestrictionAllow                   = "allow"
+	ManagedAppNotificationRestrictionBlockOrganizationalData = "blockOrganizationalData"
+	ManagedAppNotificationRestrictionBlock                   = "block"
+
+	ManagedAppDataEncryptionTypeUseDeviceSettings               = "useDeviceSettings"
+	ManagedAppDataEncryptionTypeAfterDeviceRestart              = "afterDeviceRestart"
+	ManagedAppDataEncryptionTypeWhenDeviceLockedExceptOpenFiles = "whenDeviceLockedExceptOpenFiles"
+	ManagedAppDataEncryptionTypeWhenDeviceLocked                = "whenDeviceLocked"
+)
+
+// Values for the access requirements (PIN) settings of an app protection policy.
+const (
+	ManagedAppPinCharacterSetNumeric               = "numeric"
+	ManagedAppPinCharacterSetAlphanumericAndSymbol = "alphanumericAndSymbol"
+
+	managedAppPinMinimumLength = 4
+	managedAppPinMaximumLength = 14
+)
+
+// Values for the conditional launch settings of an app protection policy.
+const (
+	ManagedAppRemediationActionBlock                       = "block"
+	ManagedAppRemediationActionWipe                        = "wipe"
+	ManagedAppRemediationActionWarn                        = "warn"
+	ManagedAppRemediationActionBlockWhenSettingIsSupported = "blockWhenSettingIsSupported"
+
+	ManagedAppDeviceThreatLevelNotConfigured = "notConfigured"
+	ManagedAppDeviceThreatLevelSecured       = "secured"
+	ManagedAppDeviceThreatLevelLow           = "low"
+	ManagedAppDeviceThreatLevelMedium        = "medium"
+	ManagedAppDeviceThreatLevelHigh          = "high"
+
+	AndroidManagedAppSafetyNetDeviceAttestationTypeNone                                 = "none"
+	AndroidManagedAppSafetyNetDeviceAttestationTypeBasicIntegrity                       = "basicIntegrity"
+	AndroidManagedAppSafetyNetDeviceAttestationTypeBasicIntegrityAndDeviceCertification = "basicIntegrityAndDeviceCertification"
+)
+
+// ResponseManagedAppProtectionsList represents a list of app protection policies of one platform.
+type ResponseManagedAppProtectionsList struct {
+	ODataContext  string                         `json:"@odata.context"`
+	ODataNextLink string                         `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceManagedAppProtection `json:"value"`
+}
+
+// ResourceManagedAppProtection represents an iOS or Android app protection policy. It is used as both the
+// request and response structure; Apps and Assignments are only populated when retrieved by ID.
+type ResourceManagedAppProtection struct {
+	ODataType                   string                               `json:"@odata.type"`
+	ID                          string                               `json:"id,omitempty"`
+	DisplayName                 string                               `json:"displayName"`
+	Description                 string                               `json:"description,omitempty"`
+	CreatedDateTime             *time.Time                           `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime        *time.Time                           `json:"lastModifiedDateTime,omitempty"`
+	RoleScopeTagIds             []string                             `json:"roleScopeTagIds,omitempty"`
+	Version                     string                               `json:"version,omitempty"`
+	IsAssigned                  bool                                 `json:"isAssigned,omitempty"`
+	DeployedAppCount            int                                  `json:"deployedAppCount,omitempty"`
+	TargetedAppManagementLevels string                               `json:"targetedAppManagementLevels,omitempty"`
+	AppGroupType                string                               `json:"appGroupType,omitempty"`
+	Apps                        []ManagedMobileApp                   `json:"apps,omitempty"`
+	Assignments                 []TargetedManagedAppPolicyAssignment `json:"assignments,omitempty"`
+
+	// Data protection
+	PeriodOfflineBeforeAccessCheck                 string `json:"periodOfflineBeforeAccessCheck,omitempty"`
+	PeriodOnlineBeforeAccessCheck                  string `json:"periodOnlineBeforeAccessCheck,omitempty"`
+	AllowedInboundDataTransferSources              string `json:"allowedInboundDataTransferSources,omitempty"`
+	AllowedOutboundDataTransferDestinations        string `json:"allowedOutboundDataTransferDestinations,omitempty"`
+	AllowedOutboundClipboardSharingLevel           string `json:"allowedOutboundClipboardSharingLevel,omitempty"`
+	AllowedOutboundClipboardSharingExceptionLength int    `json:"allowedOutboundClipboardSharingExceptionLength,omitempty"`
+	OrganizationalCredentialsRequired              bool   `json:"organizationalCredentialsRequired"`
+	DataBackupBlocked                              bool   `json:"dataBackupBlocked"`
+	ManagedBrowserToOpenLinksRequired              bool   `json:"managedBrowserToOpenLinksRequired"`
+	// Values: notConfigured, microsoftEdge
+	ManagedBrowser                              string   `json:"managedBrowser,omitempty"`
+	SaveAsBlocked                               bool     `json:"saveAsBlocked"`
+	AllowedDataStorageLocations                 []string `json:"allowedDataStorageLocations,omitempty"`
+	AllowedDataIngestionLocations               []string `json:"allowedDataIngestionLocations,omitempty"`
+	BlockDataIngestionIntoOrganizationDocuments bool     `json:"blockDataIngestionIntoOrganizationDocuments"`
+	ContactSyncBlocked                          bool     `json:"contactSyncBlocked"`
+	PrintBlocked                                bool     `json:"printBlocked"`
+	NotificationRestriction                     string   `json:"notificationRestriction,omitempty"`
+	// Values: allow, blocked, managedApps, customApp
+	DialerRestrictionLevel string `json:"dialerRestrictionLevel,omitempty"`
+
+	// Access requirements
+	PinRequired                          bool   `json:"pinRequired"`
+	PinCharacterSet                      string `json:"pinCharacterSet,omitempty"`
+	MinimumPinLength                     int    `json:"minimumPinLength,omitempty"`
+	SimplePinBlocked                     bool   `json:"simplePinBlocked"`
+	MaximumPinRetries                    int    `json:"maximumPinRetries,omitempty"`
+	PeriodBeforePinReset                 string `json:"periodBeforePinReset,omitempty"`
+	PreviousPinBlockCount                int    `json:"previousPinBlockCount,omitempty"`
+	FingerprintBlocked                   bool   `json:"fingerprintBlocked"`
+	PinRequiredInsteadOfBiometricTimeout string `json:"pinRequiredInsteadOfBiometricTimeout,omitempty"`
+	DisableAppPinIfDevicePinIsSet        bool   `json:"disableAppPinIfDevicePinIsSet"`
+
+	// Conditional launch
+	PeriodOfflineBeforeWipeIsEnforced         string `json:"periodOfflineBeforeWipeIsEnforced,omitempty"`
+	AppActionIfMaximumPinRetriesExceeded      string `json:"appActionIfMaximumPinRetriesExceeded,omitempty"`
+	AppActionIfUnableToAuthenticateUser       string `json:"appActionIfUnableToAuthenticateUser,omitempty"`
+	DeviceComplianceRequired                  bool   `json:"deviceComplianceRequired"`
+	AppActionIfDeviceComplianceRequired       string `json:"appActionIfDeviceComplianceRequired,omitempty"`
+	MinimumRequiredOsVersion                  string `json:"minimumRequiredOsVersion,omitempty"`
+	MinimumWarningOsVersion                   string `json:"minimumWarningOsVersion,omitempty"`
+	MinimumWipeOsVersion                      string `json:"minimumWipeOsVersion,omitempty"`
+	MinimumRequiredAppVersion                 string `json:"minimumRequiredAppVersion,omitempty"`
+	MinimumWarningAppVersion                  string `json:"minimumWarningAppVersion,omitempty"`
+	MinimumWipeAppVersion                     string `json:"minimumWipeAppVersion,omitempty"`
+	MaximumAllowedDeviceThreatLevel           string `json:"maximumAllowedDeviceThreatLevel,omitempty"`
+	MobileThreatDefenseRemediationAction      string `json:"mobileThreatDefenseRemediationAction,omitempty"`
+	GracePeriodToBlockAppsDuringOffClockHours string `json:"gracePeriodToBlockAppsDuringOffClockHours,omitempty"`
+
+	// Fields for iosManagedAppProtection
+	AppDataEncryptionType                        string         `json:"appDataEncryptionType,omitempty"`
+	FaceIdBlocked                                *bool          `json:"faceIdBlocked,omitempty"`
+	ExemptedAppProtocols                         []KeyValuePair `json:"exemptedAppProtocols,omitempty"`
+	ExemptedUniversalLinks                       []string       `json:"exemptedUniversalLinks,omitempty"`
+	ManagedUniversalLinks                        []string       `json:"managedUniversalLinks,omitempty"`
+	CustomBrowserProtocol                        string         `json:"customBrowserProtocol,omitempty"`
+	CustomDialerAppProtocol                      string         `json:"customDialerAppProtocol,omitempty"`
+	ThirdPartyKeyboardsBlocked                   *bool          `json:"thirdPartyKeyboardsBlocked,omitempty"`
+	FilterOpenInToOnlyManagedApps                *bool          `json:"filterOpenInToOnlyManagedApps,omitempty"`
+	DisableProtectionOfManagedOutboundOpenInData *bool          `json:"disableProtectionOfManagedOutboundOpenInData,omitempty"`
+	ProtectInboundDataFromUnknownSources         *bool          `json:"protectInboundDataFromUnknownSources,omitempty"`
+	MinimumRequiredSdkVersion                    string         `json:"minimumRequiredSdkVersion,omitempty"`
+	MinimumWarningSdkVersion                     string         `json:"minimumWarningSdkVersion,omitempty"`
+	MinimumWipeSdkVersion                        string         `json:"minimumWipeSdkVersion,omitempty"`
+	AllowedIosDeviceModels                       string         `json:"allowedIosDeviceModels,omitempty"`
+	AppActionIfIosDeviceModelNotAllowed          string         `json:"appActionIfIosDeviceModelNotAllowed,omitempty"`
+
+	// Fields for androidManagedAppProtection
+	ScreenCaptureBlocked                               *bool          `json:"screenCaptureBlocked,omitempty"`
+	EncryptAppData                                     *bool          `json:"encryptAppData,omitempty"`
+	DisableAppEncryptionIfDeviceEncryptionIsEnabled    *bool          `json:"disableAppEncryptionIfDeviceEncryptionIsEnabled,omitempty"`
+	ExemptedAppPackages                                []KeyValuePair `json:"exemptedAppPackages,omitempty"`
+	CustomBrowserPackageId                             string         `json:"customBrowserPackageId,omitempty"`
+	CustomBrowserDisplayName                           string         `json:"customBrowserDisplayName,omitempty"`
+	CustomDialerAppPackageId                           string         `json:"customDialerAppPackageId,omitempty"`
+	CustomDialerAppDisplayName                         string         `json:"customDialerAppDisplayName,omitempty"`
+	KeyboardsRestricted                                *bool          `json:"keyboardsRestricted,omitempty"`
+	ApprovedKeyboards                                  []KeyValuePair `json:"approvedKeyboards,omitempty"`
+	BiometricAuthenticationBlocked                     *bool          `json:"biometricAuthenticationBlocked,omitempty"`
+	ConnectToVpnOnLaunch                               *bool          `json:"connectToVpnOnLaunch,omitempty"`
+	DeviceLockRequired                                 *bool          `json:"deviceLockRequired,omitempty"`
+	AppActionIfDeviceLockNotSet                        string         `json:"appActionIfDeviceLockNotSet,omitempty"`
+	MinimumRequiredPatchVersion                        string         `json:"minimumRequiredPatchVersion,omitempty"`
+	MinimumWarningPatchVersion                         string         `json:"minimumWarningPatchVersion,omitempty"`
+	MinimumWipePatchVersion                            string         `json:"minimumWipePatchVersion,omitempty"`
+	MinimumRequiredCompanyPortalVersion                string         `json:"minimumRequiredCompanyPortalVersion,omitempty"`
+	MinimumWarningCompanyPortalVersion                 string         `json:"minimumWarningCompanyPortalVersion,omitempty"`
+	MinimumWipeCompanyPortalVersion                    string         `json:"minimumWipeCompanyPortalVersion,omitempty"`
+	AllowedAndroidDeviceManufacturers                  string         `json:"allowedAndroidDeviceManufacturers,omitempty"`
+	AppActionIfAndroidDeviceManufacturerNotAllowed     string         `json:"appActionIfAndroidDeviceManufacturerNotAllowed,omitempty"`
+	AllowedAndroidDeviceModels                         []string       `json:"allowedAndroidDeviceModels,omitempty"`
+	AppActionIfAndroidDeviceModelNotAllowed            string         `json:"appActionIfAndroidDeviceModelNotAllowed,omitempty"`
+	RequiredAndroidSafetyNetDeviceAttestationType      string         `json:"requiredAndroidSafetyNetDeviceAttestationType,omitempty"`
+	AppActionIfAndroidSafetyNetDeviceAttestationFailed string         `json:"appActionIfAndroidSafetyNetDeviceAttestationFailed,omitempty"`
+	// Values: none, enabled
+	RequiredAndroidSafetyNetAppsVerificationType      string `json:"requiredAndroidSafetyNetAppsVerificationType,omitempty"`
+	AppActionIfAndroidSafetyNetAppsVerificationFailed string `json:"appActionIfAndroidSafetyNetAppsVerificationFailed,omitempty"`
+	// Values: basic, hardwareBacked
+	RequiredAndroidSafetyNetEvaluationType string `json:"requiredAndroidSafetyNetEvaluationType,omitempty"`
+}
+
+// managedAppProtectionEndpoint returns the collection of app protection policies of the platform selected by
+// odataType.
+func managedAppProtectionEndpoint(odataType string) (string, error) {
+	switch odataType {
+	case ODataTypeIOSManagedAppProtection:
+		return uriBetaIOSManagedAppProtections, nil
+	case ODataTypeAndroidManagedAppProtection:
+		return uriBetaAndroidManagedAppProtections, nil
+	}
+
+	return "", fmt.Errorf("unsupported app protection policy @odata.type: %q", odataType)
+}
+
+// validateManagedAppProtection checks the PIN settings and that the targeted apps match the platform of the
+// policy.
+func validateManagedAppProtection(request *ResourceManagedAppProtection) error {
+	if request.PinRequired && request.MinimumPinLength != 0 &&
+		(request.MinimumPinLength < managedAppPinMinimumLength || request.MinimumPinLength > managedAppPinMaximumLength) {
+		return fmt.Errorf("minimum PIN length must be between %d and %d, got %d", managedAppPinMinimumLength, managedAppPinMaximumLength, request.MinimumPinLength)
+	}
+
+	switch request.PinCharacterSet {
+	case "", ManagedAppPinCharacterSetNumeric, ManagedAppPinCharacterSetAlphanumericAndSymbol:
+	default:
+		return fmt.Errorf("unsupported PIN character set: %q", request.PinCharacterSet)
+	}
+
+	return validateManagedMobileApps(request.ODataType, request.Apps)
+}
+
+// validateManagedMobileApps checks that apps are identified by bundle ID for iOS and by package ID for Android.
+func validateManagedMobileApps(odataType string, apps []ManagedMobileApp) error {
+	wantIdentifier := ODataTypeIOSMobileAppIdentifier
+	if odataType == ODataTypeAndroidManagedAppProtection {
+		wantIdentifier = ODataTypeAndroidMobileAppIdentifier
+	}
+
+	for _, app := range apps {
+		identifier := app.MobileAppIdentifier
+		if identifier.ODataType != wantIdentifier {
+			return fmt.Errorf("app %s%s is not a %s", identifier.BundleID, identifier.PackageID, wantIdentifier)
+		}
+		if identifier.BundleID == "" && identifier.PackageID == "" {
+			return fmt.Errorf("app identifier %s has no bundle or package ID", identifier.ODataType)
+		}
+	}
+
+	return nil
+}
+
+// GetManagedAppProtections retrieves the app protection policies of one platform, ODataTypeIOSManagedAppProtection
+// or ODataTypeAndroidManagedAppProtection.
+func (c *Client) GetManagedAppProtections(odataType string) (*ResponseManagedAppProtectionsList, error) {
+	endpoint, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "app protection policies", err)
+	}
+
+	var policies ResponseManagedAppProtectionsList
+
+	for endpoint != "" {
+		var page ResponseManagedAppProtectionsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "app protection policies", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if policies.ODataContext == "" {
+			policies.ODataContext = page.ODataContext
+		}
+		policies.Value = append(policies.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "app protection policies", err)
+			}
+		}
+	}
+
+	return &policies, nil
+}
+
+// GetManagedAppProtectionByID retrieves an app protection policy by its ID with its targeted apps and
+// assignments.
+func (c *Client) GetManagedAppProtectionByID(odataType, policyID string) (*ResourceManagedAppProtection, error) {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "app protection policy", policyID, err)
+	}
+	endpoint := fmt.Sprintf("%s/%s?%s", uri, policyID, managedAppPolicyExpand)
+
+	var policy ResourceManagedAppProtection
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policy)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "app protection policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &policy, nil
+}
+
+// GetManagedAppProtectionByDisplayName retrieves an app protection policy by its display name with its targeted
+// apps and assignments.
+func (c *Client) GetManagedAppProtectionByDisplayName(odataType, displayName string) (*ResourceManagedAppProtection, error) {
+	policies, err := c.GetManagedAppProtections(odataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "app protection policy", displayName, err)
+	}
+
+	var policyID string
+	for _, policy := range policies.Value {
+		if policy.DisplayName == displayName {
+			policyID = policy.ID
+			break
+		}
+	}
+
+	if policyID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "app protection policy", displayName, "policy not found")
+	}
+
+	return c.GetManagedAppProtectionByID(odataType, policyID)
+}
+
+// CreateManagedAppProtection creates an app protection policy for the platform selected by ODataType. Apps in
+// the request are targeted with the targetApps action once the policy has been created.
+func (c *Client) CreateManagedAppProtection(request *ResourceManagedAppProtection) (*ResourceManagedAppProtection, error) {
+	endpoint, err := managedAppProtectionEndpoint(request.ODataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "app protection policy", err)
+	}
+
+	if err := validateManagedAppProtection(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "app protection policy", err)
+	}
+
+	// Exclude navigation properties from the request object
+	policy := *request
+	policy.Apps = nil
+	policy.Assignments = nil
+
+	var createdPolicy ResourceManagedAppProtection
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &policy, &createdPolicy)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "app protection policy", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if len(request.Apps) > 0 {
+		if err := c.targetManagedAppPolicyApps(endpoint, "app protection policy", createdPolicy.ID, request.AppGroupType, request.Apps); err != nil {
+			return nil, err
+		}
+		createdPolicy.Apps = request.Apps
+	}
+
+	return &createdPolicy, nil
+}
+
+// CreateManagedAppProtectionWithAssignment creates an app protection policy and assigns it.
+func (c *Client) CreateManagedAppProtectionWithAssignment(request *ResourceManagedAppProtection, assignment *AssignmentTargetedManagedAppPolicy) (*ResourceManagedAppProtection, error) {
+	createdPolicy, err := c.CreateManagedAppProtection(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateManagedAppProtectionAssignment(createdPolicy.ODataType, createdPolicy.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdPolicy, nil
+}
+
+// UpdateManagedAppProtectionByID updates the settings of an app protection policy using the PATCH method. When
+// Apps is not nil the targeted apps are replaced as well, so a policy read from source control can be applied
+// as a whole. Assignments are not changed; use CreateManagedAppProtectionAssignment.
+func (c *Client) UpdateManagedAppProtectionByID(policyID string, request *ResourceManagedAppProtection) error {
+	uri, err := managedAppProtectionEndpoint(request.ODataType)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "app protection policy", policyID, err)
+	}
+
+	if err := validateManagedAppProtection(request); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "app protection policy", policyID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uri, policyID)
+
+	// Exclude navigation and read-only properties from the request object
+	patch := *request
+	patch.ID = ""
+	patch.Apps = nil
+	patch.Assignments = nil
+	patch.CreatedDateTime = nil
+	patch.LastModifiedDateTime = nil
+	patch.IsAssigned = false
+	patch.DeployedAppCount = 0
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "app protection policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if request.Apps != nil {
+		return c.targetManagedAppPolicyApps(uri, "app protection policy", policyID, request.AppGroupType, request.Apps)
+	}
+
+	return nil
+}
+
+// UpdateManagedAppProtectionByDisplayName updates an app protection policy by its display name.
+func (c *Client) UpdateManagedAppProtectionByDisplayName(displayName string, request *ResourceManagedAppProtection) error {
+	policy, err := c.GetManagedAppProtectionByDisplayName(request.ODataType, displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "app protection policy", displayName, err)
+	}
+
+	return c.UpdateManagedAppProtectionByID(policy.ID, request)
+}
+
+// DeleteManagedAppProtectionByID deletes an app protection policy by its ID.
+func (c *Client) DeleteManagedAppProtectionByID(odataType, policyID string) error {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "app protection policy", policyID, err)
+	}
+	endpoint := fmt.Sprintf("%s/%s", uri, policyID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "app protection policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteManagedAppProtectionByDisplayName deletes an app protection policy by its display name.
+func (c *Client) DeleteManagedAppProtectionByDisplayName(odataType, displayName string) error {
+	policy, err := c.GetManagedAppProtectionByDisplayName(odataType, displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "app protection policy", displayName, err)
+	}
+
+	return c.DeleteManagedAppProtectionByID(odataType, policy.ID)
+}
+
+// TargetManagedAppProtectionApps replaces the apps protected by an app protection policy. appGroupType is one of
+// the TargetedManagedAppGroupType values; apps are only used with TargetedManagedAppGroupTypeSelectedPublicApps.
+func (c *Client) TargetManagedAppProtectionApps(odataType, policyID, appGroupType string, apps []ManagedMobileApp) error {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "app protection policy apps", policyID, err)
+	}
+
+	if err := validateManagedMobileApps(odataType, apps); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "app protection policy apps", policyID, err)
+	}
+
+	return c.targetManagedAppPolicyApps(uri, "app protection policy", policyID, appGroupType, apps)
+}
+
+// GetManagedAppProtectionAssignments retrieves the assignments of an app protection policy.
+func (c *Client) GetManagedAppProtectionAssignments(odataType, policyID string) (*ResponseTargetedManagedAppPolicyAssignmentsList, error) {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "app protection policy assignments", policyID, err)
+	}
+
+	return c.getTargetedManagedAppPolicyAssignments(uri, "app protection policy", policyID)
+}
+
+// CreateManagedAppProtectionAssignment assigns an app protection policy using the assign action. The supplied
+// assignments replace any existing assignments of the policy.
+func (c *Client) CreateManagedAppProtectionAssignment(odataType, policyID string, assignment *AssignmentTargetedManagedAppPolicy) error {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "app protection policy", policyID, err)
+	}
+
+	return c.assignTargetedManagedAppPolicy(uri, "app protection policy", policyID, assignment)
+}
+
+// GetManagedAppProtectionDeploymentSummary retrieves the number of users an app protection policy has been
+// applied to, in total and per app.
+func (c *Client) GetManagedAppProtectionDeploymentSummary(odataType, policyID string) (*ResponseManagedAppPolicyDeploymentSummary, error) {
+	uri, err := managedAppProtectionEndpoint(odataType)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "app protection policy deployment summary", policyID, err)
+	}
+
+	return c.getManagedAppPolicyDeploymentSummary(uri, "app protection policy", policyID)
+}
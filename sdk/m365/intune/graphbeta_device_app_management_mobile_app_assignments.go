@@ -0,0 +1,184 @@
+// graphbeta_device_app_management_mobile_app_assignments.go
+// Graph Beta Api - Intune: App assignments
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-deploy
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/allApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-mobileappassignment?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	odataTypeMobileAppAssignment = "#microsoft.graph.mobileAppAssignment"
+
+	ODataTypeWin32LobAppAssignmentSettings                  = "#microsoft.graph.win32LobAppAssignmentSettings"
+	ODataTypeWinGetAppAssignmentSettings                    = "#microsoft.graph.winGetAppAssignmentSettings"
+	ODataTypeIOSVppAppAssignmentSettings                    = "#microsoft.graph.iosVppAppAssignmentSettings"
+	ODataTypeMicrosoftStoreForBusinessAppAssignmentSettings = "#microsoft.graph.microsoftStoreForBusinessAppAssignmentSettings"
+	ODataTypeMacOSLobAppAssignmentSettings                  = "#microsoft.graph.macOsLobAppAssignmentSettings"
+
+	MobileAppAssignmentIntentAvailable                  = "available"
+	MobileAppAssignmentIntentRequired                   = "required"
+	MobileAppAssignmentIntentUninstall                  = "uninstall"
+	MobileAppAssignmentIntentAvailableWithoutEnrollment = "availableWithoutEnrollment"
+
+	MobileAppNotificationsShowAll    = "showAll"
+	MobileAppNotificationsShowReboot = "showReboot"
+	MobileAppNotificationsHideAll    = "hideAll"
+
+	Win32LobAppDeliveryOptimizationPriorityNotConfigured = "notConfigured"
+	Win32LobAppDeliveryOptimizationPriorityForeground    = "foreground"
+)
+
+// ResponseMobileAppAssignmentsList represents the assignments of an app.
+type ResponseMobileAppAssignmentsList struct {
+	ODataContext string                `json:"@odata.context"`
+	Value        []MobileAppAssignment `json:"value"`
+}
+
+// AssignmentMobileApp represents the request body of the app assign action.
+type AssignmentMobileApp struct {
+	MobileAppAssignments []MobileAppAssignment `json:"mobileAppAssignments"`
+}
+
+// MobileAppAssignment represents the assignment of an app to a group with an install intent.
+type MobileAppAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Intent    string                                 `json:"intent"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+	Settings  *MobileAppAssignmentSettings           `json:"settings,omitempty"`
+	Source    string                                 `json:"source,omitempty"`
+	SourceID  string                                 `json:"sourceId,omitempty"`
+}
+
+// MobileAppAssignmentSettings represents the type specific settings of an app assignment. The settings type is
+// selected by ODataType and must match the type of the app.
+type MobileAppAssignmentSettings struct {
+	ODataType string `json:"@odata.type"`
+
+	// Fields for win32LobApp and winGetApp
+	Notifications       string                        `json:"notifications,omitempty"`
+	RestartSettings     *MobileAppRestartSettings     `json:"restartSettings,omitempty"`
+	InstallTimeSettings *MobileAppInstallTimeSettings `json:"installTimeSettings,omitempty"`
+
+	// Fields for win32LobApp
+	DeliveryOptimizationPriority string `json:"deliveryOptimizationPriority,omitempty"`
+
+	// Fields for iosVppApp and macOSLobApp
+	UninstallOnDeviceRemoval *bool `json:"uninstallOnDeviceRemoval,omitempty"`
+
+	// Fields for iosVppApp
+	UseDeviceLicensing      bool   `json:"useDeviceLicensing,omitempty"`
+	VpnConfigurationID      string `json:"vpnConfigurationId,omitempty"`
+	IsRemovable             *bool  `json:"isRemovable,omitempty"`
+	PreventManagedAppBackup *bool  `json:"preventManagedAppBackup,omitempty"`
+	PreventAutoAppUpdate    *bool  `json:"preventAutoAppUpdate,omitempty"`
+
+	// Fields for microsoftStoreForBusinessApp
+	UseDeviceContext bool `json:"useDeviceContext,omitempty"`
+}
+
+// MobileAppRestartSettings represents the restart grace period offered after a required install.
+type MobileAppRestartSettings struct {
+	GracePeriodInMinutes                       int `json:"gracePeriodInMinutes"`
+	CountdownDisplayBeforeRestartInMinutes     int `json:"countdownDisplayBeforeRestartInMinutes"`
+	RestartNotificationSnoozeDurationInMinutes int `json:"restartNotificationSnoozeDurationInMinutes,omitempty"`
+}
+
+// MobileAppInstallTimeSettings represents when an app becomes available and its installation deadline.
+type MobileAppInstallTimeSettings struct {
+	UseLocalTime     bool   `json:"useLocalTime"`
+	StartDateTime    string `json:"startDateTime,omitempty"`
+	DeadlineDateTime string `json:"deadlineDateTime,omitempty"`
+}
+
+// validateMobileAppAssignments checks the intents and that no target is assigned more than once, which Intune
+// rejects.
+func validateMobileAppAssignments(assignments []MobileAppAssignment) error {
+	targets := make(map[string]string, len(assignments))
+
+	for _, assignment := range assignments {
+		switch assignment.Intent {
+		case MobileAppAssignmentIntentAvailable,
+			MobileAppAssignmentIntentRequired,
+			MobileAppAssignmentIntentUninstall,
+			MobileAppAssignmentIntentAvailableWithoutEnrollment:
+		default:
+			return fmt.Errorf("unsupported mobile app assignment intent: %q", assignment.Intent)
+		}
+
+		target := assignment.Target.ODataType + "/" + assignment.Target.GroupID
+		if intent, ok := targets[target]; ok {
+			return fmt.Errorf("target %s is assigned with both %s and %s intents", target, intent, assignment.Intent)
+		}
+		targets[target] = assignment.Intent
+	}
+
+	return nil
+}
+
+// GetMobileAppAssignments retrieves the assignments of an app.
+func (c *Client) GetMobileAppAssignments(appID string) (*ResponseMobileAppAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaMobileApps, appID)
+
+	var assignments ResponseMobileAppAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app assignments", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// CreateMobileAppAssignment assigns an app using the assign action. The supplied assignments replace any
+// existing assignments of the app.
+func (c *Client) CreateMobileAppAssignment(appID string, assignment *AssignmentMobileApp) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaMobileApps, appID)
+
+	if err := validateMobileAppAssignments(assignment.MobileAppAssignments); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "mobile app", appID, err)
+	}
+
+	// Set graph metadata values
+	for i := range assignment.MobileAppAssignments {
+		assignment.MobileAppAssignments[i].ODataType = odataTypeMobileAppAssignment
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "mobile app", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteMobileAppAssignmentByID removes a single assignment from an app.
+func (c *Client) DeleteMobileAppAssignmentByID(appID, assignmentID string) error {
+	endpoint := fmt.Sprintf("%s/%s/assignments/%s", uriBetaMobileApps, appID, assignmentID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "mobile app assignment", assignmentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
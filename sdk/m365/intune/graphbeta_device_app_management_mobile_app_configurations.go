@@ -0,0 +1,656 @@
+// graphbeta_device_app_management_mobile_app_configurations.go
+// Graph Beta Api - Intune: App configuration policies for managed devices (iOS/iPadOS and Android Enterprise)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-configuration-policies-use-ios
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-configuration-policies-use-android
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/appConfig
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-manageddevicemobileappconfiguration?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// iOS app configuration is either a list of typed settings or a base64 encoded plist <dict>; Android Enterprise
+// app configuration is a base64 encoded managed configuration JSON payload. The helpers below build and decode
+// both so the payloads can be kept in source control in readable form.
+
+package intune
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaMobileAppConfigurations = "/beta/deviceAppManagement/mobileAppConfigurations"
+
+	ODataTypeIOSMobileAppConfiguration                     = "#microsoft.graph.iosMobileAppConfiguration"
+	ODataTypeAndroidManagedStoreAppConfiguration           = "#microsoft.graph.androidManagedStoreAppConfiguration"
+	odataTypeManagedDeviceMobileAppConfigurationAssignment = "#microsoft.graph.managedDeviceMobileAppConfigurationAssignment"
+
+	AppConfigurationKeyTypeString  = "stringType"
+	AppConfigurationKeyTypeInteger = "integerType"
+	AppConfigurationKeyTypeReal    = "realType"
+	AppConfigurationKeyTypeBoolean = "booleanType"
+	AppConfigurationKeyTypeToken   = "tokenType"
+
+	AndroidPermissionActionPrompt    = "prompt"
+	AndroidPermissionActionAutoGrant = "autoGrant"
+	AndroidPermissionActionAutoDeny  = "autoDeny"
+
+	AndroidProfileApplicabilityDefault            = "default"
+	AndroidProfileApplicabilityAndroidWorkProfile = "androidWorkProfile"
+	AndroidProfileApplicabilityAndroidDeviceOwner = "androidDeviceOwner"
+
+	androidManagedConfigurationKind = "androidenterprise#managedConfiguration"
+)
+
+// Tokens substituted by Intune with device or user values in iOS app configuration settings of type
+// AppConfigurationKeyTypeToken.
+const (
+	AppConfigurationTokenUserPrincipalName = "{{userprincipalname}}"
+	AppConfigurationTokenMail              = "{{mail}}"
+	AppConfigurationTokenPartialUPN        = "{{partialupn}}"
+	AppConfigurationTokenAccountID         = "{{accountid}}"
+	AppConfigurationTokenUserID            = "{{userid}}"
+	AppConfigurationTokenUserName          = "{{username}}"
+	AppConfigurationTokenDeviceID          = "{{deviceid}}"
+	AppConfigurationTokenDeviceName        = "{{devicename}}"
+	AppConfigurationTokenSerialNumber      = "{{serialnumber}}"
+	AppConfigurationTokenUDID              = "{{udid}}"
+)
+
+// ResponseManagedDeviceMobileAppConfigurationsList represents a list of app configuration policies for managed
+// devices.
+type ResponseManagedDeviceMobileAppConfigurationsList struct {
+	ODataContext  string                                        `json:"@odata.context"`
+	ODataNextLink string                                        `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceManagedDeviceMobileAppConfiguration `json:"value"`
+}
+
+// ResourceManagedDeviceMobileAppConfiguration represents an iOS or Android Enterprise app configuration policy
+// for managed devices. It is used as both the request and response structure.
+type ResourceManagedDeviceMobileAppConfiguration struct {
+	ODataType            string                                          `json:"@odata.type"`
+	ID                   string                                          `json:"id,omitempty"`
+	DisplayName          string                                          `json:"displayName"`
+	Description          string                                          `json:"description,omitempty"`
+	CreatedDateTime      *time.Time                                      `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime *time.Time                                      `json:"lastModifiedDateTime,omitempty"`
+	RoleScopeTagIds      []string                                        `json:"roleScopeTagIds,omitempty"`
+	Version              int                                             `json:"version,omitempty"`
+	TargetedMobileApps   []string                                        `json:"targetedMobileApps"`
+	Assignments          []ManagedDeviceMobileAppConfigurationAssignment `json:"assignments,omitempty"`
+
+	// Fields for iosMobileAppConfiguration. Set either Settings or EncodedSettingXml.
+	Settings          []AppConfigurationSettingItem `json:"settings,omitempty"`
+	EncodedSettingXml string                        `json:"encodedSettingXml,omitempty"`
+
+	// Fields for androidManagedStoreAppConfiguration
+	PackageID            string                    `json:"packageId,omitempty"`
+	PayloadJson          string                    `json:"payloadJson,omitempty"`
+	PermissionActions    []AndroidPermissionAction `json:"permissionActions,omitempty"`
+	ProfileApplicability string                    `json:"profileApplicability,omitempty"`
+	ConnectedAppsEnabled bool                      `json:"connectedAppsEnabled,omitempty"`
+	AppSupportsOemConfig bool                      `json:"appSupportsOemConfig,omitempty"`
+}
+
+// AppConfigurationSettingItem represents a typed iOS app configuration setting.
+type AppConfigurationSettingItem struct {
+	ODataType         string `json:"@odata.type,omitempty"`
+	AppConfigKey      string `json:"appConfigKey"`
+	AppConfigKeyType  string `json:"appConfigKeyType"`
+	AppConfigKeyValue string `json:"appConfigKeyValue"`
+}
+
+// AndroidPermissionAction represents the default action taken when an Android app requests a runtime permission,
+// e.g. android.permission.CAMERA.
+type AndroidPermissionAction struct {
+	Permission string `json:"permission"`
+	Action     string `json:"action"`
+}
+
+// AndroidManagedStoreAppConfigurationPayload represents the managed configuration JSON payload of an Android
+// Enterprise app, before base64 encoding.
+type AndroidManagedStoreAppConfigurationPayload struct {
+	Kind            string                                `json:"kind"`
+	ProductID       string                                `json:"productId"`
+	ManagedProperty []AndroidManagedConfigurationProperty `json:"managedProperty"`
+}
+
+// AndroidManagedConfigurationProperty represents a single managed configuration value. Set exactly one of the
+// value fields, matching the restriction type the app declares for the key.
+type AndroidManagedConfigurationProperty struct {
+	Key              string                              `json:"key"`
+	ValueBool        *bool                               `json:"valueBool,omitempty"`
+	ValueInteger     *int64                              `json:"valueInteger,omitempty"`
+	ValueString      *string                             `json:"valueString,omitempty"`
+	ValueStringArray []string                            `json:"valueStringArray,omitempty"`
+	ValueBundle      *AndroidManagedConfigurationBundle  `json:"valueBundle,omitempty"`
+	ValueBundleArray []AndroidManagedConfigurationBundle `json:"valueBundleArray,omitempty"`
+}
+
+// AndroidManagedConfigurationBundle represents a nested group of managed configuration values.
+type AndroidManagedConfigurationBundle struct {
+	ManagedProperty []AndroidManagedConfigurationProperty `json:"managedProperty"`
+}
+
+// ManagedDeviceMobileAppConfigurationAssignment represents an assignment of an app configuration policy for
+// managed devices.
+type ManagedDeviceMobileAppConfigurationAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// ResponseManagedDeviceMobileAppConfigurationAssignmentsList represents the assignments of an app configuration
+// policy for managed devices.
+type ResponseManagedDeviceMobileAppConfigurationAssignmentsList struct {
+	ODataContext string                                          `json:"@odata.context"`
+	Value        []ManagedDeviceMobileAppConfigurationAssignment `json:"value"`
+}
+
+// AssignmentManagedDeviceMobileAppConfiguration represents the request body of the app configuration assign
+// action.
+type AssignmentManagedDeviceMobileAppConfiguration struct {
+	Assignments []ManagedDeviceMobileAppConfigurationAssignment `json:"assignments"`
+}
+
+// ResponseManagedDeviceMobileAppConfigurationStatusSummary represents the device or user deployment summary of an
+// app configuration policy for managed devices.
+type ResponseManagedDeviceMobileAppConfigurationStatusSummary struct {
+	ODataContext               string    `json:"@odata.context"`
+	ID                         string    `json:"id"`
+	PendingCount               int       `json:"pendingCount"`
+	NotApplicableCount         int       `json:"notApplicableCount"`
+	NotApplicablePlatformCount int       `json:"notApplicablePlatformCount"`
+	SuccessCount               int       `json:"successCount"`
+	ErrorCount                 int       `json:"errorCount"`
+	FailedCount                int       `json:"failedCount"`
+	ConflictCount              int       `json:"conflictCount"`
+	LastUpdateDateTime         time.Time `json:"lastUpdateDateTime"`
+	ConfigurationVersion       int       `json:"configurationVersion"`
+}
+
+// NewAppConfigurationSettingItem returns an iOS app configuration setting whose type is derived from the Go type
+// of value: string, bool, int, int64 or float64. Use NewAppConfigurationTokenSettingItem for token values.
+func NewAppConfigurationSettingItem(key string, value interface{}) (AppConfigurationSettingItem, error) {
+	item := AppConfigurationSettingItem{AppConfigKey: key}
+
+	switch v := value.(type) {
+	case string:
+		item.AppConfigKeyType = AppConfigurationKeyTypeString
+		item.AppConfigKeyValue = v
+	case bool:
+		item.AppConfigKeyType = AppConfigurationKeyTypeBoolean
+		item.AppConfigKeyValue = strconv.FormatBool(v)
+	case int:
+		item.AppConfigKeyType = AppConfigurationKeyTypeInteger
+		item.AppConfigKeyValue = strconv.Itoa(v)
+	case int64:
+		item.AppConfigKeyType = AppConfigurationKeyTypeInteger
+		item.AppConfigKeyValue = strconv.FormatInt(v, 10)
+	case float64:
+		item.AppConfigKeyType = AppConfigurationKeyTypeReal
+		item.AppConfigKeyValue = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return item, fmt.Errorf("unsupported app configuration value type %T for key %s", value, key)
+	}
+
+	return item, nil
+}
+
+// NewAppConfigurationTokenSettingItem returns an iOS app configuration setting whose value is substituted by
+// Intune, e.g. AppConfigurationTokenUserPrincipalName.
+func NewAppConfigurationTokenSettingItem(key, token string) AppConfigurationSettingItem {
+	return AppConfigurationSettingItem{
+		AppConfigKey:      key,
+		AppConfigKeyType:  AppConfigurationKeyTypeToken,
+		AppConfigKeyValue: token,
+	}
+}
+
+// EncodeIOSAppConfigurationXML checks that the XML is a <dict> element, or a plist whose root is a <dict>, and
+// returns the dictionary base64 encoded for EncodedSettingXml. The XML declaration, doctype and plist wrapper are
+// removed as Intune expects the bare dictionary.
+func EncodeIOSAppConfigurationXML(data []byte) (string, error) {
+	dict, err := iosAppConfigurationDict(data)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(dict), nil
+}
+
+// DecodeIOSAppConfigurationXML returns the XML dictionary of an EncodedSettingXml value.
+func DecodeIOSAppConfigurationXML(encoded string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode app configuration XML: %v", err)
+	}
+
+	return data, nil
+}
+
+// iosAppConfigurationDict returns the bytes of the <dict> element at the root of the XML or directly inside a
+// root <plist> element.
+func iosAppConfigurationDict(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var root string
+	start, end := int64(-1), int64(-1)
+	depth := 0
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse app configuration XML: %v", err)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if root != "" {
+					return nil, fmt.Errorf("app configuration XML has more than one root element")
+				}
+				root = element.Name.Local
+				if root != "plist" && root != "dict" {
+					return nil, fmt.Errorf("app configuration XML root must be <dict> or <plist>, got <%s>", root)
+				}
+			}
+
+			dictDepth := 0
+			if root == "plist" {
+				dictDepth = 1
+			}
+			if depth == dictDepth && start < 0 {
+				if element.Name.Local != "dict" {
+					return nil, fmt.Errorf("app configuration plist must contain a <dict>, got <%s>", element.Name.Local)
+				}
+				start = offset
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+			if element.Name.Local == "dict" && start >= 0 && end < 0 &&
+				((root == "dict" && depth == 0) || (root == "plist" && depth == 1)) {
+				end = decoder.InputOffset()
+			}
+		}
+	}
+
+	if start < 0 || end < 0 {
+		return nil, fmt.Errorf("app configuration XML does not contain a <dict>")
+	}
+
+	return data[start:end], nil
+}
+
+// NewAndroidManagedConfigurationProperty returns a managed configuration value whose type is derived from the Go
+// type of value: bool, int, int64, string or []string.
+func NewAndroidManagedConfigurationProperty(key string, value interface{}) (AndroidManagedConfigurationProperty, error) {
+	property := AndroidManagedConfigurationProperty{Key: key}
+
+	switch v := value.(type) {
+	case bool:
+		property.ValueBool = &v
+	case int:
+		i := int64(v)
+		property.ValueInteger = &i
+	case int64:
+		property.ValueInteger = &v
+	case string:
+		property.ValueString = &v
+	case []string:
+		property.ValueStringArray = v
+	default:
+		return property, fmt.Errorf("unsupported managed configuration value type %T for key %s", value, key)
+	}
+
+	return property, nil
+}
+
+// EncodeAndroidManagedStoreAppConfigurationPayload returns the payload base64 encoded for PayloadJson. Kind and
+// ProductID are derived from the package ID when they are not set.
+func EncodeAndroidManagedStoreAppConfigurationPayload(packageID string, payload *AndroidManagedStoreAppConfigurationPayload) (string, error) {
+	encoded := *payload
+	if encoded.Kind == "" {
+		encoded.Kind = androidManagedConfigurationKind
+	}
+	if encoded.ProductID == "" {
+		encoded.ProductID = "app:" + packageID
+	}
+	if encoded.ManagedProperty == nil {
+		encoded.ManagedProperty = []AndroidManagedConfigurationProperty{}
+	}
+
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return "", fmt.Errorf(shared.ErrorMsgFailedJsonMarshal, "managed configuration payload", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodeAndroidManagedStoreAppConfigurationPayload decodes a PayloadJson value.
+func DecodeAndroidManagedStoreAppConfigurationPayload(encoded string) (*AndroidManagedStoreAppConfigurationPayload, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode managed configuration payload: %v", err)
+	}
+
+	var payload AndroidManagedStoreAppConfigurationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode managed configuration payload: %v", err)
+	}
+
+	return &payload, nil
+}
+
+// validateManagedDeviceMobileAppConfiguration checks the platform specific requirements of an app configuration
+// policy for managed devices.
+func validateManagedDeviceMobileAppConfiguration(request *ResourceManagedDeviceMobileAppConfiguration) error {
+	if len(request.TargetedMobileApps) == 0 {
+		return fmt.Errorf("app configuration policy must target an app")
+	}
+
+	switch request.ODataType {
+	case ODataTypeIOSMobileAppConfiguration:
+		if len(request.Settings) > 0 && request.EncodedSettingXml != "" {
+			return fmt.Errorf("iOS app configuration must use either settings or encoded setting XML, not both")
+		}
+
+		keys := make(map[string]bool, len(request.Settings))
+		for _, setting := range request.Settings {
+			switch setting.AppConfigKeyType {
+			case AppConfigurationKeyTypeString, AppConfigurationKeyTypeInteger, AppConfigurationKeyTypeReal,
+				AppConfigurationKeyTypeBoolean, AppConfigurationKeyTypeToken:
+			default:
+				return fmt.Errorf("unsupported app configuration key type %q for key %s", setting.AppConfigKeyType, setting.AppConfigKey)
+			}
+			if keys[setting.AppConfigKey] {
+				return fmt.Errorf("app configuration key %s is defined more than once", setting.AppConfigKey)
+			}
+			keys[setting.AppConfigKey] = true
+		}
+	case ODataTypeAndroidManagedStoreAppConfiguration:
+		if request.PackageID == "" {
+			return fmt.Errorf("android managed store app configuration requires a package ID")
+		}
+	default:
+		return fmt.Errorf("unsupported app configuration @odata.type: %q", request.ODataType)
+	}
+
+	return nil
+}
+
+// GetManagedDeviceMobileAppConfigurations retrieves all app configuration policies for managed devices.
+func (c *Client) GetManagedDeviceMobileAppConfigurations() (*ResponseManagedDeviceMobileAppConfigurationsList, error) {
+	endpoint := uriBetaMobileAppConfigurations
+
+	var configurations ResponseManagedDeviceMobileAppConfigurationsList
+
+	for endpoint != "" {
+		var page ResponseManagedDeviceMobileAppConfigurationsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app configurations", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if configurations.ODataContext == "" {
+			configurations.ODataContext = page.ODataContext
+		}
+		configurations.Value = append(configurations.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app configurations", err)
+			}
+		}
+	}
+
+	return &configurations, nil
+}
+
+// GetManagedDeviceMobileAppConfigurationByID retrieves an app configuration policy for managed devices by its ID
+// with its assignments.
+func (c *Client) GetManagedDeviceMobileAppConfigurationByID(configurationID string) (*ResourceManagedDeviceMobileAppConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=assignments", uriBetaMobileAppConfigurations, configurationID)
+
+	var configuration ResourceManagedDeviceMobileAppConfiguration
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &configuration, nil
+}
+
+// GetManagedDeviceMobileAppConfigurationByDisplayName retrieves an app configuration policy for managed devices by
+// its display name.
+func (c *Client) GetManagedDeviceMobileAppConfigurationByDisplayName(displayName string) (*ResourceManagedDeviceMobileAppConfiguration, error) {
+	configurations, err := c.GetManagedDeviceMobileAppConfigurations()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "mobile app configuration", displayName, err)
+	}
+
+	var configurationID string
+	for _, configuration := range configurations.Value {
+		if configuration.DisplayName == displayName {
+			configurationID = configuration.ID
+			break
+		}
+	}
+
+	if configurationID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "mobile app configuration", displayName, "configuration not found")
+	}
+
+	return c.GetManagedDeviceMobileAppConfigurationByID(configurationID)
+}
+
+// CreateManagedDeviceMobileAppConfiguration creates an app configuration policy for managed devices of the
+// platform selected by ODataType.
+func (c *Client) CreateManagedDeviceMobileAppConfiguration(request *ResourceManagedDeviceMobileAppConfiguration) (*ResourceManagedDeviceMobileAppConfiguration, error) {
+	endpoint := uriBetaMobileAppConfigurations
+
+	if err := validateManagedDeviceMobileAppConfiguration(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app configuration", err)
+	}
+
+	// Exclude navigation properties from the request object
+	configuration := *request
+	configuration.Assignments = nil
+
+	var createdConfiguration ResourceManagedDeviceMobileAppConfiguration
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &configuration, &createdConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdConfiguration, nil
+}
+
+// CreateManagedDeviceMobileAppConfigurationWithAssignment creates an app configuration policy for managed devices
+// and assigns it.
+func (c *Client) CreateManagedDeviceMobileAppConfigurationWithAssignment(request *ResourceManagedDeviceMobileAppConfiguration, assignment *AssignmentManagedDeviceMobileAppConfiguration) (*ResourceManagedDeviceMobileAppConfiguration, error) {
+	createdConfiguration, err := c.CreateManagedDeviceMobileAppConfiguration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateManagedDeviceMobileAppConfigurationAssignment(createdConfiguration.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdConfiguration, nil
+}
+
+// UpdateManagedDeviceMobileAppConfigurationByID updates an app configuration policy for managed devices using
+// the PATCH method. Assignments are not changed; use CreateManagedDeviceMobileAppConfigurationAssignment.
+func (c *Client) UpdateManagedDeviceMobileAppConfigurationByID(configurationID string, request *ResourceManagedDeviceMobileAppConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileAppConfigurations, configurationID)
+
+	if err := validateManagedDeviceMobileAppConfiguration(request); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app configuration", configurationID, err)
+	}
+
+	// Exclude navigation and read-only properties from the request object
+	patch := *request
+	patch.ID = ""
+	patch.Assignments = nil
+	patch.CreatedDateTime = nil
+	patch.LastModifiedDateTime = nil
+	patch.Version = 0
+	patch.AppSupportsOemConfig = false
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateManagedDeviceMobileAppConfigurationByDisplayName updates an app configuration policy for managed devices
+// by its display name.
+func (c *Client) UpdateManagedDeviceMobileAppConfigurationByDisplayName(displayName string, request *ResourceManagedDeviceMobileAppConfiguration) error {
+	configuration, err := c.GetManagedDeviceMobileAppConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "mobile app configuration", displayName, err)
+	}
+
+	return c.UpdateManagedDeviceMobileAppConfigurationByID(configuration.ID, request)
+}
+
+// DeleteManagedDeviceMobileAppConfigurationByID deletes an app configuration policy for managed devices by its ID.
+func (c *Client) DeleteManagedDeviceMobileAppConfigurationByID(configurationID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileAppConfigurations, configurationID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "mobile app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteManagedDeviceMobileAppConfigurationByDisplayName deletes an app configuration policy for managed devices
+// by its display name.
+func (c *Client) DeleteManagedDeviceMobileAppConfigurationByDisplayName(displayName string) error {
+	configuration, err := c.GetManagedDeviceMobileAppConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "mobile app configuration", displayName, err)
+	}
+
+	return c.DeleteManagedDeviceMobileAppConfigurationByID(configuration.ID)
+}
+
+// GetManagedDeviceMobileAppConfigurationAssignments retrieves the assignments of an app configuration policy for
+// managed devices.
+func (c *Client) GetManagedDeviceMobileAppConfigurationAssignments(configurationID string) (*ResponseManagedDeviceMobileAppConfigurationAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaMobileAppConfigurations, configurationID)
+
+	var assignments ResponseManagedDeviceMobileAppConfigurationAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app configuration assignments", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// CreateManagedDeviceMobileAppConfigurationAssignment assigns an app configuration policy for managed devices
+// using the assign action. The supplied assignments replace any existing assignments of the policy.
+func (c *Client) CreateManagedDeviceMobileAppConfigurationAssignment(configurationID string, assignment *AssignmentManagedDeviceMobileAppConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaMobileAppConfigurations, configurationID)
+
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = odataTypeManagedDeviceMobileAppConfigurationAssignment
+	}
+	if assignment.Assignments == nil {
+		assignment.Assignments = []ManagedDeviceMobileAppConfigurationAssignment{}
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "mobile app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetManagedDeviceMobileAppConfigurationDeviceStatusSummary retrieves the device deployment summary of an app
+// configuration policy for managed devices.
+func (c *Client) GetManagedDeviceMobileAppConfigurationDeviceStatusSummary(configurationID string) (*ResponseManagedDeviceMobileAppConfigurationStatusSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatusSummary", uriBetaMobileAppConfigurations, configurationID)
+
+	var summary ResponseManagedDeviceMobileAppConfigurationStatusSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &summary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app configuration device status summary", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &summary, nil
+}
+
+// GetManagedDeviceMobileAppConfigurationUserStatusSummary retrieves the user deployment summary of an app
+// configuration policy for managed devices.
+func (c *Client) GetManagedDeviceMobileAppConfigurationUserStatusSummary(configurationID string) (*ResponseManagedDeviceMobileAppConfigurationStatusSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/userStatusSummary", uriBetaMobileAppConfigurations, configurationID)
+
+	var summary ResponseManagedDeviceMobileAppConfigurationStatusSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &summary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app configuration user status summary", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &summary, nil
+}
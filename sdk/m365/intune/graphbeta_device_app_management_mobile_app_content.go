@@ -0,0 +1,372 @@
+// graphbeta_device_app_management_mobile_app_content.go
+// Graph Beta Api - Intune: Mobile app content versions and files
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-add
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/allApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-mobileappcontentfile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+//
+// Line-of-business app content is uploaded in these steps:
+//  1. create a content version of the app
+//  2. create a content file in the version; Intune then requests an Azure Storage SAS URI for it
+//  3. upload the encrypted content to the SAS URI as block blob blocks and commit the block list
+//  4. commit the content file with the encryption info of the package; Intune then decrypts and verifies it
+//  5. set the committed content version of the app
+
+package intune
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaMobileApps                = "/beta/deviceAppManagement/mobileApps"
+	odataTypeMobileAppContentFile    = "#microsoft.graph.mobileAppContentFile"
+	defaultMobileAppContentChunkSize = 6 * 1024 * 1024
+
+	MobileAppContentFileUploadStateSuccess                        = "success"
+	MobileAppContentFileUploadStateTransientError                 = "transientError"
+	MobileAppContentFileUploadStateError                          = "error"
+	MobileAppContentFileUploadStateUnknown                        = "unknown"
+	MobileAppContentFileUploadStateAzureStorageURIRequestSuccess  = "azureStorageUriRequestSuccess"
+	MobileAppContentFileUploadStateAzureStorageURIRequestPending  = "azureStorageUriRequestPending"
+	MobileAppContentFileUploadStateAzureStorageURIRequestFailed   = "azureStorageUriRequestFailed"
+	MobileAppContentFileUploadStateAzureStorageURIRequestTimedOut = "azureStorageUriRequestTimedOut"
+	MobileAppContentFileUploadStateAzureStorageURIRenewalSuccess  = "azureStorageUriRenewalSuccess"
+	MobileAppContentFileUploadStateAzureStorageURIRenewalPending  = "azureStorageUriRenewalPending"
+	MobileAppContentFileUploadStateAzureStorageURIRenewalFailed   = "azureStorageUriRenewalFailed"
+	MobileAppContentFileUploadStateAzureStorageURIRenewalTimedOut = "azureStorageUriRenewalTimedOut"
+	MobileAppContentFileUploadStateCommitFileSuccess              = "commitFileSuccess"
+	MobileAppContentFileUploadStateCommitFilePending              = "commitFilePending"
+	MobileAppContentFileUploadStateCommitFileFailed               = "commitFileFailed"
+	MobileAppContentFileUploadStateCommitFileTimedOut             = "commitFileTimedOut"
+)
+
+// ResponseMobileAppContentVersionsList represents a list of content versions of an app.
+type ResponseMobileAppContentVersionsList struct {
+	ODataContext string                            `json:"@odata.context"`
+	Value        []ResourceMobileAppContentVersion `json:"value"`
+}
+
+// ResourceMobileAppContentVersion represents a content version of a line-of-business app.
+type ResourceMobileAppContentVersion struct {
+	ID string `json:"id,omitempty"`
+}
+
+// ResponseMobileAppContentFilesList represents a list of files in a content version.
+type ResponseMobileAppContentFilesList struct {
+	ODataContext string                         `json:"@odata.context"`
+	Value        []ResourceMobileAppContentFile `json:"value"`
+}
+
+// ResourceMobileAppContentFile represents a file in a content version. Size is the size of the unencrypted
+// content and SizeEncrypted the size of the content that is uploaded.
+type ResourceMobileAppContentFile struct {
+	ODataType                         string     `json:"@odata.type,omitempty"`
+	ID                                string     `json:"id,omitempty"`
+	Name                              string     `json:"name"`
+	Size                              int64      `json:"size"`
+	SizeEncrypted                     int64      `json:"sizeEncrypted"`
+	Manifest                          []byte     `json:"manifest"`
+	IsDependency                      bool       `json:"isDependency"`
+	IsFrameworkFile                   bool       `json:"isFrameworkFile,omitempty"`
+	IsCommitted                       bool       `json:"isCommitted,omitempty"`
+	AzureStorageURI                   string     `json:"azureStorageUri,omitempty"`
+	AzureStorageURIExpirationDateTime *time.Time `json:"azureStorageUriExpirationDateTime,omitempty"`
+	CreatedDateTime                   *time.Time `json:"createdDateTime,omitempty"`
+	UploadState                       string     `json:"uploadState,omitempty"`
+}
+
+// MobileAppContentUploadOptions configures how app content is uploaded. Zero values use the defaults noted on
+// each field.
+type MobileAppContentUploadOptions struct {
+	// Storage receives the encrypted content. Defaults to an AzureBlobStorageUploader.
+	Storage BlobStorageUploader
+	// ChunkSize is the size in bytes of each uploaded block. Defaults to 6 MiB.
+	ChunkSize int
+	// PollInterval is the delay between checks of the file upload state. Defaults to 5 seconds.
+	PollInterval time.Duration
+	// Timeout bounds each wait for a file upload state. Defaults to 10 minutes.
+	Timeout time.Duration
+	// SASRenewalInterval is how long a SAS URI is used before it is renewed. Defaults to 7 minutes.
+	SASRenewalInterval time.Duration
+}
+
+// withDefaults returns a copy of the options with unset fields defaulted.
+func (o *MobileAppContentUploadOptions) withDefaults() MobileAppContentUploadOptions {
+	var options MobileAppContentUploadOptions
+	if o != nil {
+		options = *o
+	}
+
+	if options.Storage == nil {
+		options.Storage = &AzureBlobStorageUploader{}
+	}
+	if options.ChunkSize <= 0 {
+		options.ChunkSize = defaultMobileAppContentChunkSize
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = 5 * time.Second
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * time.Minute
+	}
+	if options.SASRenewalInterval <= 0 {
+		options.SASRenewalInterval = 7 * time.Minute
+	}
+
+	return options
+}
+
+// mobileAppContentVersionsEndpoint returns the content versions endpoint of an app, which is addressed through
+// the app type cast, e.g. mobileApps/{id}/microsoft.graph.win32LobApp/contentVersions.
+func mobileAppContentVersionsEndpoint(appID, appODataType string) string {
+	return fmt.Sprintf("%s/%s/%s/contentVersions", uriBetaMobileApps, appID, strings.TrimPrefix(appODataType, "#"))
+}
+
+// mobileAppContentFileEndpoint returns the endpoint of a file in a content version.
+func mobileAppContentFileEndpoint(appID, appODataType, contentVersionID, fileID string) string {
+	return fmt.Sprintf("%s/%s/files/%s", mobileAppContentVersionsEndpoint(appID, appODataType), contentVersionID, fileID)
+}
+
+// GetMobileAppContentVersions retrieves the content versions of a line-of-business app.
+func (c *Client) GetMobileAppContentVersions(appID, appODataType string) (*ResponseMobileAppContentVersionsList, error) {
+	endpoint := mobileAppContentVersionsEndpoint(appID, appODataType)
+
+	var contentVersions ResponseMobileAppContentVersionsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &contentVersions)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app content versions", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &contentVersions, nil
+}
+
+// CreateMobileAppContentVersion creates a content version of a line-of-business app.
+func (c *Client) CreateMobileAppContentVersion(appID, appODataType string) (*ResourceMobileAppContentVersion, error) {
+	endpoint := mobileAppContentVersionsEndpoint(appID, appODataType)
+
+	var contentVersion ResourceMobileAppContentVersion
+	resp, err := c.HTTP.DoRequest("POST", endpoint, map[string]interface{}{}, &contentVersion)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app content version", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &contentVersion, nil
+}
+
+// GetMobileAppContentFiles retrieves the files of a content version.
+func (c *Client) GetMobileAppContentFiles(appID, appODataType, contentVersionID string) (*ResponseMobileAppContentFilesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/files", mobileAppContentVersionsEndpoint(appID, appODataType), contentVersionID)
+
+	var files ResponseMobileAppContentFilesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &files)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app content files", contentVersionID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &files, nil
+}
+
+// GetMobileAppContentFileByID retrieves a file of a content version by its ID.
+func (c *Client) GetMobileAppContentFileByID(appID, appODataType, contentVersionID, fileID string) (*ResourceMobileAppContentFile, error) {
+	endpoint := mobileAppContentFileEndpoint(appID, appODataType, contentVersionID, fileID)
+
+	var file ResourceMobileAppContentFile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &file)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app content file", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &file, nil
+}
+
+// CreateMobileAppContentFile creates a file in a content version. Intune requests a SAS URI for the file once it
+// has been created; use WaitForMobileAppContentFileUploadState to wait for it.
+func (c *Client) CreateMobileAppContentFile(appID, appODataType, contentVersionID string, request *ResourceMobileAppContentFile) (*ResourceMobileAppContentFile, error) {
+	endpoint := fmt.Sprintf("%s/%s/files", mobileAppContentVersionsEndpoint(appID, appODataType), contentVersionID)
+
+	// Set graph metadata values
+	request.ODataType = odataTypeMobileAppContentFile
+
+	var createdFile ResourceMobileAppContentFile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdFile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app content file", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdFile, nil
+}
+
+// RenewMobileAppContentFileUpload requests a new SAS URI for a file whose upload is still in progress.
+func (c *Client) RenewMobileAppContentFileUpload(appID, appODataType, contentVersionID, fileID string) error {
+	endpoint := fmt.Sprintf("%s/renewUpload", mobileAppContentFileEndpoint(appID, appODataType, contentVersionID, fileID))
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app content file upload", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// CommitMobileAppContentFile commits an uploaded file with the encryption info of its package.
+func (c *Client) CommitMobileAppContentFile(appID, appODataType, contentVersionID, fileID string, encryptionInfo *MobileAppFileEncryptionInfo) error {
+	endpoint := fmt.Sprintf("%s/commit", mobileAppContentFileEndpoint(appID, appODataType, contentVersionID, fileID))
+
+	requestBody := struct {
+		FileEncryptionInfo *MobileAppFileEncryptionInfo `json:"fileEncryptionInfo"`
+	}{FileEncryptionInfo: encryptionInfo}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app content file commit", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// WaitForMobileAppContentFileUploadState polls a file until its upload state is the wanted state or the timeout
+// elapses. Failed and timed out states, and the error state, end the wait with an error.
+func (c *Client) WaitForMobileAppContentFileUploadState(appID, appODataType, contentVersionID, fileID, wantState string, pollInterval, timeout time.Duration) (*ResourceMobileAppContentFile, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		file, err := c.GetMobileAppContentFileByID(appID, appODataType, contentVersionID, fileID)
+		if err != nil {
+			return nil, err
+		}
+
+		if file.UploadState == wantState {
+			return file, nil
+		}
+		if mobileAppContentFileUploadStateFailed(file.UploadState) {
+			return file, fmt.Errorf("mobile app content file %s is in upload state %s while waiting for %s", fileID, file.UploadState, wantState)
+		}
+
+		if time.Now().After(deadline) {
+			return file, fmt.Errorf("timed out after %s waiting for mobile app content file %s to reach %s, last state: %s", timeout, fileID, wantState, file.UploadState)
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// mobileAppContentFileUploadStateFailed reports whether an upload state is final and unsuccessful.
+func mobileAppContentFileUploadStateFailed(state string) bool {
+	return state == MobileAppContentFileUploadStateError ||
+		strings.HasSuffix(state, "Failed") ||
+		strings.HasSuffix(state, "TimedOut")
+}
+
+// UploadMobileAppContentFile uploads encrypted content to a file created with CreateMobileAppContentFile and
+// commits it. It waits for the SAS URI, uploads the content in blocks, renewing the SAS URI as it ages, commits
+// the block list and the file, and waits for Intune to accept the commit.
+func (c *Client) UploadMobileAppContentFile(appID, appODataType, contentVersionID, fileID string, content io.Reader, encryptionInfo *MobileAppFileEncryptionInfo, options *MobileAppContentUploadOptions) (*ResourceMobileAppContentFile, error) {
+	opts := options.withDefaults()
+
+	file, err := c.WaitForMobileAppContentFileUploadState(appID, appODataType, contentVersionID, fileID, MobileAppContentFileUploadStateAzureStorageURIRequestSuccess, opts.PollInterval, opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	sasURI := file.AzureStorageURI
+	sasIssued := time.Now()
+
+	var blockIDs []string
+	buffer := make([]byte, opts.ChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buffer)
+		if n > 0 {
+			if time.Since(sasIssued) > opts.SASRenewalInterval {
+				if err := c.RenewMobileAppContentFileUpload(appID, appODataType, contentVersionID, fileID); err != nil {
+					return nil, err
+				}
+				file, err = c.WaitForMobileAppContentFileUploadState(appID, appODataType, contentVersionID, fileID, MobileAppContentFileUploadStateAzureStorageURIRenewalSuccess, opts.PollInterval, opts.Timeout)
+				if err != nil {
+					return nil, err
+				}
+				sasURI = file.AzureStorageURI
+				sasIssued = time.Now()
+			}
+
+			blockID := blobStorageBlockID(len(blockIDs))
+			if err := opts.Storage.PutBlock(sasURI, blockID, buffer[:n]); err != nil {
+				return nil, fmt.Errorf("failed to upload block %d of mobile app content file %s: %v", len(blockIDs), fileID, err)
+			}
+			blockIDs = append(blockIDs, blockID)
+		}
+
+		if readErr == io.EOF || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read mobile app content: %v", readErr)
+		}
+	}
+
+	if err := opts.Storage.PutBlockList(sasURI, blockIDs); err != nil {
+		return nil, fmt.Errorf("failed to commit blocks of mobile app content file %s: %v", fileID, err)
+	}
+
+	if err := c.CommitMobileAppContentFile(appID, appODataType, contentVersionID, fileID, encryptionInfo); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForMobileAppContentFileUploadState(appID, appODataType, contentVersionID, fileID, MobileAppContentFileUploadStateCommitFileSuccess, opts.PollInterval, opts.Timeout)
+}
+
+// SetMobileAppCommittedContentVersion sets the content version devices install for a line-of-business app.
+func (c *Client) SetMobileAppCommittedContentVersion(appID, appODataType, contentVersionID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, appID)
+
+	requestBody := map[string]string{
+		"@odata.type":             appODataType,
+		"committedContentVersion": contentVersionID,
+	}
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app committed content version", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// graphbeta_device_app_management_mobile_app_install_error_codes.go
+// Graph Beta Api - Intune: App installation error codes
+// Documentation: https://learn.microsoft.com/en-us/troubleshoot/mem/intune/app-management/troubleshoot-app-install
+// Documentation: https://learn.microsoft.com/en-us/windows/win32/msi/error-codes
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/allApps
+// Install status reports carry the error code of a failed installation as a signed 32-bit integer. These
+// helpers format the code as hex and translate the common Windows Installer, Win32 and Intune codes so
+// failures can be triaged without looking each one up.
+
+package intune
+
+import (
+	"fmt"
+)
+
+// mobileAppInstallErrorDescriptions describes Intune management extension, app package and HRESULT codes.
+var mobileAppInstallErrorDescriptions = map[uint32]string{
+	0x87D1041C: "The application was not detected after installation completed successfully; check the detection rules",
+	0x87D1313C: "The network connection was lost while the content was downloading",
+	0x80073CF0: "The app package could not be opened",
+	0x80073CF3: "The app package failed update, dependency or conflict validation",
+	0x80073CF6: "The app package could not be registered",
+	0x80073CF9: "The app package installation failed",
+	0x80073D02: "The app package could not be installed because resources it modifies are in use",
+	0x80004005: "Unspecified error",
+	0x8000FFFF: "Catastrophic failure",
+}
+
+// win32InstallErrorDescriptions describes Win32 and Windows Installer exit codes, which are also reported
+// wrapped in an HRESULT (0x8007xxxx).
+var win32InstallErrorDescriptions = map[uint32]string{
+	2:    "The system cannot find the file specified",
+	3:    "The system cannot find the path specified",
+	5:    "Access is denied",
+	14:   "Not enough memory resources are available",
+	112:  "There is not enough space on the disk",
+	258:  "The wait operation timed out",
+	1223: "The operation was cancelled by the user",
+	1601: "The Windows Installer service could not be accessed",
+	1602: "The user cancelled the installation",
+	1603: "A fatal error occurred during installation",
+	1605: "This action is only valid for products that are currently installed",
+	1612: "The installation source for this product is not available",
+	1618: "Another installation is already in progress",
+	1619: "The installation package could not be opened",
+	1620: "The installation package could not be opened; it may not be a valid Windows Installer package",
+	1622: "There was an error opening the installation log file",
+	1624: "There was an error applying transforms",
+	1625: "The installation is forbidden by system policy",
+	1633: "The installation package is not supported on this processor type",
+	1638: "Another version of this product is already installed",
+	1639: "Invalid command line argument",
+	1641: "The installer has initiated a restart",
+	3010: "A restart is required to complete the installation",
+}
+
+// FormatMobileAppInstallErrorCode formats an error code as reported by Intune, e.g. -2016345060, as the hex
+// value shown in the Intune portal, e.g. 0x87D1041C.
+func FormatMobileAppInstallErrorCode(code int64) string {
+	return fmt.Sprintf("0x%08X", uint32(code))
+}
+
+// DescribeMobileAppInstallErrorCode returns a readable description of an installation error code. Installer
+// exit codes are accepted as is (e.g. 1603) or wrapped in an HRESULT (e.g. 0x80070643). An empty string is
+// returned for 0 and for codes that are not known.
+func DescribeMobileAppInstallErrorCode(code int64) string {
+	if code == 0 {
+		return ""
+	}
+
+	value := uint32(code)
+	if description, ok := mobileAppInstallErrorDescriptions[value]; ok {
+		return description
+	}
+	if description, ok := win32InstallErrorDescriptions[value]; ok {
+		return description
+	}
+
+	// HRESULT_FROM_WIN32 sets the failure bit and FACILITY_WIN32 (7) above the Win32 code
+	if value&0xFFFF0000 == 0x80070000 {
+		if description, ok := win32InstallErrorDescriptions[value&0xFFFF]; ok {
+			return description
+		}
+	}
+
+	return ""
+}
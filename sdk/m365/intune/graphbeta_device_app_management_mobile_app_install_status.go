@@ -0,0 +1,323 @@
+// graphbeta_device_app_management_mobile_app_install_status.go
+// Graph Beta Api - Intune: App install status
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-monitor
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/allApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-mobileappinstallstatus?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/intune-reporting-devicemanagementreports-getdeviceinstallstatusreport?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaReportsGetDeviceInstallStatusReport = "/beta/deviceManagement/reports/getDeviceInstallStatusReport"
+	uriBetaReportsGetUserInstallStatusReport   = "/beta/deviceManagement/reports/getUserInstallStatusReport"
+
+	MobileAppInstallStateInstalled       = "installed"
+	MobileAppInstallStateFailed          = "failed"
+	MobileAppInstallStateNotInstalled    = "notInstalled"
+	MobileAppInstallStateUninstallFailed = "uninstallFailed"
+	MobileAppInstallStatePendingInstall  = "pendingInstall"
+	MobileAppInstallStateNotApplicable   = "notApplicable"
+	MobileAppInstallStateUnknown         = "unknown"
+)
+
+// mobileAppDeviceInstallStatusReportColumns are the columns requested from getDeviceInstallStatusReport.
+var mobileAppDeviceInstallStatusReportColumns = []string{
+	"DeviceName", "DeviceId", "UserName", "UserPrincipalName", "Platform", "AppVersion",
+	"InstallState", "InstallStateDetail", "ErrorCode", "HexErrorCode", "LastModifiedDateTime", "ApplicationId",
+}
+
+// ResponseMobileAppInstallSummary represents the install counts of an app across devices and users.
+type ResponseMobileAppInstallSummary struct {
+	ODataContext              string `json:"@odata.context"`
+	ID                        string `json:"id"`
+	InstalledDeviceCount      int    `json:"installedDeviceCount"`
+	FailedDeviceCount         int    `json:"failedDeviceCount"`
+	NotApplicableDeviceCount  int    `json:"notApplicableDeviceCount"`
+	NotInstalledDeviceCount   int    `json:"notInstalledDeviceCount"`
+	PendingInstallDeviceCount int    `json:"pendingInstallDeviceCount"`
+	InstalledUserCount        int    `json:"installedUserCount"`
+	FailedUserCount           int    `json:"failedUserCount"`
+	NotApplicableUserCount    int    `json:"notApplicableUserCount"`
+	NotInstalledUserCount     int    `json:"notInstalledUserCount"`
+	PendingInstallUserCount   int    `json:"pendingInstallUserCount"`
+}
+
+// ResponseMobileAppDeviceInstallStatusesList represents the install status of an app on each device.
+type ResponseMobileAppDeviceInstallStatusesList struct {
+	ODataContext  string                         `json:"@odata.context"`
+	ODataNextLink string                         `json:"@odata.nextLink,omitempty"`
+	Value         []MobileAppDeviceInstallStatus `json:"value"`
+}
+
+// MobileAppDeviceInstallStatus represents the install status of an app on a device. ErrorCode is the signed
+// 32-bit code reported by the device; see DescribeMobileAppInstallErrorCode.
+type MobileAppDeviceInstallStatus struct {
+	ID                 string     `json:"id,omitempty"`
+	DeviceName         string     `json:"deviceName"`
+	DeviceID           string     `json:"deviceId"`
+	LastSyncDateTime   *time.Time `json:"lastSyncDateTime,omitempty"`
+	InstallState       string     `json:"installState"`
+	InstallStateDetail string     `json:"installStateDetail,omitempty"`
+	ErrorCode          int64      `json:"errorCode"`
+	OSVersion          string     `json:"osVersion,omitempty"`
+	OSDescription      string     `json:"osDescription,omitempty"`
+	UserName           string     `json:"userName,omitempty"`
+	UserPrincipalName  string     `json:"userPrincipalName,omitempty"`
+	DisplayVersion     string     `json:"displayVersion,omitempty"`
+}
+
+// ResponseMobileAppUserInstallStatusesList represents the install status of an app for each user.
+type ResponseMobileAppUserInstallStatusesList struct {
+	ODataContext  string                       `json:"@odata.context"`
+	ODataNextLink string                       `json:"@odata.nextLink,omitempty"`
+	Value         []MobileAppUserInstallStatus `json:"value"`
+}
+
+// MobileAppUserInstallStatus represents the install counts of an app across the devices of a user.
+type MobileAppUserInstallStatus struct {
+	ID                      string `json:"id,omitempty"`
+	UserName                string `json:"userName"`
+	UserPrincipalName       string `json:"userPrincipalName"`
+	InstalledDeviceCount    int    `json:"installedDeviceCount"`
+	FailedDeviceCount       int    `json:"failedDeviceCount"`
+	NotInstalledDeviceCount int    `json:"notInstalledDeviceCount"`
+}
+
+// MobileAppInstallStatusAggregate summarises the install status of an app on its devices by install state and
+// by error code.
+type MobileAppInstallStatusAggregate struct {
+	AppID          string
+	DeviceCount    int
+	ByInstallState map[string]int
+	Errors         []MobileAppInstallErrorCount
+}
+
+// MobileAppInstallErrorCount counts the devices that reported an installation error code.
+type MobileAppInstallErrorCount struct {
+	ErrorCode    int64
+	HexErrorCode string
+	Description  string
+	DeviceCount  int
+}
+
+// GetMobileAppInstallSummary retrieves the install counts of an app.
+func (c *Client) GetMobileAppInstallSummary(appID string) (*ResponseMobileAppInstallSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/installSummary", uriBetaMobileApps, appID)
+
+	var summary ResponseMobileAppInstallSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &summary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app install summary", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &summary, nil
+}
+
+// GetMobileAppDeviceInstallStatuses retrieves the install status of an app on each device.
+func (c *Client) GetMobileAppDeviceInstallStatuses(appID string) (*ResponseMobileAppDeviceInstallStatusesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatuses", uriBetaMobileApps, appID)
+
+	var statuses ResponseMobileAppDeviceInstallStatusesList
+	for endpoint != "" {
+		var page ResponseMobileAppDeviceInstallStatusesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app device install statuses", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if statuses.ODataContext == "" {
+			statuses.ODataContext = page.ODataContext
+		}
+		statuses.Value = append(statuses.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app device install statuses", err)
+			}
+		}
+	}
+
+	return &statuses, nil
+}
+
+// GetMobileAppUserInstallStatuses retrieves the install status of an app for each user.
+func (c *Client) GetMobileAppUserInstallStatuses(appID string) (*ResponseMobileAppUserInstallStatusesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/userStatuses", uriBetaMobileApps, appID)
+
+	var statuses ResponseMobileAppUserInstallStatusesList
+	for endpoint != "" {
+		var page ResponseMobileAppUserInstallStatusesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app user install statuses", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if statuses.ODataContext == "" {
+			statuses.ODataContext = page.ODataContext
+		}
+		statuses.Value = append(statuses.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile app user install statuses", err)
+			}
+		}
+	}
+
+	return &statuses, nil
+}
+
+// GetMobileAppDeviceInstallStatusReport runs the device install status report, which backs the Device install
+// status page of an app in the Intune portal. Filter uses the report syntax, e.g. (ApplicationId eq '<app id>').
+func (c *Client) GetMobileAppDeviceInstallStatusReport(request *ReportRequest) (*ResponseReportStream, error) {
+	return c.postReportStream(uriBetaReportsGetDeviceInstallStatusReport, request, "mobile app device install status report")
+}
+
+// GetMobileAppUserInstallStatusReport runs the user install status report, which backs the User install status
+// page of an app in the Intune portal.
+func (c *Client) GetMobileAppUserInstallStatusReport(request *ReportRequest) (*ResponseReportStream, error) {
+	return c.postReportStream(uriBetaReportsGetUserInstallStatusReport, request, "mobile app user install status report")
+}
+
+// GetMobileAppDeviceInstallStatusesFromReport retrieves every row of the device install status report of an app,
+// page by page, as device install statuses. The report is more current than deviceStatuses and covers every
+// app type.
+func (c *Client) GetMobileAppDeviceInstallStatusesFromReport(appID string) ([]MobileAppDeviceInstallStatus, error) {
+	report, err := c.postReportStreamAllPages(uriBetaReportsGetDeviceInstallStatusReport, &ReportRequest{
+		Select: mobileAppDeviceInstallStatusReportColumns,
+		Filter: fmt.Sprintf("(ApplicationId eq '%s')", odataEscapeString(appID)),
+	}, "mobile app device install status report")
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MobileAppDeviceInstallStatus
+	for _, row := range report.Rows() {
+		statuses = append(statuses, mobileAppDeviceInstallStatusFromReportRow(row))
+	}
+
+	return statuses, nil
+}
+
+// mobileAppDeviceInstallStatusFromReportRow converts a device install status report row.
+func mobileAppDeviceInstallStatusFromReportRow(row map[string]interface{}) MobileAppDeviceInstallStatus {
+	status := MobileAppDeviceInstallStatus{
+		DeviceName:         reportRowString(row, "DeviceName"),
+		DeviceID:           reportRowString(row, "DeviceId"),
+		InstallState:       normalizeMobileAppInstallState(reportRowString(row, "InstallState")),
+		InstallStateDetail: reportRowString(row, "InstallStateDetail"),
+		ErrorCode:          reportRowInt64(row, "ErrorCode"),
+		OSDescription:      reportRowString(row, "Platform"),
+		UserName:           reportRowString(row, "UserName"),
+		UserPrincipalName:  reportRowString(row, "UserPrincipalName"),
+		DisplayVersion:     reportRowString(row, "AppVersion"),
+	}
+
+	lastModified := reportRowString(row, "LastModifiedDateTime")
+	for _, layout := range reportTimeLayouts {
+		if modified, err := time.Parse(layout, lastModified); err == nil {
+			status.LastSyncDateTime = &modified
+			break
+		}
+	}
+
+	return status
+}
+
+// normalizeMobileAppInstallState converts the display text of an install state in a report, e.g. "Not
+// installed", to the install state value, e.g. notInstalled.
+func normalizeMobileAppInstallState(state string) string {
+	words := strings.FieldsFunc(state, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return MobileAppInstallStateUnknown
+	}
+
+	// Only the first letter of each word changes case, so values that are already camel case are kept
+	normalized := strings.ToLower(words[0][:1]) + words[0][1:]
+	for _, word := range words[1:] {
+		normalized += strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return normalized
+}
+
+// AggregateMobileAppDeviceInstallStatuses counts device install statuses by install state and by error code.
+// Error codes are ordered by the number of devices reporting them, most frequent first.
+func AggregateMobileAppDeviceInstallStatuses(appID string, statuses []MobileAppDeviceInstallStatus) *MobileAppInstallStatusAggregate {
+	aggregate := &MobileAppInstallStatusAggregate{
+		AppID:          appID,
+		DeviceCount:    len(statuses),
+		ByInstallState: make(map[string]int),
+	}
+
+	errorCounts := make(map[int64]int)
+	for _, status := range statuses {
+		aggregate.ByInstallState[status.InstallState]++
+		if status.ErrorCode != 0 {
+			errorCounts[status.ErrorCode]++
+		}
+	}
+
+	for code, count := range errorCounts {
+		aggregate.Errors = append(aggregate.Errors, MobileAppInstallErrorCount{
+			ErrorCode:    code,
+			HexErrorCode: FormatMobileAppInstallErrorCode(code),
+			Description:  DescribeMobileAppInstallErrorCode(code),
+			DeviceCount:  count,
+		})
+	}
+	sort.Slice(aggregate.Errors, func(i, j int) bool {
+		if aggregate.Errors[i].DeviceCount != aggregate.Errors[j].DeviceCount {
+			return aggregate.Errors[i].DeviceCount > aggregate.Errors[j].DeviceCount
+		}
+		return aggregate.Errors[i].ErrorCode < aggregate.Errors[j].ErrorCode
+	})
+
+	return aggregate
+}
+
+// GetMobileAppInstallStatusAggregate retrieves the device install status report of an app and aggregates it.
+func (c *Client) GetMobileAppInstallStatusAggregate(appID string) (*MobileAppInstallStatusAggregate, error) {
+	statuses, err := c.GetMobileAppDeviceInstallStatusesFromReport(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return AggregateMobileAppDeviceInstallStatuses(appID, statuses), nil
+}
+
+// GetMobileAppInstallStatusAggregates aggregates the install status of several apps concurrently, returning the
+// aggregates in the order of the given IDs.
+func (c *Client) GetMobileAppInstallStatusAggregates(appIDs []string) ([]*MobileAppInstallStatusAggregate, error) {
+	return shared.RunWorkerPool(c.maxConcurrentRequests(), len(appIDs), func(i int) (*MobileAppInstallStatusAggregate, error) {
+		return c.GetMobileAppInstallStatusAggregate(appIDs[i])
+	})
+}
@@ -0,0 +1,302 @@
+// graphbeta_device_app_management_mobile_app_relationships.go
+// Graph Beta Api - Intune: App supersedence and dependencies
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-win32-supersedence
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-win32-add#step-5-dependencies
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsWindowsMenu/~/windowsApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-mobileapprelationship?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+//
+// An app's child relationships point at the apps it supersedes or depends on; its parent relationships are the
+// apps that supersede or depend on it. Relationships must not form a cycle, which is checked before they are
+// submitted.
+
+package intune
+
+import (
+	"fmt"
+	"strings"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	ODataTypeMobileAppSupersedence = "#microsoft.graph.mobileAppSupersedence"
+	ODataTypeMobileAppDependency   = "#microsoft.graph.mobileAppDependency"
+
+	MobileAppSupersedenceTypeUpdate  = "update"
+	MobileAppSupersedenceTypeReplace = "replace"
+
+	MobileAppDependencyTypeDetect      = "detect"
+	MobileAppDependencyTypeAutoInstall = "autoInstall"
+
+	MobileAppRelationshipTargetTypeChild  = "child"
+	MobileAppRelationshipTargetTypeParent = "parent"
+)
+
+// ResponseMobileAppRelationshipsList represents the relationships of an app.
+type ResponseMobileAppRelationshipsList struct {
+	ODataContext string                          `json:"@odata.context"`
+	Value        []ResourceMobileAppRelationship `json:"value"`
+}
+
+// ResourceMobileAppRelationship represents a supersedence or dependency relationship between two apps. When
+// submitting relationships only ODataType, TargetID and the supersedence or dependency type are required.
+type ResourceMobileAppRelationship struct {
+	ODataType            string `json:"@odata.type"`
+	ID                   string `json:"id,omitempty"`
+	TargetID             string `json:"targetId"`
+	TargetDisplayName    string `json:"targetDisplayName,omitempty"`
+	TargetDisplayVersion string `json:"targetDisplayVersion,omitempty"`
+	TargetPublisher      string `json:"targetPublisher,omitempty"`
+	TargetType           string `json:"targetType,omitempty"`
+	SourceID             string `json:"sourceId,omitempty"`
+	SourceDisplayName    string `json:"sourceDisplayName,omitempty"`
+
+	// Fields for mobileAppSupersedence
+	SupersedenceType    string `json:"supersedenceType,omitempty"`
+	SupersededAppCount  int    `json:"supersededAppCount,omitempty"`
+	SupersedingAppCount int    `json:"supersedingAppCount,omitempty"`
+
+	// Fields for mobileAppDependency
+	DependencyType    string `json:"dependencyType,omitempty"`
+	DependentAppCount int    `json:"dependentAppCount,omitempty"`
+	DependsOnAppCount int    `json:"dependsOnAppCount,omitempty"`
+}
+
+// MobileAppRelationshipCycleError is returned when submitting relationships would make an app supersede or
+// depend on itself, directly or through other apps. Path lists the app IDs of the cycle, starting and ending
+// with AppID.
+type MobileAppRelationshipCycleError struct {
+	AppID string
+	Path  []string
+}
+
+func (e *MobileAppRelationshipCycleError) Error() string {
+	return fmt.Sprintf("mobile app relationships of %s would create a cycle: %s", e.AppID, strings.Join(e.Path, " -> "))
+}
+
+// GetMobileAppRelationships retrieves the supersedence and dependency relationships of an app, both those it
+// is the source of (child) and those it is the target of (parent).
+func (c *Client) GetMobileAppRelationships(appID string) (*ResponseMobileAppRelationshipsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/relationships", uriBetaMobileApps, appID)
+
+	var relationships ResponseMobileAppRelationshipsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &relationships)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app relationships", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &relationships, nil
+}
+
+// UpdateMobileAppRelationships replaces the apps an app supersedes and depends on. The relationships are
+// checked locally, and against the relationships of the target apps for cycles, before they are submitted; a
+// cycle is reported as a *MobileAppRelationshipCycleError.
+func (c *Client) UpdateMobileAppRelationships(appID string, relationships []ResourceMobileAppRelationship) error {
+	endpoint := fmt.Sprintf("%s/%s/updateRelationships", uriBetaMobileApps, appID)
+
+	if err := validateMobileAppRelationships(appID, relationships); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app relationships", appID, err)
+	}
+
+	if err := c.checkMobileAppRelationshipCycles(appID, relationships); err != nil {
+		return err
+	}
+
+	requestBody := struct {
+		Relationships []ResourceMobileAppRelationship `json:"relationships"`
+	}{}
+	for _, relationship := range relationships {
+		requestBody.Relationships = append(requestBody.Relationships, ResourceMobileAppRelationship{
+			ODataType:        relationship.ODataType,
+			TargetID:         relationship.TargetID,
+			SupersedenceType: relationship.SupersedenceType,
+			DependencyType:   relationship.DependencyType,
+		})
+	}
+	if requestBody.Relationships == nil {
+		requestBody.Relationships = []ResourceMobileAppRelationship{}
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app relationships", appID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// AddMobileAppDependency makes an app depend on another app, keeping its existing relationships.
+func (c *Client) AddMobileAppDependency(appID, targetAppID, dependencyType string) error {
+	return c.addMobileAppRelationship(appID, ResourceMobileAppRelationship{
+		ODataType:      ODataTypeMobileAppDependency,
+		TargetID:       targetAppID,
+		DependencyType: dependencyType,
+	})
+}
+
+// AddMobileAppSupersedence makes an app supersede another app, keeping its existing relationships.
+func (c *Client) AddMobileAppSupersedence(appID, targetAppID, supersedenceType string) error {
+	return c.addMobileAppRelationship(appID, ResourceMobileAppRelationship{
+		ODataType:        ODataTypeMobileAppSupersedence,
+		TargetID:         targetAppID,
+		SupersedenceType: supersedenceType,
+	})
+}
+
+// RemoveMobileAppRelationship removes the relationship from an app to a target app, keeping the others.
+func (c *Client) RemoveMobileAppRelationship(appID, targetAppID string) error {
+	relationships, err := c.getMobileAppChildRelationships(appID)
+	if err != nil {
+		return err
+	}
+
+	var kept []ResourceMobileAppRelationship
+	for _, relationship := range relationships {
+		if relationship.TargetID != targetAppID {
+			kept = append(kept, relationship)
+		}
+	}
+
+	if len(kept) == len(relationships) {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "mobile app relationship", targetAppID, "relationship not found")
+	}
+
+	return c.UpdateMobileAppRelationships(appID, kept)
+}
+
+// addMobileAppRelationship appends a relationship to the existing child relationships of an app.
+func (c *Client) addMobileAppRelationship(appID string, relationship ResourceMobileAppRelationship) error {
+	relationships, err := c.getMobileAppChildRelationships(appID)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateMobileAppRelationships(appID, append(relationships, relationship))
+}
+
+// getMobileAppChildRelationships retrieves the relationships an app is the source of.
+func (c *Client) getMobileAppChildRelationships(appID string) ([]ResourceMobileAppRelationship, error) {
+	relationships, err := c.GetMobileAppRelationships(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []ResourceMobileAppRelationship
+	for _, relationship := range relationships.Value {
+		if relationship.TargetType == MobileAppRelationshipTargetTypeChild {
+			children = append(children, relationship)
+		}
+	}
+
+	return children, nil
+}
+
+// validateMobileAppRelationships checks the relationship types and that no app is targeted twice or by the
+// app itself.
+func validateMobileAppRelationships(appID string, relationships []ResourceMobileAppRelationship) error {
+	targets := make(map[string]bool, len(relationships))
+
+	for _, relationship := range relationships {
+		switch relationship.ODataType {
+		case ODataTypeMobileAppSupersedence:
+			if relationship.SupersedenceType != MobileAppSupersedenceTypeUpdate && relationship.SupersedenceType != MobileAppSupersedenceTypeReplace {
+				return fmt.Errorf("unsupported supersedence type %q for app %s", relationship.SupersedenceType, relationship.TargetID)
+			}
+		case ODataTypeMobileAppDependency:
+			if relationship.DependencyType != MobileAppDependencyTypeDetect && relationship.DependencyType != MobileAppDependencyTypeAutoInstall {
+				return fmt.Errorf("unsupported dependency type %q for app %s", relationship.DependencyType, relationship.TargetID)
+			}
+		default:
+			return fmt.Errorf("unsupported mobile app relationship @odata.type: %q", relationship.ODataType)
+		}
+
+		if relationship.TargetID == appID {
+			return &MobileAppRelationshipCycleError{AppID: appID, Path: []string{appID, appID}}
+		}
+		if targets[relationship.TargetID] {
+			return fmt.Errorf("app %s is the target of more than one relationship", relationship.TargetID)
+		}
+		targets[relationship.TargetID] = true
+	}
+
+	return nil
+}
+
+// checkMobileAppRelationshipCycles walks the child relationships of the target apps and reports a cycle if the
+// walk leads back to appID.
+func (c *Client) checkMobileAppRelationshipCycles(appID string, relationships []ResourceMobileAppRelationship) error {
+	proposed := make([]string, 0, len(relationships))
+	for _, relationship := range relationships {
+		proposed = append(proposed, relationship.TargetID)
+	}
+
+	targetsOf := func(id string) ([]string, error) {
+		if id == appID {
+			return proposed, nil
+		}
+
+		children, err := c.getMobileAppChildRelationships(id)
+		if err != nil {
+			return nil, err
+		}
+
+		targets := make([]string, 0, len(children))
+		for _, child := range children {
+			targets = append(targets, child.TargetID)
+		}
+		return targets, nil
+	}
+
+	path, err := findMobileAppRelationshipCycle(appID, targetsOf)
+	if err != nil {
+		return err
+	}
+	if path != nil {
+		return &MobileAppRelationshipCycleError{AppID: appID, Path: path}
+	}
+
+	return nil
+}
+
+// findMobileAppRelationshipCycle searches depth first from start and returns the path of the first cycle back
+// to start, or nil when there is none. Each app's targets are looked up once.
+func findMobileAppRelationshipCycle(start string, targetsOf func(string) ([]string, error)) ([]string, error) {
+	visited := map[string]bool{}
+
+	var visit func(id string, path []string) ([]string, error)
+	visit = func(id string, path []string) ([]string, error) {
+		targets, err := targetsOf(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			if target == start {
+				return append(append([]string{}, path...), target), nil
+			}
+			if visited[target] {
+				continue
+			}
+			visited[target] = true
+
+			cycle, err := visit(target, append(path, target))
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	return visit(start, []string{start})
+}
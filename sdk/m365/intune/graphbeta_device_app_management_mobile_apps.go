@@ -0,0 +1,492 @@
+// graphbeta_device_app_management_mobile_apps.go
+// Graph Beta Api - Intune: Apps
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-add
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/allApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-mobileapp?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+//
+// ResourceMobileApp covers the app types below; the type is selected by ODataType. Win32 apps have their own
+// resource, ResourceWin32LobApp.
+
+package intune
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	ODataTypeWinGetApp                    = "#microsoft.graph.winGetApp"
+	ODataTypeMicrosoftStoreForBusinessApp = "#microsoft.graph.microsoftStoreForBusinessApp"
+	ODataTypeMacOSLobApp                  = "#microsoft.graph.macOSLobApp"
+	ODataTypeMacOSDmgApp                  = "#microsoft.graph.macOSDmgApp"
+	ODataTypeIOSVppApp                    = "#microsoft.graph.iosVppApp"
+	ODataTypeWebApp                       = "#microsoft.graph.webApp"
+	ODataTypeOfficeSuiteApp               = "#microsoft.graph.officeSuiteApp"
+
+	MobileAppPublishingStateNotPublished = "notPublished"
+	MobileAppPublishingStateProcessing   = "processing"
+	MobileAppPublishingStatePublished    = "published"
+
+	MicrosoftStoreForBusinessLicenseTypeOffline = "offline"
+	MicrosoftStoreForBusinessLicenseTypeOnline  = "online"
+
+	OfficeUpdateChannelCurrent              = "current"
+	OfficeUpdateChannelDeferred             = "deferred"
+	OfficeUpdateChannelFirstReleaseCurrent  = "firstReleaseCurrent"
+	OfficeUpdateChannelFirstReleaseDeferred = "firstReleaseDeferred"
+	OfficeUpdateChannelMonthlyEnterprise    = "monthlyEnterprise"
+
+	OfficeProductIDO365ProPlusRetail  = "o365ProPlusRetail"
+	OfficeProductIDO365BusinessRetail = "o365BusinessRetail"
+	OfficeProductIDVisioProRetail     = "visioProRetail"
+	OfficeProductIDProjectProRetail   = "projectProRetail"
+	OfficePlatformArchitectureX86     = "x86"
+	OfficePlatformArchitectureX64     = "x64"
+	OfficeInstallProgressDisplayNone  = "none"
+	OfficeInstallProgressDisplayFull  = "full"
+)
+
+// ResponseMobileAppsList represents a list of apps.
+type ResponseMobileAppsList struct {
+	ODataContext  string              `json:"@odata.context"`
+	ODataNextLink string              `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceMobileApp `json:"value"`
+}
+
+// ResourceMobileApp represents an app in the Intune app catalog.
+type ResourceMobileApp struct {
+	ODataType             string                `json:"@odata.type"`
+	ID                    string                `json:"id,omitempty"`
+	DisplayName           string                `json:"displayName"`
+	Description           string                `json:"description,omitempty"`
+	Publisher             string                `json:"publisher"`
+	LargeIcon             *MobileAppMimeContent `json:"largeIcon,omitempty"`
+	CreatedDateTime       *time.Time            `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime  *time.Time            `json:"lastModifiedDateTime,omitempty"`
+	IsFeatured            *bool                 `json:"isFeatured,omitempty"`
+	PrivacyInformationUrl string                `json:"privacyInformationUrl,omitempty"`
+	InformationUrl        string                `json:"informationUrl,omitempty"`
+	Owner                 string                `json:"owner,omitempty"`
+	Developer             string                `json:"developer,omitempty"`
+	Notes                 string                `json:"notes,omitempty"`
+	PublishingState       string                `json:"publishingState,omitempty"`
+	IsAssigned            bool                  `json:"isAssigned,omitempty"`
+	RoleScopeTagIds       []string              `json:"roleScopeTagIds,omitempty"`
+	DependentAppCount     int                   `json:"dependentAppCount,omitempty"`
+	SupersedingAppCount   int                   `json:"supersedingAppCount,omitempty"`
+	SupersededAppCount    int                   `json:"supersededAppCount,omitempty"`
+
+	// Fields for line-of-business apps (macOSLobApp and macOSDmgApp)
+	CommittedContentVersion         string                       `json:"committedContentVersion,omitempty"`
+	FileName                        string                       `json:"fileName,omitempty"`
+	Size                            int64                        `json:"size,omitempty"`
+	MinimumSupportedOperatingSystem *MacOSMinimumOperatingSystem `json:"minimumSupportedOperatingSystem,omitempty"`
+	IgnoreVersionDetection          *bool                        `json:"ignoreVersionDetection,omitempty"`
+
+	// Fields for macOSLobApp (.pkg)
+	BundleID          string             `json:"bundleId,omitempty"`
+	BuildNumber       string             `json:"buildNumber,omitempty"`
+	VersionNumber     string             `json:"versionNumber,omitempty"`
+	ChildApps         []MacOSLobChildApp `json:"childApps,omitempty"`
+	InstallAsManaged  *bool              `json:"installAsManaged,omitempty"`
+	PreInstallScript  *MacOSLobAppScript `json:"preInstallScript,omitempty"`
+	PostInstallScript *MacOSLobAppScript `json:"postInstallScript,omitempty"`
+
+	// Fields for macOSDmgApp (.dmg)
+	PrimaryBundleID      string             `json:"primaryBundleId,omitempty"`
+	PrimaryBundleVersion string             `json:"primaryBundleVersion,omitempty"`
+	IncludedApps         []MacOSIncludedApp `json:"includedApps,omitempty"`
+
+	// Fields for winGetApp
+	PackageIdentifier string                      `json:"packageIdentifier,omitempty"`
+	ManifestHash      string                      `json:"manifestHash,omitempty"`
+	InstallExperience *WinGetAppInstallExperience `json:"installExperience,omitempty"`
+
+	// Fields for microsoftStoreForBusinessApp and iosVppApp
+	UsedLicenseCount  int                     `json:"usedLicenseCount,omitempty"`
+	TotalLicenseCount int                     `json:"totalLicenseCount,omitempty"`
+	LicensingType     *MobileAppLicensingType `json:"licensingType,omitempty"`
+
+	// Fields for microsoftStoreForBusinessApp
+	ProductKey          string `json:"productKey,omitempty"`
+	LicenseType         string `json:"licenseType,omitempty"`
+	PackageIdentityName string `json:"packageIdentityName,omitempty"`
+
+	// Fields for iosVppApp; VPP apps are synchronised from Apple Business Manager and cannot be created
+	ReleaseDateTime          *time.Time     `json:"releaseDateTime,omitempty"`
+	AppStoreUrl              string         `json:"appStoreUrl,omitempty"`
+	ApplicableDeviceType     *IOSDeviceType `json:"applicableDeviceType,omitempty"`
+	VppTokenOrganizationName string         `json:"vppTokenOrganizationName,omitempty"`
+	VppTokenAccountType      string         `json:"vppTokenAccountType,omitempty"`
+	VppTokenAppleId          string         `json:"vppTokenAppleId,omitempty"`
+	VppTokenID               string         `json:"vppTokenId,omitempty"`
+
+	// Fields for webApp
+	AppUrl            string `json:"appUrl,omitempty"`
+	UseManagedBrowser *bool  `json:"useManagedBrowser,omitempty"`
+
+	// Fields for officeSuiteApp; OfficeConfigurationXml is base64 encoded and replaces the other settings
+	AutoAcceptEula                       *bool                       `json:"autoAcceptEula,omitempty"`
+	ProductIds                           []string                    `json:"productIds,omitempty"`
+	ExcludedApps                         *OfficeSuiteAppExcludedApps `json:"excludedApps,omitempty"`
+	UseSharedComputerActivation          *bool                       `json:"useSharedComputerActivation,omitempty"`
+	UpdateChannel                        string                      `json:"updateChannel,omitempty"`
+	OfficePlatformArchitecture           string                      `json:"officePlatformArchitecture,omitempty"`
+	LocalesToInstall                     []string                    `json:"localesToInstall,omitempty"`
+	InstallProgressDisplayLevel          string                      `json:"installProgressDisplayLevel,omitempty"`
+	ShouldUninstallOlderVersionsOfOffice *bool                       `json:"shouldUninstallOlderVersionsOfOffice,omitempty"`
+	TargetVersion                        string                      `json:"targetVersion,omitempty"`
+	UpdateVersion                        string                      `json:"updateVersion,omitempty"`
+	OfficeConfigurationXml               string                      `json:"officeConfigurationXml,omitempty"`
+}
+
+// MacOSMinimumOperatingSystem represents the minimum macOS version an app supports. Set exactly one field.
+type MacOSMinimumOperatingSystem struct {
+	V10_13 bool `json:"v10_13,omitempty"`
+	V10_14 bool `json:"v10_14,omitempty"`
+	V10_15 bool `json:"v10_15,omitempty"`
+	V11_0  bool `json:"v11_0,omitempty"`
+	V12_0  bool `json:"v12_0,omitempty"`
+	V13_0  bool `json:"v13_0,omitempty"`
+	V14_0  bool `json:"v14_0,omitempty"`
+}
+
+// MacOSLobChildApp represents an app bundle installed by a .pkg.
+type MacOSLobChildApp struct {
+	BundleID      string `json:"bundleId"`
+	BuildNumber   string `json:"buildNumber,omitempty"`
+	VersionNumber string `json:"versionNumber,omitempty"`
+}
+
+// MacOSIncludedApp represents an app bundle contained in a .dmg.
+type MacOSIncludedApp struct {
+	BundleID      string `json:"bundleId"`
+	BundleVersion string `json:"bundleVersion"`
+}
+
+// MacOSLobAppScript represents a shell script run before or after a .pkg is installed; ScriptContent is base64
+// encoded.
+type MacOSLobAppScript struct {
+	ScriptContent string `json:"scriptContent"`
+}
+
+// WinGetAppInstallExperience represents the context a WinGet app is installed in.
+type WinGetAppInstallExperience struct {
+	RunAsAccount string `json:"runAsAccount"`
+}
+
+// MobileAppLicensingType represents whether a store app supports user and device licensing.
+type MobileAppLicensingType struct {
+	SupportsUserLicensing   bool `json:"supportsUserLicensing"`
+	SupportsDeviceLicensing bool `json:"supportsDeviceLicensing"`
+}
+
+// IOSDeviceType represents the iOS device types an app applies to.
+type IOSDeviceType struct {
+	IPad          bool `json:"iPad"`
+	IPhoneAndIPod bool `json:"iPhoneAndIPod"`
+}
+
+// OfficeSuiteAppExcludedApps represents the Microsoft 365 apps that are not installed.
+type OfficeSuiteAppExcludedApps struct {
+	Access             bool `json:"access"`
+	Bing               bool `json:"bing"`
+	Excel              bool `json:"excel"`
+	Groove             bool `json:"groove"`
+	InfoPath           bool `json:"infoPath"`
+	Lync               bool `json:"lync"`
+	OneDrive           bool `json:"oneDrive"`
+	OneNote            bool `json:"oneNote"`
+	Outlook            bool `json:"outlook"`
+	PowerPoint         bool `json:"powerPoint"`
+	Publisher          bool `json:"publisher"`
+	SharePointDesigner bool `json:"sharePointDesigner"`
+	Teams              bool `json:"teams"`
+	Visio              bool `json:"visio"`
+	Word               bool `json:"word"`
+}
+
+// validateMobileAppODataType checks that the app type is one covered by ResourceMobileApp.
+func validateMobileAppODataType(odataType string) error {
+	switch odataType {
+	case ODataTypeWinGetApp,
+		ODataTypeMicrosoftStoreForBusinessApp,
+		ODataTypeMacOSLobApp,
+		ODataTypeMacOSDmgApp,
+		ODataTypeIOSVppApp,
+		ODataTypeWebApp,
+		ODataTypeOfficeSuiteApp:
+		return nil
+	}
+
+	return fmt.Errorf("unsupported mobile app @odata.type: %q", odataType)
+}
+
+// GetMobileApps retrieves every app in the Intune app catalog.
+func (c *Client) GetMobileApps() (*ResponseMobileAppsList, error) {
+	return c.getMobileApps(uriBetaMobileApps)
+}
+
+// GetMobileAppsByODataType retrieves the apps of one type, e.g. ODataTypeWebApp.
+func (c *Client) GetMobileAppsByODataType(odataType string) (*ResponseMobileAppsList, error) {
+	return c.getMobileApps(uriBetaMobileApps + "?$filter=" + url.QueryEscape(fmt.Sprintf("isof('%s')", strings.TrimPrefix(odataType, "#"))))
+}
+
+// getMobileApps retrieves all pages of apps starting from the given endpoint.
+func (c *Client) getMobileApps(endpoint string) (*ResponseMobileAppsList, error) {
+	var apps ResponseMobileAppsList
+
+	for endpoint != "" {
+		var page ResponseMobileAppsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile apps", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if apps.ODataContext == "" {
+			apps.ODataContext = page.ODataContext
+		}
+		apps.Value = append(apps.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "mobile apps", err)
+			}
+		}
+	}
+
+	return &apps, nil
+}
+
+// GetMobileAppByID retrieves an app by its ID.
+func (c *Client) GetMobileAppByID(id string) (*ResourceMobileApp, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	var app ResourceMobileApp
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "mobile app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &app, nil
+}
+
+// GetMobileAppByDisplayName retrieves an app by its display name.
+func (c *Client) GetMobileAppByDisplayName(displayName string) (*ResourceMobileApp, error) {
+	endpoint := uriBetaMobileApps + "?$filter=" + url.QueryEscape(fmt.Sprintf("displayName eq '%s'", odataEscapeString(displayName)))
+
+	apps, err := c.getMobileApps(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "mobile app", displayName, err)
+	}
+
+	if len(apps.Value) == 0 {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "mobile app", displayName, "app not found")
+	}
+
+	return &apps.Value[0], nil
+}
+
+// CreateMobileApp creates an app of the type set in ODataType. Line-of-business apps are created without
+// content; upload it with UploadMobileAppContentFromFile.
+func (c *Client) CreateMobileApp(request *ResourceMobileApp) (*ResourceMobileApp, error) {
+	endpoint := uriBetaMobileApps
+
+	if err := validateMobileAppODataType(request.ODataType); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app", err)
+	}
+	if request.ODataType == ODataTypeIOSVppApp {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app", "iOS VPP apps are synchronised from Apple Business Manager and cannot be created")
+	}
+
+	var createdApp ResourceMobileApp
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdApp)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "mobile app", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdApp, nil
+}
+
+// CreateMobileAppWithAssignment creates an app and then assigns it.
+func (c *Client) CreateMobileAppWithAssignment(request *ResourceMobileApp, assignment *AssignmentMobileApp) (*ResourceMobileApp, error) {
+	createdApp, err := c.CreateMobileApp(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateMobileAppAssignment(createdApp.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdApp, nil
+}
+
+// UploadMobileAppContentFromFile encrypts a macOS .pkg or .dmg and uploads it as the committed content of a
+// macOSLobApp or macOSDmgApp.
+func (c *Client) UploadMobileAppContentFromFile(appID, appODataType, filePath string, options *MobileAppContentUploadOptions) error {
+	if appODataType != ODataTypeMacOSLobApp && appODataType != ODataTypeMacOSDmgApp {
+		return fmt.Errorf("content upload from file is not supported for mobile app @odata.type: %q", appODataType)
+	}
+
+	workDir, err := os.MkdirTemp("", "mobileappcontent")
+	if err != nil {
+		return fmt.Errorf("failed to create mobile app content working folder: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	size, fileDigest, err := sha256File(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read mobile app content %s: %v", filePath, err)
+	}
+
+	encryptedPath := filepath.Join(workDir, filepath.Base(filePath))
+	encryptionInfo, err := encryptIntuneWinContent(filePath, encryptedPath)
+	if err != nil {
+		return err
+	}
+	encryptionInfo.FileDigest = base64.StdEncoding.EncodeToString(fileDigest)
+
+	encrypted, err := os.Open(encryptedPath)
+	if err != nil {
+		return fmt.Errorf("failed to open encrypted mobile app content: %v", err)
+	}
+	defer encrypted.Close()
+
+	encryptedInfo, err := encrypted.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to open encrypted mobile app content: %v", err)
+	}
+
+	contentVersion, err := c.CreateMobileAppContentVersion(appID, appODataType)
+	if err != nil {
+		return err
+	}
+
+	file, err := c.CreateMobileAppContentFile(appID, appODataType, contentVersion.ID, &ResourceMobileAppContentFile{
+		Name:          filepath.Base(filePath),
+		Size:          size,
+		SizeEncrypted: encryptedInfo.Size(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.UploadMobileAppContentFile(appID, appODataType, contentVersion.ID, file.ID, encrypted, encryptionInfo, options); err != nil {
+		return err
+	}
+
+	return c.SetMobileAppCommittedContentVersion(appID, appODataType, contentVersion.ID)
+}
+
+// sha256File returns the size and SHA256 digest of a file.
+func sha256File(filePath string) (int64, []byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer file.Close()
+
+	digest := sha256.New()
+	size, err := io.Copy(digest, file)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return size, digest.Sum(nil), nil
+}
+
+// UpdateMobileAppByID updates an app by its ID. ODataType must be set to the type of the app.
+func (c *Client) UpdateMobileAppByID(id string, request *ResourceMobileApp) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	if err := validateMobileAppODataType(request.ODataType); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app", id, err)
+	}
+
+	payload := *request
+	payload.ID = ""
+	payload.CreatedDateTime = nil
+	payload.LastModifiedDateTime = nil
+	payload.PublishingState = ""
+	payload.IsAssigned = false
+	payload.DependentAppCount = 0
+	payload.SupersedingAppCount = 0
+	payload.SupersededAppCount = 0
+	payload.CommittedContentVersion = ""
+	payload.Size = 0
+	payload.UsedLicenseCount = 0
+	payload.TotalLicenseCount = 0
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "mobile app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateMobileAppByDisplayName updates an app by its display name.
+func (c *Client) UpdateMobileAppByDisplayName(displayName string, request *ResourceMobileApp) error {
+	app, err := c.GetMobileAppByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "mobile app", displayName, err)
+	}
+
+	return c.UpdateMobileAppByID(app.ID, request)
+}
+
+// DeleteMobileAppByID deletes an app by its ID. Apps that other apps depend on or supersede cannot be deleted
+// until the relationships are removed.
+func (c *Client) DeleteMobileAppByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "mobile app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteMobileAppByDisplayName deletes an app by its display name.
+func (c *Client) DeleteMobileAppByDisplayName(displayName string) error {
+	app, err := c.GetMobileAppByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "mobile app", displayName, err)
+	}
+
+	return c.DeleteMobileAppByID(app.ID)
+}
@@ -0,0 +1,305 @@
+// graphbeta_device_app_management_targeted_managed_app_configurations.go
+// Graph Beta Api - Intune: App configuration policies for managed apps
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-configuration-policies-managed-app
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/appConfig
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-mam-targetedmanagedappconfiguration?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// App configuration policies for managed apps deliver name/value settings to apps that integrate the Intune App
+// SDK, regardless of whether the device is enrolled.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaTargetedManagedAppConfigurations  = "/beta/deviceAppManagement/targetedManagedAppConfigurations"
+	odataTypeTargetedManagedAppConfiguration = "#microsoft.graph.targetedManagedAppConfiguration"
+)
+
+// ResponseTargetedManagedAppConfigurationsList represents a list of app configuration policies for managed apps.
+type ResponseTargetedManagedAppConfigurationsList struct {
+	ODataContext  string                                    `json:"@odata.context"`
+	ODataNextLink string                                    `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceTargetedManagedAppConfiguration `json:"value"`
+}
+
+// ResourceTargetedManagedAppConfiguration represents an app configuration policy for managed apps. It is used as
+// both the request and response structure; Apps and Assignments are only populated when retrieved by ID.
+type ResourceTargetedManagedAppConfiguration struct {
+	ODataType                   string                               `json:"@odata.type,omitempty"`
+	ID                          string                               `json:"id,omitempty"`
+	DisplayName                 string                               `json:"displayName"`
+	Description                 string                               `json:"description,omitempty"`
+	CreatedDateTime             *time.Time                           `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime        *time.Time                           `json:"lastModifiedDateTime,omitempty"`
+	RoleScopeTagIds             []string                             `json:"roleScopeTagIds,omitempty"`
+	Version                     string                               `json:"version,omitempty"`
+	IsAssigned                  bool                                 `json:"isAssigned,omitempty"`
+	DeployedAppCount            int                                  `json:"deployedAppCount,omitempty"`
+	TargetedAppManagementLevels string                               `json:"targetedAppManagementLevels,omitempty"`
+	AppGroupType                string                               `json:"appGroupType,omitempty"`
+	CustomSettings              []KeyValuePair                       `json:"customSettings"`
+	Apps                        []ManagedMobileApp                   `json:"apps,omitempty"`
+	Assignments                 []TargetedManagedAppPolicyAssignment `json:"assignments,omitempty"`
+}
+
+// validateTargetedManagedAppConfiguration checks that setting names are set and unique, as an app receives the
+// settings as a dictionary.
+func validateTargetedManagedAppConfiguration(request *ResourceTargetedManagedAppConfiguration) error {
+	names := make(map[string]bool, len(request.CustomSettings))
+
+	for _, setting := range request.CustomSettings {
+		if setting.Name == "" {
+			return fmt.Errorf("app configuration setting with value %q has no name", setting.Value)
+		}
+		if names[setting.Name] {
+			return fmt.Errorf("app configuration setting %s is defined more than once", setting.Name)
+		}
+		names[setting.Name] = true
+	}
+
+	for _, app := range request.Apps {
+		if app.MobileAppIdentifier.BundleID == "" && app.MobileAppIdentifier.PackageID == "" {
+			return fmt.Errorf("app identifier %s has no bundle or package ID", app.MobileAppIdentifier.ODataType)
+		}
+	}
+
+	return nil
+}
+
+// GetTargetedManagedAppConfigurations retrieves all app configuration policies for managed apps.
+func (c *Client) GetTargetedManagedAppConfigurations() (*ResponseTargetedManagedAppConfigurationsList, error) {
+	endpoint := uriBetaTargetedManagedAppConfigurations
+
+	var configurations ResponseTargetedManagedAppConfigurationsList
+
+	for endpoint != "" {
+		var page ResponseTargetedManagedAppConfigurationsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "targeted managed app configurations", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if configurations.ODataContext == "" {
+			configurations.ODataContext = page.ODataContext
+		}
+		configurations.Value = append(configurations.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "targeted managed app configurations", err)
+			}
+		}
+	}
+
+	return &configurations, nil
+}
+
+// GetTargetedManagedAppConfigurationByID retrieves an app configuration policy for managed apps by its ID with
+// its targeted apps and assignments.
+func (c *Client) GetTargetedManagedAppConfigurationByID(configurationID string) (*ResourceTargetedManagedAppConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s?%s", uriBetaTargetedManagedAppConfigurations, configurationID, managedAppPolicyExpand)
+
+	var configuration ResourceTargetedManagedAppConfiguration
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "targeted managed app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &configuration, nil
+}
+
+// GetTargetedManagedAppConfigurationByDisplayName retrieves an app configuration policy for managed apps by its
+// display name.
+func (c *Client) GetTargetedManagedAppConfigurationByDisplayName(displayName string) (*ResourceTargetedManagedAppConfiguration, error) {
+	configurations, err := c.GetTargetedManagedAppConfigurations()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "targeted managed app configuration", displayName, err)
+	}
+
+	var configurationID string
+	for _, configuration := range configurations.Value {
+		if configuration.DisplayName == displayName {
+			configurationID = configuration.ID
+			break
+		}
+	}
+
+	if configurationID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "targeted managed app configuration", displayName, "configuration not found")
+	}
+
+	return c.GetTargetedManagedAppConfigurationByID(configurationID)
+}
+
+// CreateTargetedManagedAppConfiguration creates an app configuration policy for managed apps. Apps in the request
+// are targeted with the targetApps action once the policy has been created.
+func (c *Client) CreateTargetedManagedAppConfiguration(request *ResourceTargetedManagedAppConfiguration) (*ResourceTargetedManagedAppConfiguration, error) {
+	endpoint := uriBetaTargetedManagedAppConfigurations
+
+	if err := validateTargetedManagedAppConfiguration(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "targeted managed app configuration", err)
+	}
+
+	// Set graph metadata values
+	configuration := *request
+	configuration.ODataType = odataTypeTargetedManagedAppConfiguration
+	if configuration.CustomSettings == nil {
+		configuration.CustomSettings = []KeyValuePair{}
+	}
+
+	// Exclude navigation properties from the request object
+	configuration.Apps = nil
+	configuration.Assignments = nil
+
+	var createdConfiguration ResourceTargetedManagedAppConfiguration
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &configuration, &createdConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "targeted managed app configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if len(request.Apps) > 0 {
+		if err := c.targetManagedAppPolicyApps(endpoint, "targeted managed app configuration", createdConfiguration.ID, request.AppGroupType, request.Apps); err != nil {
+			return nil, err
+		}
+		createdConfiguration.Apps = request.Apps
+	}
+
+	return &createdConfiguration, nil
+}
+
+// CreateTargetedManagedAppConfigurationWithAssignment creates an app configuration policy for managed apps and
+// assigns it.
+func (c *Client) CreateTargetedManagedAppConfigurationWithAssignment(request *ResourceTargetedManagedAppConfiguration, assignment *AssignmentTargetedManagedAppPolicy) (*ResourceTargetedManagedAppConfiguration, error) {
+	createdConfiguration, err := c.CreateTargetedManagedAppConfiguration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateTargetedManagedAppConfigurationAssignment(createdConfiguration.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdConfiguration, nil
+}
+
+// UpdateTargetedManagedAppConfigurationByID updates an app configuration policy for managed apps using the PATCH
+// method. The custom settings are replaced as a whole and, when Apps is not nil, so are the targeted apps.
+// Assignments are not changed; use CreateTargetedManagedAppConfigurationAssignment.
+func (c *Client) UpdateTargetedManagedAppConfigurationByID(configurationID string, request *ResourceTargetedManagedAppConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaTargetedManagedAppConfigurations, configurationID)
+
+	if err := validateTargetedManagedAppConfiguration(request); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "targeted managed app configuration", configurationID, err)
+	}
+
+	// Exclude navigation and read-only properties from the request object
+	patch := *request
+	patch.ODataType = odataTypeTargetedManagedAppConfiguration
+	patch.ID = ""
+	patch.Apps = nil
+	patch.Assignments = nil
+	patch.CreatedDateTime = nil
+	patch.LastModifiedDateTime = nil
+	patch.IsAssigned = false
+	patch.DeployedAppCount = 0
+	if patch.CustomSettings == nil {
+		patch.CustomSettings = []KeyValuePair{}
+	}
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "targeted managed app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if request.Apps != nil {
+		return c.targetManagedAppPolicyApps(uriBetaTargetedManagedAppConfigurations, "targeted managed app configuration", configurationID, request.AppGroupType, request.Apps)
+	}
+
+	return nil
+}
+
+// UpdateTargetedManagedAppConfigurationByDisplayName updates an app configuration policy for managed apps by its
+// display name.
+func (c *Client) UpdateTargetedManagedAppConfigurationByDisplayName(displayName string, request *ResourceTargetedManagedAppConfiguration) error {
+	configuration, err := c.GetTargetedManagedAppConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "targeted managed app configuration", displayName, err)
+	}
+
+	return c.UpdateTargetedManagedAppConfigurationByID(configuration.ID, request)
+}
+
+// DeleteTargetedManagedAppConfigurationByID deletes an app configuration policy for managed apps by its ID.
+func (c *Client) DeleteTargetedManagedAppConfigurationByID(configurationID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaTargetedManagedAppConfigurations, configurationID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "targeted managed app configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteTargetedManagedAppConfigurationByDisplayName deletes an app configuration policy for managed apps by its
+// display name.
+func (c *Client) DeleteTargetedManagedAppConfigurationByDisplayName(displayName string) error {
+	configuration, err := c.GetTargetedManagedAppConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "targeted managed app configuration", displayName, err)
+	}
+
+	return c.DeleteTargetedManagedAppConfigurationByID(configuration.ID)
+}
+
+// TargetTargetedManagedAppConfigurationApps replaces the apps an app configuration policy for managed apps
+// applies to.
+func (c *Client) TargetTargetedManagedAppConfigurationApps(configurationID, appGroupType string, apps []ManagedMobileApp) error {
+	return c.targetManagedAppPolicyApps(uriBetaTargetedManagedAppConfigurations, "targeted managed app configuration", configurationID, appGroupType, apps)
+}
+
+// GetTargetedManagedAppConfigurationAssignments retrieves the assignments of an app configuration policy for
+// managed apps.
+func (c *Client) GetTargetedManagedAppConfigurationAssignments(configurationID string) (*ResponseTargetedManagedAppPolicyAssignmentsList, error) {
+	return c.getTargetedManagedAppPolicyAssignments(uriBetaTargetedManagedAppConfigurations, "targeted managed app configuration", configurationID)
+}
+
+// CreateTargetedManagedAppConfigurationAssignment assigns an app configuration policy for managed apps using the
+// assign action. The supplied assignments replace any existing assignments of the policy.
+func (c *Client) CreateTargetedManagedAppConfigurationAssignment(configurationID string, assignment *AssignmentTargetedManagedAppPolicy) error {
+	return c.assignTargetedManagedAppPolicy(uriBetaTargetedManagedAppConfigurations, "targeted managed app configuration", configurationID, assignment)
+}
+
+// GetTargetedManagedAppConfigurationDeploymentSummary retrieves the number of users an app configuration policy
+// for managed apps has been applied to, in total and per app.
+func (c *Client) GetTargetedManagedAppConfigurationDeploymentSummary(configurationID string) (*ResponseManagedAppPolicyDeploymentSummary, error) {
+	return c.getManagedAppPolicyDeploymentSummary(uriBetaTargetedManagedAppConfigurations, "targeted managed app configuration", configurationID)
+}
@@ -0,0 +1,458 @@
+// graphbeta_device_app_management_win32_lob_apps.go
+// Graph Beta Api - Intune: Windows apps (Win32)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/apps-win32-add
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsWindowsMenu/~/windowsApps
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-apps-win32lobapp?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	ODataTypeWin32LobApp                     = "#microsoft.graph.win32LobApp"
+	ODataTypeWin32LobAppFileSystemRule       = "#microsoft.graph.win32LobAppFileSystemRule"
+	ODataTypeWin32LobAppRegistryRule         = "#microsoft.graph.win32LobAppRegistryRule"
+	ODataTypeWin32LobAppProductCodeRule      = "#microsoft.graph.win32LobAppProductCodeRule"
+	ODataTypeWin32LobAppPowerShellScriptRule = "#microsoft.graph.win32LobAppPowerShellScriptRule"
+
+	Win32LobAppRuleTypeDetection   = "detection"
+	Win32LobAppRuleTypeRequirement = "requirement"
+
+	Win32LobAppRuleOperatorNotConfigured      = "notConfigured"
+	Win32LobAppRuleOperatorEqual              = "equal"
+	Win32LobAppRuleOperatorNotEqual           = "notEqual"
+	Win32LobAppRuleOperatorGreaterThan        = "greaterThan"
+	Win32LobAppRuleOperatorGreaterThanOrEqual = "greaterThanOrEqual"
+	Win32LobAppRuleOperatorLessThan           = "lessThan"
+	Win32LobAppRuleOperatorLessThanOrEqual    = "lessThanOrEqual"
+
+	// Operation types of file system rules.
+	Win32LobAppFileSystemOperationTypeNotConfigured = "notConfigured"
+	Win32LobAppFileSystemOperationTypeExists        = "exists"
+	Win32LobAppFileSystemOperationTypeDoesNotExist  = "doesNotExist"
+	Win32LobAppFileSystemOperationTypeModifiedDate  = "modifiedDate"
+	Win32LobAppFileSystemOperationTypeCreatedDate   = "createdDate"
+	Win32LobAppFileSystemOperationTypeVersion       = "version"
+	Win32LobAppFileSystemOperationTypeSizeInMB      = "sizeInMB"
+
+	// Operation types of registry rules.
+	Win32LobAppRegistryRuleOperationTypeNotConfigured = "notConfigured"
+	Win32LobAppRegistryRuleOperationTypeExists        = "exists"
+	Win32LobAppRegistryRuleOperationTypeDoesNotExist  = "doesNotExist"
+	Win32LobAppRegistryRuleOperationTypeString        = "string"
+	Win32LobAppRegistryRuleOperationTypeInteger       = "integer"
+	Win32LobAppRegistryRuleOperationTypeVersion       = "version"
+
+	// Operation types of PowerShell script rules. Detection scripts use notConfigured; requirement scripts
+	// compare their output as the given type.
+	Win32LobAppPowerShellScriptRuleOperationTypeNotConfigured = "notConfigured"
+	Win32LobAppPowerShellScriptRuleOperationTypeString        = "string"
+	Win32LobAppPowerShellScriptRuleOperationTypeDateTime      = "dateTime"
+	Win32LobAppPowerShellScriptRuleOperationTypeInteger       = "integer"
+	Win32LobAppPowerShellScriptRuleOperationTypeFloat         = "float"
+	Win32LobAppPowerShellScriptRuleOperationTypeVersion       = "version"
+	Win32LobAppPowerShellScriptRuleOperationTypeBoolean       = "boolean"
+
+	Win32LobAppReturnCodeTypeSuccess    = "success"
+	Win32LobAppReturnCodeTypeSoftReboot = "softReboot"
+	Win32LobAppReturnCodeTypeHardReboot = "hardReboot"
+	Win32LobAppReturnCodeTypeRetry      = "retry"
+	Win32LobAppReturnCodeTypeFailed     = "failed"
+
+	Win32LobAppRunAsAccountSystem = "system"
+	Win32LobAppRunAsAccountUser   = "user"
+
+	Win32LobAppRestartBehaviorBasedOnReturnCode = "basedOnReturnCode"
+	Win32LobAppRestartBehaviorAllow             = "allow"
+	Win32LobAppRestartBehaviorSuppress          = "suppress"
+	Win32LobAppRestartBehaviorForce             = "force"
+
+	Win32LobAppMsiPackageTypePerMachine   = "perMachine"
+	Win32LobAppMsiPackageTypePerUser      = "perUser"
+	Win32LobAppMsiPackageTypeDualPurpose  = "dualPurpose"
+	defaultWin32LobAppArchitectures       = "x64,x86"
+	defaultWin32LobAppMaxRunTimeInMinutes = 60
+)
+
+// ResponseWin32LobAppsList represents a list of Win32 apps.
+type ResponseWin32LobAppsList struct {
+	ODataContext  string                `json:"@odata.context"`
+	ODataNextLink string                `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWin32LobApp `json:"value"`
+}
+
+// ResourceWin32LobApp represents a Win32 app deployed from a .intunewin package.
+type ResourceWin32LobApp struct {
+	ODataType                      string                        `json:"@odata.type"`
+	ID                             string                        `json:"id,omitempty"`
+	DisplayName                    string                        `json:"displayName"`
+	Description                    string                        `json:"description"`
+	Publisher                      string                        `json:"publisher"`
+	LargeIcon                      *MobileAppMimeContent         `json:"largeIcon,omitempty"`
+	CreatedDateTime                *time.Time                    `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime           *time.Time                    `json:"lastModifiedDateTime,omitempty"`
+	IsFeatured                     *bool                         `json:"isFeatured,omitempty"`
+	PrivacyInformationUrl          string                        `json:"privacyInformationUrl,omitempty"`
+	InformationUrl                 string                        `json:"informationUrl,omitempty"`
+	Owner                          string                        `json:"owner,omitempty"`
+	Developer                      string                        `json:"developer,omitempty"`
+	Notes                          string                        `json:"notes,omitempty"`
+	PublishingState                string                        `json:"publishingState,omitempty"`
+	RoleScopeTagIds                []string                      `json:"roleScopeTagIds,omitempty"`
+	CommittedContentVersion        string                        `json:"committedContentVersion,omitempty"`
+	FileName                       string                        `json:"fileName,omitempty"`
+	Size                           int64                         `json:"size,omitempty"`
+	InstallCommandLine             string                        `json:"installCommandLine,omitempty"`
+	UninstallCommandLine           string                        `json:"uninstallCommandLine,omitempty"`
+	ApplicableArchitectures        string                        `json:"applicableArchitectures,omitempty"`
+	MinimumSupportedWindowsRelease string                        `json:"minimumSupportedWindowsRelease,omitempty"`
+	MinimumFreeDiskSpaceInMB       *int                          `json:"minimumFreeDiskSpaceInMB,omitempty"`
+	MinimumMemoryInMB              *int                          `json:"minimumMemoryInMB,omitempty"`
+	MinimumNumberOfProcessors      *int                          `json:"minimumNumberOfProcessors,omitempty"`
+	MinimumCpuSpeedInMHz           *int                          `json:"minimumCpuSpeedInMHz,omitempty"`
+	Rules                          []Win32LobAppRule             `json:"rules,omitempty"`
+	InstallExperience              *Win32LobAppInstallExperience `json:"installExperience,omitempty"`
+	ReturnCodes                    []Win32LobAppReturnCode       `json:"returnCodes,omitempty"`
+	MsiInformation                 *Win32LobAppMsiInformation    `json:"msiInformation,omitempty"`
+	SetupFilePath                  string                        `json:"setupFilePath,omitempty"`
+	DisplayVersion                 string                        `json:"displayVersion,omitempty"`
+	AllowAvailableUninstall        *bool                         `json:"allowAvailableUninstall,omitempty"`
+}
+
+// MobileAppMimeContent represents an image, such as an app icon, as base64 encoded content with its mime type.
+type MobileAppMimeContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Win32LobAppRule represents a detection or requirement rule of a Win32 app. The rule kind is selected by
+// ODataType and only the fields of that kind are sent.
+type Win32LobAppRule struct {
+	ODataType string `json:"@odata.type"`
+	RuleType  string `json:"ruleType"`
+
+	// Fields for file system and registry rules
+	Check32BitOn64System bool   `json:"check32BitOn64System,omitempty"`
+	OperationType        string `json:"operationType,omitempty"`
+	Operator             string `json:"operator,omitempty"`
+	ComparisonValue      string `json:"comparisonValue,omitempty"`
+
+	// Fields for file system rules
+	Path             string `json:"path,omitempty"`
+	FileOrFolderName string `json:"fileOrFolderName,omitempty"`
+
+	// Fields for registry rules
+	KeyPath   string `json:"keyPath,omitempty"`
+	ValueName string `json:"valueName,omitempty"`
+
+	// Fields for product code rules
+	ProductCode            string `json:"productCode,omitempty"`
+	ProductVersionOperator string `json:"productVersionOperator,omitempty"`
+	ProductVersion         string `json:"productVersion,omitempty"`
+
+	// Fields for PowerShell script rules; ScriptContent is base64 encoded
+	DisplayName           string `json:"displayName,omitempty"`
+	EnforceSignatureCheck bool   `json:"enforceSignatureCheck,omitempty"`
+	RunAs32Bit            bool   `json:"runAs32Bit,omitempty"`
+	RunAsAccount          string `json:"runAsAccount,omitempty"`
+	ScriptContent         string `json:"scriptContent,omitempty"`
+}
+
+// Win32LobAppInstallExperience represents the context, restart behaviour and time limit of an installation.
+type Win32LobAppInstallExperience struct {
+	RunAsAccount          string `json:"runAsAccount"`
+	DeviceRestartBehavior string `json:"deviceRestartBehavior"`
+	MaxRunTimeInMinutes   int    `json:"maxRunTimeInMinutes,omitempty"`
+}
+
+// Win32LobAppReturnCode maps an installer exit code to the outcome Intune reports.
+type Win32LobAppReturnCode struct {
+	ReturnCode int    `json:"returnCode"`
+	Type       string `json:"type"`
+}
+
+// Win32LobAppMsiInformation represents the MSI details of a Win32 app whose setup file is an MSI.
+type Win32LobAppMsiInformation struct {
+	ProductCode    string `json:"productCode,omitempty"`
+	ProductVersion string `json:"productVersion,omitempty"`
+	UpgradeCode    string `json:"upgradeCode,omitempty"`
+	RequiresReboot bool   `json:"requiresReboot"`
+	PackageType    string `json:"packageType,omitempty"`
+	ProductName    string `json:"productName,omitempty"`
+	Publisher      string `json:"publisher,omitempty"`
+}
+
+// DefaultWin32LobAppReturnCodes returns the return codes the Intune portal configures for a new Win32 app.
+func DefaultWin32LobAppReturnCodes() []Win32LobAppReturnCode {
+	return []Win32LobAppReturnCode{
+		{ReturnCode: 0, Type: Win32LobAppReturnCodeTypeSuccess},
+		{ReturnCode: 1707, Type: Win32LobAppReturnCodeTypeSuccess},
+		{ReturnCode: 3010, Type: Win32LobAppReturnCodeTypeSoftReboot},
+		{ReturnCode: 1641, Type: Win32LobAppReturnCodeTypeHardReboot},
+		{ReturnCode: 1618, Type: Win32LobAppReturnCodeTypeRetry},
+	}
+}
+
+// NewWin32LobAppProductCodeDetectionRule returns a rule detecting an MSI by its product code. An empty
+// productVersion detects any version.
+func NewWin32LobAppProductCodeDetectionRule(productCode, productVersion string) Win32LobAppRule {
+	rule := Win32LobAppRule{
+		ODataType:              ODataTypeWin32LobAppProductCodeRule,
+		RuleType:               Win32LobAppRuleTypeDetection,
+		ProductCode:            productCode,
+		ProductVersionOperator: Win32LobAppRuleOperatorNotConfigured,
+	}
+	if productVersion != "" {
+		rule.ProductVersionOperator = Win32LobAppRuleOperatorEqual
+		rule.ProductVersion = productVersion
+	}
+	return rule
+}
+
+// NewWin32LobAppPowerShellScriptDetectionRule returns a rule that runs a PowerShell script to detect the app.
+// The app is detected when the script exits with 0 and writes to standard output.
+func NewWin32LobAppPowerShellScriptDetectionRule(scriptContent string, enforceSignatureCheck, runAs32Bit bool) Win32LobAppRule {
+	return Win32LobAppRule{
+		ODataType:             ODataTypeWin32LobAppPowerShellScriptRule,
+		RuleType:              Win32LobAppRuleTypeDetection,
+		OperationType:         Win32LobAppPowerShellScriptRuleOperationTypeNotConfigured,
+		Operator:              Win32LobAppRuleOperatorNotConfigured,
+		EnforceSignatureCheck: enforceSignatureCheck,
+		RunAs32Bit:            runAs32Bit,
+		ScriptContent:         base64.StdEncoding.EncodeToString([]byte(scriptContent)),
+	}
+}
+
+// validateWin32LobAppRules checks that the app has a detection rule, which Intune requires.
+func validateWin32LobAppRules(rules []Win32LobAppRule) error {
+	for _, rule := range rules {
+		if rule.RuleType == Win32LobAppRuleTypeDetection {
+			return nil
+		}
+	}
+	return fmt.Errorf("win32 app requires at least one detection rule")
+}
+
+// GetWin32LobApps retrieves a list of Win32 apps.
+func (c *Client) GetWin32LobApps() (*ResponseWin32LobAppsList, error) {
+	endpoint := fmt.Sprintf("%s?$filter=isof('%s')", uriBetaMobileApps, strings.TrimPrefix(ODataTypeWin32LobApp, "#"))
+
+	var apps ResponseWin32LobAppsList
+	for endpoint != "" {
+		var page ResponseWin32LobAppsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "win32 apps", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if apps.ODataContext == "" {
+			apps.ODataContext = page.ODataContext
+		}
+		apps.Value = append(apps.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "win32 apps", err)
+			}
+		}
+	}
+
+	return &apps, nil
+}
+
+// GetWin32LobAppByID retrieves a Win32 app by its ID.
+func (c *Client) GetWin32LobAppByID(id string) (*ResourceWin32LobApp, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	var app ResourceWin32LobApp
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "win32 app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &app, nil
+}
+
+// GetWin32LobAppByDisplayName retrieves a Win32 app by its display name.
+func (c *Client) GetWin32LobAppByDisplayName(displayName string) (*ResourceWin32LobApp, error) {
+	apps, err := c.GetWin32LobApps()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "win32 apps", err)
+	}
+
+	for _, app := range apps.Value {
+		if app.DisplayName == displayName {
+			return c.GetWin32LobAppByID(app.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "win32 app", displayName, "app not found")
+}
+
+// CreateWin32LobApp creates a Win32 app without content. Upload the package with UploadWin32LobAppContent, or
+// use CreateWin32LobAppFromIntuneWinPackage to do both.
+func (c *Client) CreateWin32LobApp(request *ResourceWin32LobApp) (*ResourceWin32LobApp, error) {
+	endpoint := uriBetaMobileApps
+
+	if err := validateWin32LobAppRules(request.Rules); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "win32 app", err)
+	}
+
+	// Set graph metadata values
+	request.ODataType = ODataTypeWin32LobApp
+
+	var createdApp ResourceWin32LobApp
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdApp)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "win32 app", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdApp, nil
+}
+
+// UploadWin32LobAppContent uploads the encrypted content of an opened .intunewin package to a Win32 app as a new
+// content version and makes that version the committed content of the app.
+func (c *Client) UploadWin32LobAppContent(appID string, pkg *IntuneWinPackage, options *MobileAppContentUploadOptions) error {
+	contentVersion, err := c.CreateMobileAppContentVersion(appID, ODataTypeWin32LobApp)
+	if err != nil {
+		return err
+	}
+
+	file, err := c.CreateMobileAppContentFile(appID, ODataTypeWin32LobApp, contentVersion.ID, &ResourceMobileAppContentFile{
+		Name:          pkg.FileName(),
+		Size:          pkg.Detection.UnencryptedContentSize,
+		SizeEncrypted: pkg.EncryptedSize(),
+	})
+	if err != nil {
+		return err
+	}
+
+	content, err := pkg.OpenContent()
+	if err != nil {
+		return fmt.Errorf("failed to read intunewin package content: %v", err)
+	}
+	defer content.Close()
+
+	if _, err := c.UploadMobileAppContentFile(appID, ODataTypeWin32LobApp, contentVersion.ID, file.ID, content, &pkg.Detection.EncryptionInfo, options); err != nil {
+		return err
+	}
+
+	return c.SetWin32LobAppCommittedContentVersion(appID, contentVersion.ID)
+}
+
+// SetWin32LobAppCommittedContentVersion sets the content version devices install for a Win32 app.
+func (c *Client) SetWin32LobAppCommittedContentVersion(appID, contentVersionID string) error {
+	return c.SetMobileAppCommittedContentVersion(appID, ODataTypeWin32LobApp, contentVersionID)
+}
+
+// CreateWin32LobAppFromIntuneWinPackage creates a Win32 app from a .intunewin package and uploads its content.
+//
+// FileName and SetupFilePath are taken from the package, and MsiInformation when the setup file is an MSI and
+// none is set. Unset ReturnCodes, InstallExperience and ApplicableArchitectures get the defaults of the Intune
+// portal. If the upload fails the app is left in place without content and the error names its ID, so the
+// content can be uploaded again with UploadWin32LobAppContent.
+func (c *Client) CreateWin32LobAppFromIntuneWinPackage(request *ResourceWin32LobApp, intuneWinFilePath string, options *MobileAppContentUploadOptions) (*ResourceWin32LobApp, error) {
+	pkg, err := OpenIntuneWinPackage(intuneWinFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer pkg.Close()
+
+	request.FileName = pkg.FileName()
+	if request.SetupFilePath == "" {
+		request.SetupFilePath = pkg.Detection.SetupFile
+	}
+	if request.MsiInformation == nil && pkg.Detection.MsiInfo != nil {
+		request.MsiInformation = pkg.Detection.MsiInfo.Win32LobAppMsiInformation(pkg.Detection.Name)
+	}
+	if len(request.ReturnCodes) == 0 {
+		request.ReturnCodes = DefaultWin32LobAppReturnCodes()
+	}
+	if request.InstallExperience == nil {
+		request.InstallExperience = &Win32LobAppInstallExperience{
+			RunAsAccount:          Win32LobAppRunAsAccountSystem,
+			DeviceRestartBehavior: Win32LobAppRestartBehaviorBasedOnReturnCode,
+			MaxRunTimeInMinutes:   defaultWin32LobAppMaxRunTimeInMinutes,
+		}
+	}
+	if request.ApplicableArchitectures == "" {
+		request.ApplicableArchitectures = defaultWin32LobAppArchitectures
+	}
+
+	createdApp, err := c.CreateWin32LobApp(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.UploadWin32LobAppContent(createdApp.ID, pkg, options); err != nil {
+		return nil, fmt.Errorf("failed to upload content of win32 app %s: %v", createdApp.ID, err)
+	}
+
+	return c.GetWin32LobAppByID(createdApp.ID)
+}
+
+// UpdateWin32LobAppByID updates the properties of a Win32 app by its ID. Content is replaced with
+// UploadWin32LobAppContent.
+func (c *Client) UpdateWin32LobAppByID(id string, request *ResourceWin32LobApp) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	// Set graph metadata values
+	request.ODataType = ODataTypeWin32LobApp
+
+	payload := *request
+	payload.ID = ""
+	payload.CreatedDateTime = nil
+	payload.LastModifiedDateTime = nil
+	payload.PublishingState = ""
+	payload.CommittedContentVersion = ""
+	payload.Size = 0
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "win32 app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWin32LobAppByID deletes a Win32 app by its ID.
+func (c *Client) DeleteWin32LobAppByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaMobileApps, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "win32 app", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
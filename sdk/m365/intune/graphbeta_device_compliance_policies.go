@@ -0,0 +1,648 @@
+// graphbeta_device_compliance_policies.go
+// Graph Beta Api - Intune: Compliance Policies (Windows 10, macOS, iOS, Android Enterprise and AOSP)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/device-compliance-get-started
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesComplianceMenu/~/policies
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfig-devicecompliancepolicy?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// Compliance policies are polymorphic on @odata.type. A single resource struct carries the settings of every
+// supported platform, grouped by platform, and only the fields relevant to the chosen @odata.type should be set.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceCompliancePolicies = "/beta/deviceManagement/deviceCompliancePolicies"
+
+	ODataTypeWindows10CompliancePolicy                 = "#microsoft.graph.windows10CompliancePolicy"
+	ODataTypeMacOSCompliancePolicy                     = "#microsoft.graph.macOSCompliancePolicy"
+	ODataTypeIOSCompliancePolicy                       = "#microsoft.graph.iosCompliancePolicy"
+	ODataTypeAndroidDeviceOwnerCompliancePolicy        = "#microsoft.graph.androidDeviceOwnerCompliancePolicy"
+	ODataTypeAndroidWorkProfileCompliancePolicy        = "#microsoft.graph.androidWorkProfileCompliancePolicy"
+	ODataTypeAospDeviceOwnerCompliancePolicy           = "#microsoft.graph.aospDeviceOwnerCompliancePolicy"
+	odataTypeDeviceComplianceScheduledActionForRule    = "#microsoft.graph.deviceComplianceScheduledActionForRule"
+	odataTypeDeviceComplianceActionItem                = "#microsoft.graph.deviceComplianceActionItem"
+	odataTypeDeviceCompliancePolicyAssignment          = "#microsoft.graph.deviceCompliancePolicyAssignment"
+	odataTypeDeviceCompliancePolicyScript              = "#microsoft.graph.deviceCompliancePolicyScript"
+	deviceComplianceScheduledActionRuleNameDefault     = "PasswordRequired"
+	deviceCompliancePolicyExpandScheduledAndAssignment = "$expand=scheduledActionsForRule($expand=scheduledActionConfigurations),assignments"
+)
+
+// Action types for compliance policy scheduled actions (actions for noncompliance).
+const (
+	DeviceComplianceActionTypeBlock                        = "block"
+	DeviceComplianceActionTypeNotification                 = "notification"
+	DeviceComplianceActionTypePushNotification             = "pushNotification"
+	DeviceComplianceActionTypeRetire                       = "retire"
+	DeviceComplianceActionTypeRemoteLock                   = "remoteLock"
+	DeviceComplianceActionTypeRemoveResourceAccessProfiles = "removeResourceAccessProfiles"
+)
+
+// ResponseDeviceCompliancePoliciesList represents a list of device compliance policies.
+type ResponseDeviceCompliancePoliciesList struct {
+	ODataContext string                           `json:"@odata.context"`
+	ODataCount   int                              `json:"@odata.count"`
+	Value        []ResourceDeviceCompliancePolicy `json:"value"`
+}
+
+// ResourceDeviceCompliancePolicy represents a device compliance policy for any supported platform.
+// It is used as both the request and response structure.
+type ResourceDeviceCompliancePolicy struct {
+	ODataType                     string                                   `json:"@odata.type"`
+	ID                            string                                   `json:"id,omitempty"`
+	DisplayName                   string                                   `json:"displayName"`
+	Description                   string                                   `json:"description,omitempty"`
+	Version                       int                                      `json:"version,omitempty"`
+	CreatedDateTime               *time.Time                               `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime          *time.Time                               `json:"lastModifiedDateTime,omitempty"`
+	RoleScopeTagIds               []string                                 `json:"roleScopeTagIds,omitempty"`
+	ScheduledActionsForRule       []DeviceComplianceScheduledActionForRule `json:"scheduledActionsForRule,omitempty"`
+	Assignments                   []DeviceCompliancePolicyAssignment       `json:"assignments,omitempty"`
+	OsMinimumVersion              string                                   `json:"osMinimumVersion,omitempty"`
+	OsMaximumVersion              string                                   `json:"osMaximumVersion,omitempty"`
+	StorageRequireEncryption      *bool                                    `json:"storageRequireEncryption,omitempty"`
+	DeviceThreatProtectionEnabled *bool                                    `json:"deviceThreatProtectionEnabled,omitempty"`
+	// Values: unavailable, secured, low, medium, high, notSet
+	DeviceThreatProtectionRequiredSecurityLevel   string `json:"deviceThreatProtectionRequiredSecurityLevel,omitempty"`
+	AdvancedThreatProtectionRequiredSecurityLevel string `json:"advancedThreatProtectionRequiredSecurityLevel,omitempty"`
+	// Fields for Windows 10, macOS, Android Enterprise and AOSP - Password
+	PasswordRequired                      *bool  `json:"passwordRequired,omitempty"`
+	PasswordBlockSimple                   *bool  `json:"passwordBlockSimple,omitempty"`
+	PasswordMinimumLength                 *int   `json:"passwordMinimumLength,omitempty"`
+	PasswordMinutesOfInactivityBeforeLock *int   `json:"passwordMinutesOfInactivityBeforeLock,omitempty"`
+	PasswordExpirationDays                *int   `json:"passwordExpirationDays,omitempty"`
+	PasswordPreviousPasswordBlockCount    *int   `json:"passwordPreviousPasswordBlockCount,omitempty"`
+	PasswordMinimumCharacterSetCount      *int   `json:"passwordMinimumCharacterSetCount,omitempty"`
+	PasswordRequiredType                  string `json:"passwordRequiredType,omitempty"`
+	// Fields for Windows 10
+	PasswordRequiredToUnlockFromIdle       *bool  `json:"passwordRequiredToUnlockFromIdle,omitempty"`
+	RequireHealthyDeviceReport             *bool  `json:"requireHealthyDeviceReport,omitempty"`
+	MobileOsMinimumVersion                 string `json:"mobileOsMinimumVersion,omitempty"`
+	MobileOsMaximumVersion                 string `json:"mobileOsMaximumVersion,omitempty"`
+	EarlyLaunchAntiMalwareDriverEnabled    *bool  `json:"earlyLaunchAntiMalwareDriverEnabled,omitempty"`
+	BitLockerEnabled                       *bool  `json:"bitLockerEnabled,omitempty"`
+	SecureBootEnabled                      *bool  `json:"secureBootEnabled,omitempty"`
+	CodeIntegrityEnabled                   *bool  `json:"codeIntegrityEnabled,omitempty"`
+	MemoryIntegrityEnabled                 *bool  `json:"memoryIntegrityEnabled,omitempty"`
+	KernelDmaProtectionEnabled             *bool  `json:"kernelDmaProtectionEnabled,omitempty"`
+	VirtualizationBasedSecurityEnabled     *bool  `json:"virtualizationBasedSecurityEnabled,omitempty"`
+	FirmwareProtectionEnabled              *bool  `json:"firmwareProtectionEnabled,omitempty"`
+	ActiveFirewallRequired                 *bool  `json:"activeFirewallRequired,omitempty"`
+	DefenderEnabled                        *bool  `json:"defenderEnabled,omitempty"`
+	DefenderVersion                        string `json:"defenderVersion,omitempty"`
+	SignatureOutOfDate                     *bool  `json:"signatureOutOfDate,omitempty"`
+	RtpEnabled                             *bool  `json:"rtpEnabled,omitempty"`
+	AntivirusRequired                      *bool  `json:"antivirusRequired,omitempty"`
+	AntiSpywareRequired                    *bool  `json:"antiSpywareRequired,omitempty"`
+	ConfigurationManagerComplianceRequired *bool  `json:"configurationManagerComplianceRequired,omitempty"`
+	TpmRequired                            *bool  `json:"tpmRequired,omitempty"`
+	// A pointer to an empty slice clears the build ranges on update
+	ValidOperatingSystemBuildRanges *[]OperatingSystemVersionRange `json:"validOperatingSystemBuildRanges,omitempty"`
+	DeviceCompliancePolicyScript    *DeviceCompliancePolicyScript  `json:"deviceCompliancePolicyScript,omitempty"`
+	// Fields for macOS
+	OsMinimumBuildVersion            string `json:"osMinimumBuildVersion,omitempty"`
+	OsMaximumBuildVersion            string `json:"osMaximumBuildVersion,omitempty"`
+	SystemIntegrityProtectionEnabled *bool  `json:"systemIntegrityProtectionEnabled,omitempty"`
+	// Values: notConfigured, macAppStore, macAppStoreAndIdentifiedDevelopers, anywhere
+	GatekeeperAllowedAppSource string `json:"gatekeeperAllowedAppSource,omitempty"`
+	FirewallEnabled            *bool  `json:"firewallEnabled,omitempty"`
+	FirewallBlockAllIncoming   *bool  `json:"firewallBlockAllIncoming,omitempty"`
+	FirewallEnableStealthMode  *bool  `json:"firewallEnableStealthMode,omitempty"`
+	// Fields for iOS
+	PasscodeRequired                               *bool                       `json:"passcodeRequired,omitempty"`
+	PasscodeBlockSimple                            *bool                       `json:"passcodeBlockSimple,omitempty"`
+	PasscodeExpirationDays                         *int                        `json:"passcodeExpirationDays,omitempty"`
+	PasscodeMinimumLength                          *int                        `json:"passcodeMinimumLength,omitempty"`
+	PasscodeMinutesOfInactivityBeforeLock          *int                        `json:"passcodeMinutesOfInactivityBeforeLock,omitempty"`
+	PasscodeMinutesOfInactivityBeforeScreenTimeout *int                        `json:"passcodeMinutesOfInactivityBeforeScreenTimeout,omitempty"`
+	PasscodePreviousPasscodeBlockCount             *int                        `json:"passcodePreviousPasscodeBlockCount,omitempty"`
+	PasscodeMinimumCharacterSetCount               *int                        `json:"passcodeMinimumCharacterSetCount,omitempty"`
+	PasscodeRequiredType                           string                      `json:"passcodeRequiredType,omitempty"`
+	ManagedEmailProfileRequired                    *bool                       `json:"managedEmailProfileRequired,omitempty"`
+	RestrictedApps                                 []DeviceCompliancePolicyApp `json:"restrictedApps,omitempty"`
+	// Fields for iOS, Android Work Profile and AOSP
+	SecurityBlockJailbrokenDevices *bool `json:"securityBlockJailbrokenDevices,omitempty"`
+	// Fields for Android Enterprise (fully managed, dedicated and corporate-owned work profile) and AOSP
+	MinAndroidSecurityPatchLevel                       string `json:"minAndroidSecurityPatchLevel,omitempty"`
+	PasswordMinimumLetterCharacters                    *int   `json:"passwordMinimumLetterCharacters,omitempty"`
+	PasswordMinimumLowerCaseCharacters                 *int   `json:"passwordMinimumLowerCaseCharacters,omitempty"`
+	PasswordMinimumNonLetterCharacters                 *int   `json:"passwordMinimumNonLetterCharacters,omitempty"`
+	PasswordMinimumNumericCharacters                   *int   `json:"passwordMinimumNumericCharacters,omitempty"`
+	PasswordMinimumSymbolCharacters                    *int   `json:"passwordMinimumSymbolCharacters,omitempty"`
+	PasswordMinimumUpperCaseCharacters                 *int   `json:"passwordMinimumUpperCaseCharacters,omitempty"`
+	PasswordPreviousPasswordCountToBlock               *int   `json:"passwordPreviousPasswordCountToBlock,omitempty"`
+	SecurityRequireSafetyNetAttestationBasicIntegrity  *bool  `json:"securityRequireSafetyNetAttestationBasicIntegrity,omitempty"`
+	SecurityRequireSafetyNetAttestationCertifiedDevice *bool  `json:"securityRequireSafetyNetAttestationCertifiedDevice,omitempty"`
+	SecurityRequireIntuneAppIntegrity                  *bool  `json:"securityRequireIntuneAppIntegrity,omitempty"`
+	RequireNoPendingSystemUpdates                      *bool  `json:"requireNoPendingSystemUpdates,omitempty"`
+	// Values: basic, hardwareBacked
+	SecurityRequiredAndroidSafetyNetEvaluationType string `json:"securityRequiredAndroidSafetyNetEvaluationType,omitempty"`
+	// Fields for Android Enterprise (personally-owned work profile)
+	RequiredPasswordComplexity                   string `json:"requiredPasswordComplexity,omitempty"`
+	PasswordSignInFailureCountBeforeFactoryReset *int   `json:"passwordSignInFailureCountBeforeFactoryReset,omitempty"`
+	SecurityPreventInstallAppsFromUnknownSources *bool  `json:"securityPreventInstallAppsFromUnknownSources,omitempty"`
+	SecurityDisableUsbDebugging                  *bool  `json:"securityDisableUsbDebugging,omitempty"`
+	SecurityRequireVerifyApps                    *bool  `json:"securityRequireVerifyApps,omitempty"`
+	SecurityRequireGooglePlayServices            *bool  `json:"securityRequireGooglePlayServices,omitempty"`
+	SecurityRequireUpToDateSecurityProviders     *bool  `json:"securityRequireUpToDateSecurityProviders,omitempty"`
+	SecurityRequireCompanyPortalAppIntegrity     *bool  `json:"securityRequireCompanyPortalAppIntegrity,omitempty"`
+	WorkProfileRequirePassword                   *bool  `json:"workProfileRequirePassword,omitempty"`
+	WorkProfilePasswordMinimumLength             *int   `json:"workProfilePasswordMinimumLength,omitempty"`
+	WorkProfileInactiveBeforeScreenLockInMinutes *int   `json:"workProfileInactiveBeforeScreenLockInMinutes,omitempty"`
+	WorkProfilePasswordExpirationInDays          *int   `json:"workProfilePasswordExpirationInDays,omitempty"`
+	WorkProfilePreviousPasswordBlockCount        *int   `json:"workProfilePreviousPasswordBlockCount,omitempty"`
+	WorkProfilePasswordRequiredType              string `json:"workProfilePasswordRequiredType,omitempty"`
+}
+
+// OperatingSystemVersionRange represents a range of valid Windows build numbers.
+type OperatingSystemVersionRange struct {
+	ODataType      string `json:"@odata.type,omitempty"`
+	Description    string `json:"description,omitempty"`
+	LowestVersion  string `json:"lowestVersion"`
+	HighestVersion string `json:"highestVersion"`
+}
+
+// DeviceCompliancePolicyScript represents the custom compliance discovery script and its base64 encoded
+// JSON rules file attached to a Windows 10 compliance policy. See DeviceComplianceScriptRules.EncodeRulesContent.
+type DeviceCompliancePolicyScript struct {
+	ODataType                string `json:"@odata.type,omitempty"`
+	DeviceComplianceScriptId string `json:"deviceComplianceScriptId"`
+	RulesContent             string `json:"rulesContent"`
+}
+
+// DeviceCompliancePolicyApp represents an app in the iOS restricted apps list.
+type DeviceCompliancePolicyApp struct {
+	ODataType   string `json:"@odata.type"`
+	Name        string `json:"name"`
+	Publisher   string `json:"publisher,omitempty"`
+	AppStoreUrl string `json:"appStoreUrl,omitempty"`
+	AppId       string `json:"appId,omitempty"`
+}
+
+// DeviceComplianceScheduledActionForRule represents the actions for noncompliance of a compliance policy.
+type DeviceComplianceScheduledActionForRule struct {
+	ODataType                     string                       `json:"@odata.type,omitempty"`
+	ID                            string                       `json:"id,omitempty"`
+	RuleName                      string                       `json:"ruleName"`
+	ScheduledActionConfigurations []DeviceComplianceActionItem `json:"scheduledActionConfigurations"`
+}
+
+// DeviceComplianceActionItem represents a single action for noncompliance, such as block, notify or retire,
+// and the grace period in hours after which it is taken.
+type DeviceComplianceActionItem struct {
+	ODataType                 string   `json:"@odata.type,omitempty"`
+	ID                        string   `json:"id,omitempty"`
+	GracePeriodHours          int      `json:"gracePeriodHours"`
+	ActionType                string   `json:"actionType"`
+	NotificationTemplateId    string   `json:"notificationTemplateId"`
+	NotificationMessageCCList []string `json:"notificationMessageCCList"`
+}
+
+// DeviceCompliancePolicyAssignment represents an assignment of a device compliance policy.
+type DeviceCompliancePolicyAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Source    string                                 `json:"source,omitempty"`
+	SourceId  string                                 `json:"sourceId,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// ResponseDeviceCompliancePolicyAssignmentsList represents a list of device compliance policy assignments.
+type ResponseDeviceCompliancePolicyAssignmentsList struct {
+	ODataContext string                             `json:"@odata.context"`
+	Value        []DeviceCompliancePolicyAssignment `json:"value"`
+}
+
+// AssignmentDeviceCompliancePolicy represents the request body of the compliance policy assign action.
+type AssignmentDeviceCompliancePolicy struct {
+	Assignments []DeviceCompliancePolicyAssignment `json:"assignments"`
+}
+
+// ScheduleActionsDeviceCompliancePolicy represents the request body of the scheduleActionsForRules action.
+type ScheduleActionsDeviceCompliancePolicy struct {
+	DeviceComplianceScheduledActionForRules []DeviceComplianceScheduledActionForRule `json:"deviceComplianceScheduledActionForRules"`
+}
+
+// ResponseDeviceCompliancePolicyStatusOverview represents the device or user status overview of a compliance policy.
+type ResponseDeviceCompliancePolicyStatusOverview struct {
+	ODataContext               string    `json:"@odata.context"`
+	ID                         string    `json:"id"`
+	PendingCount               int       `json:"pendingCount"`
+	NotApplicableCount         int       `json:"notApplicableCount"`
+	NotApplicablePlatformCount int       `json:"notApplicablePlatformCount"`
+	SuccessCount               int       `json:"successCount"`
+	ErrorCount                 int       `json:"errorCount"`
+	FailedCount                int       `json:"failedCount"`
+	ConflictCount              int       `json:"conflictCount"`
+	LastUpdateDateTime         time.Time `json:"lastUpdateDateTime"`
+	ConfigurationVersion       int       `json:"configurationVersion"`
+}
+
+// ResponseDeviceCompliancePolicyDeviceStatusesList represents the per device statuses of a compliance policy.
+type ResponseDeviceCompliancePolicyDeviceStatusesList struct {
+	ODataContext string                                   `json:"@odata.context"`
+	Value        []DeviceCompliancePolicyDeviceStatusItem `json:"value"`
+}
+
+// DeviceCompliancePolicyDeviceStatusItem represents the compliance status of a single device.
+type DeviceCompliancePolicyDeviceStatusItem struct {
+	ID                                      string    `json:"id"`
+	DeviceDisplayName                       string    `json:"deviceDisplayName"`
+	UserName                                string    `json:"userName"`
+	DeviceModel                             string    `json:"deviceModel"`
+	Platform                                int       `json:"platform"`
+	ComplianceGracePeriodExpirationDateTime time.Time `json:"complianceGracePeriodExpirationDateTime"`
+	Status                                  string    `json:"status"`
+	LastReportedDateTime                    time.Time `json:"lastReportedDateTime"`
+	UserPrincipalName                       string    `json:"userPrincipalName"`
+}
+
+// ResponseDeviceCompliancePolicyUserStatusesList represents the per user statuses of a compliance policy.
+type ResponseDeviceCompliancePolicyUserStatusesList struct {
+	ODataContext string                                 `json:"@odata.context"`
+	Value        []DeviceCompliancePolicyUserStatusItem `json:"value"`
+}
+
+// DeviceCompliancePolicyUserStatusItem represents the compliance status of a single user.
+type DeviceCompliancePolicyUserStatusItem struct {
+	ID                   string    `json:"id"`
+	UserDisplayName      string    `json:"userDisplayName"`
+	DevicesCount         int       `json:"devicesCount"`
+	Status               string    `json:"status"`
+	LastReportedDateTime time.Time `json:"lastReportedDateTime"`
+	UserPrincipalName    string    `json:"userPrincipalName"`
+}
+
+// NewDeviceComplianceActionItem returns an action for noncompliance that is taken after the given number of days.
+// Notification actions additionally require the ID of a notification message template.
+func NewDeviceComplianceActionItem(actionType string, afterDays int, notificationTemplateID string, ccList ...string) DeviceComplianceActionItem {
+	if ccList == nil {
+		ccList = []string{}
+	}
+
+	return DeviceComplianceActionItem{
+		ODataType:                 odataTypeDeviceComplianceActionItem,
+		GracePeriodHours:          afterDays * 24,
+		ActionType:                actionType,
+		NotificationTemplateId:    notificationTemplateID,
+		NotificationMessageCCList: ccList,
+	}
+}
+
+// setDeviceCompliancePolicyScheduledActionDefaults returns a copy of the scheduled actions with the graph
+// metadata set and, as Graph rejects compliance policies without one, adds an immediate block action when none
+// was supplied.
+func setDeviceCompliancePolicyScheduledActionDefaults(actions []DeviceComplianceScheduledActionForRule) []DeviceComplianceScheduledActionForRule {
+	if len(actions) == 0 {
+		actions = []DeviceComplianceScheduledActionForRule{{
+			ScheduledActionConfigurations: []DeviceComplianceActionItem{
+				NewDeviceComplianceActionItem(DeviceComplianceActionTypeBlock, 0, ""),
+			},
+		}}
+	}
+
+	// The actions are copied so that the caller's slices are not modified
+	withDefaults := make([]DeviceComplianceScheduledActionForRule, len(actions))
+	for i, action := range actions {
+		action.ODataType = odataTypeDeviceComplianceScheduledActionForRule
+		if action.RuleName == "" {
+			action.RuleName = deviceComplianceScheduledActionRuleNameDefault
+		}
+		configurations := make([]DeviceComplianceActionItem, len(action.ScheduledActionConfigurations))
+		for j, configuration := range action.ScheduledActionConfigurations {
+			configuration.ODataType = odataTypeDeviceComplianceActionItem
+			if configuration.NotificationMessageCCList == nil {
+				configuration.NotificationMessageCCList = []string{}
+			}
+			configurations[j] = configuration
+		}
+		action.ScheduledActionConfigurations = configurations
+		withDefaults[i] = action
+	}
+
+	return withDefaults
+}
+
+// validateDeviceCompliancePolicyODataType checks that the policy targets one of the supported platforms.
+func validateDeviceCompliancePolicyODataType(odataType string) error {
+	switch odataType {
+	case ODataTypeWindows10CompliancePolicy,
+		ODataTypeMacOSCompliancePolicy,
+		ODataTypeIOSCompliancePolicy,
+		ODataTypeAndroidDeviceOwnerCompliancePolicy,
+		ODataTypeAndroidWorkProfileCompliancePolicy,
+		ODataTypeAospDeviceOwnerCompliancePolicy:
+		return nil
+	}
+
+	return fmt.Errorf("unsupported device compliance policy @odata.type: %q", odataType)
+}
+
+// GetDeviceCompliancePolicies retrieves a list of all device compliance policies with their
+// actions for noncompliance and assignments.
+func (c *Client) GetDeviceCompliancePolicies() (*ResponseDeviceCompliancePoliciesList, error) {
+	endpoint := uriBetaDeviceCompliancePolicies + "?" + deviceCompliancePolicyExpandScheduledAndAssignment
+
+	var responseDeviceCompliancePolicies ResponseDeviceCompliancePoliciesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseDeviceCompliancePolicies)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device compliance policies", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &responseDeviceCompliancePolicies, nil
+}
+
+// GetDeviceCompliancePolicyByID retrieves a device compliance policy by its ID with its actions for
+// noncompliance and assignments.
+func (c *Client) GetDeviceCompliancePolicyByID(policyID string) (*ResourceDeviceCompliancePolicy, error) {
+	endpoint := fmt.Sprintf("%s/%s?%s", uriBetaDeviceCompliancePolicies, policyID, deviceCompliancePolicyExpandScheduledAndAssignment)
+
+	var responseDeviceCompliancePolicy ResourceDeviceCompliancePolicy
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseDeviceCompliancePolicy)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &responseDeviceCompliancePolicy, nil
+}
+
+// GetDeviceCompliancePolicyByDisplayName retrieves a device compliance policy by its display name.
+func (c *Client) GetDeviceCompliancePolicyByDisplayName(displayName string) (*ResourceDeviceCompliancePolicy, error) {
+	policies, err := c.GetDeviceCompliancePolicies()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device compliance policies", err)
+	}
+
+	var policyID string
+	for _, policy := range policies.Value {
+		if policy.DisplayName == displayName {
+			policyID = policy.ID
+			break
+		}
+	}
+
+	if policyID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "device compliance policy", displayName, "policy not found")
+	}
+
+	return c.GetDeviceCompliancePolicyByID(policyID)
+}
+
+// CreateDeviceCompliancePolicy creates a new device compliance policy. The request's ODataType selects the
+// platform. If no actions for noncompliance are supplied, an immediate block action is added, as Graph
+// requires at least one.
+func (c *Client) CreateDeviceCompliancePolicy(request *ResourceDeviceCompliancePolicy) (*ResourceDeviceCompliancePolicy, error) {
+	if err := validateDeviceCompliancePolicyODataType(request.ODataType); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device compliance policy", err)
+	}
+
+	// Set graph metadata values on a copy, leaving the caller's request unchanged
+	payload := *request
+	payload.ScheduledActionsForRule = setDeviceCompliancePolicyScheduledActionDefaults(request.ScheduledActionsForRule)
+	if request.DeviceCompliancePolicyScript != nil {
+		script := *request.DeviceCompliancePolicyScript
+		script.ODataType = odataTypeDeviceCompliancePolicyScript
+		payload.DeviceCompliancePolicyScript = &script
+	}
+
+	endpoint := uriBetaDeviceCompliancePolicies
+
+	var createdPolicy ResourceDeviceCompliancePolicy
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &payload, &createdPolicy)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device compliance policy", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdPolicy, nil
+}
+
+// CreateDeviceCompliancePolicyWithAssignment creates a new device compliance policy and assigns it.
+func (c *Client) CreateDeviceCompliancePolicyWithAssignment(request *ResourceDeviceCompliancePolicy, assignment *AssignmentDeviceCompliancePolicy) (*ResourceDeviceCompliancePolicy, error) {
+	createdPolicy, err := c.CreateDeviceCompliancePolicy(request)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.CreateDeviceCompliancePolicyAssignment(createdPolicy.ID, assignment)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedAssign, "device compliance policy", createdPolicy.ID, err)
+	}
+
+	return createdPolicy, nil
+}
+
+// UpdateDeviceCompliancePolicyByID updates a device compliance policy by its ID using the PATCH method.
+// Actions for noncompliance and assignments are navigation properties and cannot be patched; use
+// UpdateDeviceCompliancePolicyScheduledActionsByID and CreateDeviceCompliancePolicyAssignment instead.
+func (c *Client) UpdateDeviceCompliancePolicyByID(policyID string, request *ResourceDeviceCompliancePolicy) (*ResourceDeviceCompliancePolicy, error) {
+	if err := validateDeviceCompliancePolicyODataType(request.ODataType); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device compliance policy", policyID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceCompliancePolicies, policyID)
+
+	// Exclude navigation properties from the request object
+	patch := *request
+	patch.ScheduledActionsForRule = nil
+	patch.Assignments = nil
+	if request.DeviceCompliancePolicyScript != nil {
+		script := *request.DeviceCompliancePolicyScript
+		script.ODataType = odataTypeDeviceCompliancePolicyScript
+		patch.DeviceCompliancePolicyScript = &script
+	}
+
+	var updatedPolicy ResourceDeviceCompliancePolicy
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, &updatedPolicy)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device compliance policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedPolicy, nil
+}
+
+// UpdateDeviceCompliancePolicyByDisplayName updates a device compliance policy by its display name.
+func (c *Client) UpdateDeviceCompliancePolicyByDisplayName(displayName string, request *ResourceDeviceCompliancePolicy) (*ResourceDeviceCompliancePolicy, error) {
+	policy, err := c.GetDeviceCompliancePolicyByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "device compliance policy", displayName, err)
+	}
+
+	return c.UpdateDeviceCompliancePolicyByID(policy.ID, request)
+}
+
+// UpdateDeviceCompliancePolicyScheduledActionsByID replaces the actions for noncompliance (block, notify,
+// retire after N days etc.) of a device compliance policy.
+func (c *Client) UpdateDeviceCompliancePolicyScheduledActionsByID(policyID string, actions []DeviceComplianceScheduledActionForRule) ([]DeviceComplianceScheduledActionForRule, error) {
+	endpoint := fmt.Sprintf("%s/%s/scheduleActionsForRules", uriBetaDeviceCompliancePolicies, policyID)
+
+	request := ScheduleActionsDeviceCompliancePolicy{
+		DeviceComplianceScheduledActionForRules: setDeviceCompliancePolicyScheduledActionDefaults(actions),
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, nil)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device compliance policy scheduled actions", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return request.DeviceComplianceScheduledActionForRules, nil
+}
+
+// DeleteDeviceCompliancePolicyByID deletes a device compliance policy by its ID.
+func (c *Client) DeleteDeviceCompliancePolicyByID(policyID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceCompliancePolicies, policyID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device compliance policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceCompliancePolicyByDisplayName deletes a device compliance policy by its display name.
+func (c *Client) DeleteDeviceCompliancePolicyByDisplayName(displayName string) error {
+	policy, err := c.GetDeviceCompliancePolicyByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "device compliance policy", displayName, err)
+	}
+
+	return c.DeleteDeviceCompliancePolicyByID(policy.ID)
+}
+
+// GetDeviceCompliancePolicyAssignments retrieves the assignments of a device compliance policy.
+func (c *Client) GetDeviceCompliancePolicyAssignments(policyID string) (*ResponseDeviceCompliancePolicyAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaDeviceCompliancePolicies, policyID)
+
+	var assignments ResponseDeviceCompliancePolicyAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device compliance policy assignments", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// CreateDeviceCompliancePolicyAssignment assigns a device compliance policy using the assign action.
+// The supplied assignments replace any existing assignments of the policy.
+func (c *Client) CreateDeviceCompliancePolicyAssignment(policyID string, assignment *AssignmentDeviceCompliancePolicy) (*ResponseDeviceCompliancePolicyAssignmentsList, error) {
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = odataTypeDeviceCompliancePolicyAssignment
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaDeviceCompliancePolicies, policyID)
+
+	var createdAssignments ResponseDeviceCompliancePolicyAssignmentsList
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, &createdAssignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedAssign, "device compliance policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdAssignments, nil
+}
+
+// GetDeviceCompliancePolicyDeviceStatusOverview retrieves the device status overview of a device compliance policy.
+func (c *Client) GetDeviceCompliancePolicyDeviceStatusOverview(policyID string) (*ResponseDeviceCompliancePolicyStatusOverview, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatusOverview", uriBetaDeviceCompliancePolicies, policyID)
+
+	var overview ResponseDeviceCompliancePolicyStatusOverview
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &overview)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance policy device status overview", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &overview, nil
+}
+
+// GetDeviceCompliancePolicyUserStatusOverview retrieves the user status overview of a device compliance policy.
+func (c *Client) GetDeviceCompliancePolicyUserStatusOverview(policyID string) (*ResponseDeviceCompliancePolicyStatusOverview, error) {
+	endpoint := fmt.Sprintf("%s/%s/userStatusOverview", uriBetaDeviceCompliancePolicies, policyID)
+
+	var overview ResponseDeviceCompliancePolicyStatusOverview
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &overview)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance policy user status overview", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &overview, nil
+}
+
+// GetDeviceCompliancePolicyDeviceStatuses retrieves the per device compliance statuses of a device compliance policy.
+func (c *Client) GetDeviceCompliancePolicyDeviceStatuses(policyID string) (*ResponseDeviceCompliancePolicyDeviceStatusesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatuses", uriBetaDeviceCompliancePolicies, policyID)
+
+	var statuses ResponseDeviceCompliancePolicyDeviceStatusesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &statuses)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance policy device statuses", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &statuses, nil
+}
+
+// GetDeviceCompliancePolicyUserStatuses retrieves the per user compliance statuses of a device compliance policy.
+func (c *Client) GetDeviceCompliancePolicyUserStatuses(policyID string) (*ResponseDeviceCompliancePolicyUserStatusesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/userStatuses", uriBetaDeviceCompliancePolicies, policyID)
+
+	var statuses ResponseDeviceCompliancePolicyUserStatusesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &statuses)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance policy user statuses", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &statuses, nil
+}
@@ -0,0 +1,548 @@
+// graphbeta_device_compliance_script_rules.go
+// Graph Beta Api - Intune: Compliance (custom compliance JSON rules files)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/compliance-custom-json
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesMenu/~/compliance
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfig-devicecompliancepolicyscript?view=graph-rest-beta
+// A custom compliance setting pairs a discovery script (deviceComplianceScripts) with a JSON rules file.
+// The rules file is attached to a compliance policy and each rule evaluates one key of the hashtable
+// returned by the discovery script, so the two must agree on setting names.
+
+package intune
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+// Operators supported by custom compliance rules.
+const (
+	ComplianceRuleOperatorIsEquals      = "IsEquals"
+	ComplianceRuleOperatorNotEquals     = "NotEquals"
+	ComplianceRuleOperatorGreaterThan   = "GreaterThan"
+	ComplianceRuleOperatorGreaterEquals = "GreaterEquals"
+	ComplianceRuleOperatorLessThan      = "LessThan"
+	ComplianceRuleOperatorLessEquals    = "LessEquals"
+)
+
+// Data types supported by custom compliance rules.
+const (
+	ComplianceRuleDataTypeBoolean  = "Boolean"
+	ComplianceRuleDataTypeInt64    = "Int64"
+	ComplianceRuleDataTypeDouble   = "Double"
+	ComplianceRuleDataTypeString   = "String"
+	ComplianceRuleDataTypeDateTime = "DateTime"
+	ComplianceRuleDataTypeVersion  = "Version"
+)
+
+// complianceRuleOperatorsByDataType lists the operators Intune accepts for each data type.
+// Data type names are matched case-insensitively, as Intune does when ingesting the rules file.
+var complianceRuleOperatorsByDataType = map[string][]string{
+	strings.ToLower(ComplianceRuleDataTypeBoolean):  {ComplianceRuleOperatorIsEquals, ComplianceRuleOperatorNotEquals},
+	strings.ToLower(ComplianceRuleDataTypeString):   {ComplianceRuleOperatorIsEquals, ComplianceRuleOperatorNotEquals},
+	strings.ToLower(ComplianceRuleDataTypeInt64):    allComplianceRuleOperators,
+	strings.ToLower(ComplianceRuleDataTypeDouble):   allComplianceRuleOperators,
+	strings.ToLower(ComplianceRuleDataTypeDateTime): allComplianceRuleOperators,
+	strings.ToLower(ComplianceRuleDataTypeVersion):  allComplianceRuleOperators,
+}
+
+var allComplianceRuleOperators = []string{
+	ComplianceRuleOperatorIsEquals,
+	ComplianceRuleOperatorNotEquals,
+	ComplianceRuleOperatorGreaterThan,
+	ComplianceRuleOperatorGreaterEquals,
+	ComplianceRuleOperatorLessThan,
+	ComplianceRuleOperatorLessEquals,
+}
+
+// DeviceComplianceScriptRules represents the contents of a custom compliance JSON rules file.
+type DeviceComplianceScriptRules struct {
+	Rules []DeviceComplianceScriptRule `json:"Rules"`
+}
+
+// DeviceComplianceScriptRule represents a single rule evaluated against the discovery script output.
+type DeviceComplianceScriptRule struct {
+	SettingName        string                                    `json:"SettingName"`
+	Operator           string                                    `json:"Operator"`
+	DataType           string                                    `json:"DataType"`
+	Operand            interface{}                               `json:"Operand"`
+	MoreInfoUrl        string                                    `json:"MoreInfoUrl"`
+	RemediationStrings []DeviceComplianceScriptRemediationString `json:"RemediationStrings"`
+}
+
+// DeviceComplianceScriptRemediationString represents the localised message shown to the end user
+// in Company Portal when a rule is not met.
+type DeviceComplianceScriptRemediationString struct {
+	Language    string `json:"Language"`
+	Title       string `json:"Title"`
+	Description string `json:"Description"`
+}
+
+// ParseDeviceComplianceScriptRules decodes and validates a custom compliance JSON rules file.
+// Numeric operands are preserved as json.Number so that Int64 values are not rounded through float64.
+func ParseDeviceComplianceScriptRules(data []byte) (*DeviceComplianceScriptRules, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var rules DeviceComplianceScriptRules
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("failed to parse compliance rules: %v", err)
+	}
+
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// ParseDeviceComplianceScriptRulesFile reads a custom compliance JSON rules file from disk,
+// then decodes and validates it.
+func ParseDeviceComplianceScriptRulesFile(filePath string) (*DeviceComplianceScriptRules, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compliance rules file: %v", err)
+	}
+
+	return ParseDeviceComplianceScriptRules(data)
+}
+
+// Validate checks every rule in the rules file against the custom compliance schema and returns
+// all problems found joined into a single error, or nil when the rules file is valid.
+func (r *DeviceComplianceScriptRules) Validate() error {
+	if len(r.Rules) == 0 {
+		return errors.New("compliance rules file must contain at least one rule")
+	}
+
+	var errs []error
+	seen := make(map[string]bool)
+	for i, rule := range r.Rules {
+		if err := rule.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("rule %d (%s): %w", i, rule.SettingName, err))
+		}
+		if rule.SettingName != "" {
+			if seen[rule.SettingName] {
+				errs = append(errs, fmt.Errorf("rule %d (%s): duplicate setting name", i, rule.SettingName))
+			}
+			seen[rule.SettingName] = true
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks a single rule for required fields, a supported data type and operator combination,
+// an operand that matches the data type and at least one complete remediation string.
+func (rule *DeviceComplianceScriptRule) Validate() error {
+	var errs []error
+
+	if rule.SettingName == "" {
+		errs = append(errs, errors.New("SettingName is required"))
+	}
+
+	operators, ok := complianceRuleOperatorsByDataType[strings.ToLower(rule.DataType)]
+	if !ok {
+		errs = append(errs, fmt.Errorf("unsupported DataType %q", rule.DataType))
+	} else {
+		if !containsString(operators, rule.Operator) {
+			errs = append(errs, fmt.Errorf("operator %q is not supported for DataType %q", rule.Operator, rule.DataType))
+		}
+		if err := validateComplianceRuleOperand(rule.DataType, rule.Operand); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if rule.MoreInfoUrl == "" {
+		errs = append(errs, errors.New("MoreInfoUrl is required"))
+	}
+
+	if len(rule.RemediationStrings) == 0 {
+		errs = append(errs, errors.New("at least one RemediationStrings entry is required"))
+	}
+	for i, remediation := range rule.RemediationStrings {
+		if remediation.Language == "" || remediation.Title == "" || remediation.Description == "" {
+			errs = append(errs, fmt.Errorf("RemediationStrings[%d] requires Language, Title and Description", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateComplianceRuleOperand checks that the operand can be interpreted as the rule's data type.
+func validateComplianceRuleOperand(dataType string, operand interface{}) error {
+	if operand == nil {
+		return errors.New("Operand is required")
+	}
+
+	switch strings.ToLower(dataType) {
+	case strings.ToLower(ComplianceRuleDataTypeBoolean):
+		if _, ok := operand.(bool); !ok {
+			return fmt.Errorf("operand %v is not a Boolean", operand)
+		}
+	case strings.ToLower(ComplianceRuleDataTypeInt64):
+		if _, err := strconv.ParseInt(operandString(operand), 10, 64); err != nil {
+			return fmt.Errorf("operand %v is not an Int64", operand)
+		}
+	case strings.ToLower(ComplianceRuleDataTypeDouble):
+		if _, err := strconv.ParseFloat(operandString(operand), 64); err != nil {
+			return fmt.Errorf("operand %v is not a Double", operand)
+		}
+	case strings.ToLower(ComplianceRuleDataTypeString):
+		if _, ok := operand.(string); !ok {
+			return fmt.Errorf("operand %v is not a String", operand)
+		}
+	case strings.ToLower(ComplianceRuleDataTypeDateTime):
+		if _, err := time.Parse(time.RFC3339, operandString(operand)); err != nil {
+			return fmt.Errorf("operand %v is not an ISO 8601 DateTime", operand)
+		}
+	case strings.ToLower(ComplianceRuleDataTypeVersion):
+		if !complianceRuleVersionPattern.MatchString(operandString(operand)) {
+			return fmt.Errorf("operand %v is not a Version", operand)
+		}
+	}
+
+	return nil
+}
+
+var complianceRuleVersionPattern = regexp.MustCompile(`^\d+(\.\d+){1,3}$`)
+
+// operandString renders an operand decoded from JSON, or set in Go, as a string for parsing.
+func operandString(operand interface{}) string {
+	switch v := operand.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// EncodeRulesContent returns the rules file as the base64 encoded JSON expected by the
+// rulesContent property of a compliance policy's deviceCompliancePolicyScript.
+func (r *DeviceComplianceScriptRules) EncodeRulesContent() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf(shared.ErrorMsgFailedJsonMarshal, "compliance rules", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// ExtractDeviceComplianceScriptOutputSettingNames returns the setting names a PowerShell discovery script
+// emits, i.e. the keys of the hashtable it converts to JSON with ConvertTo-Json. The hashtable may be piped
+// (@{ ... } | ConvertTo-Json, $hash | ConvertTo-Json) or passed as an argument (ConvertTo-Json -InputObject $hash).
+// For a variable, the keys of the literals assigned to it and the keys added afterwards ($hash.Add('Name', value),
+// $hash['Name'] = value, $hash.Name = value) are included. Other hashtables, such as splatted parameters, are
+// ignored. Names are returned sorted and de-duplicated.
+func ExtractDeviceComplianceScriptOutputSettingNames(scriptContent string) []string {
+	script := stripPowerShellComments(scriptContent)
+	names := make(map[string]bool)
+
+	for _, output := range findPowerShellJSONOutputs(script) {
+		if variable, ok := strings.CutPrefix(output, "$"); ok {
+			for _, name := range powerShellHashtableVariableKeys(script, variable) {
+				names[name] = true
+			}
+			continue
+		}
+		for _, name := range powerShellHashtableKeys(output) {
+			names[name] = true
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+var (
+	psIdentifierPattern          = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	psVariablePattern            = regexp.MustCompile(`^\$(\w+)`)
+	psTypeCastPattern            = regexp.MustCompile(`^\[[\w.]+\]\s*`)
+	psConvertToJSONPattern       = regexp.MustCompile(`(?i)\bConvertTo-Json\b`)
+	psInputObjectPattern         = regexp.MustCompile(`(?i)^[^|;\n]*?-InputObject[\s:]+`)
+	psHashtableAssignmentPattern = regexp.MustCompile(`\$(\w+)\s*=\s*(?:\[[\w.]+\]\s*)?@\{`)
+	psHashtableAddPattern        = regexp.MustCompile(`\$(\w+)\.Add\(\s*["'](\w+)["']\s*,`)
+	psHashtableIndexPattern      = regexp.MustCompile(`\$(\w+)\[\s*["'](\w+)["']\s*\]\s*=[^=]`)
+	psHashtableMemberPattern     = regexp.MustCompile(`\$(\w+)\.(\w+)\s*=[^=]`)
+)
+
+// findPowerShellJSONOutputs returns the input of every ConvertTo-Json call in the script, either as a
+// variable ("$name") or as the body of a hashtable literal.
+func findPowerShellJSONOutputs(script string) []string {
+	var outputs []string
+	for _, loc := range psConvertToJSONPattern.FindAllStringIndex(script, -1) {
+		// ConvertTo-Json -InputObject $hash, or ConvertTo-Json $hash
+		rest := script[loc[1]:]
+		if match := psInputObjectPattern.FindStringIndex(rest); match != nil {
+			rest = rest[match[1]:]
+		}
+		if output, ok := powerShellOperand(rest); ok {
+			outputs = append(outputs, output)
+			continue
+		}
+
+		// $hash | ConvertTo-Json, or @{ ... } | ConvertTo-Json
+		before := strings.TrimRight(script[:loc[0]], " \t")
+		if !strings.HasSuffix(before, "|") {
+			continue
+		}
+		before = strings.TrimRight(strings.TrimSuffix(before, "|"), " \t")
+		if strings.HasSuffix(before, "}") {
+			if start := powerShellHashtableStart(before, len(before)-1); start >= 0 {
+				outputs = append(outputs, before[start+2:len(before)-1])
+			}
+			continue
+		}
+		if i := strings.LastIndexByte(before, '$'); i >= 0 && psIdentifierPattern.MatchString(before[i+1:]) {
+			outputs = append(outputs, before[i:])
+		}
+	}
+
+	return outputs
+}
+
+// powerShellOperand parses the argument at the start of text as a variable ("$name") or a hashtable literal,
+// optionally cast (e.g. [pscustomobject]@{ ... }) or parenthesised, returning the literal's body.
+func powerShellOperand(text string) (string, bool) {
+	text = strings.TrimLeft(text, " \t")
+	text = strings.TrimLeft(strings.TrimPrefix(text, "("), " \t")
+	if match := psVariablePattern.FindStringSubmatch(text); match != nil {
+		return "$" + match[1], true
+	}
+	text = psTypeCastPattern.ReplaceAllString(text, "")
+	if strings.HasPrefix(text, "@{") {
+		if body, ok := powerShellHashtableBody(text, 0); ok {
+			return body, true
+		}
+	}
+
+	return "", false
+}
+
+// powerShellHashtableVariableKeys returns the keys of the hashtable literals assigned to a variable and of
+// the entries added to it afterwards.
+func powerShellHashtableVariableKeys(script, variable string) []string {
+	var keys []string
+	for _, match := range psHashtableAssignmentPattern.FindAllStringSubmatchIndex(script, -1) {
+		if !strings.EqualFold(script[match[2]:match[3]], variable) {
+			continue
+		}
+		if body, ok := powerShellHashtableBody(script, match[1]-2); ok {
+			keys = append(keys, powerShellHashtableKeys(body)...)
+		}
+	}
+
+	for _, pattern := range []*regexp.Regexp{psHashtableAddPattern, psHashtableIndexPattern, psHashtableMemberPattern} {
+		for _, match := range pattern.FindAllStringSubmatch(script, -1) {
+			if strings.EqualFold(match[1], variable) {
+				keys = append(keys, match[2])
+			}
+		}
+	}
+
+	return keys
+}
+
+// powerShellHashtableKeys returns the keys of a hashtable literal body. Keys of nested hashtables are not
+// included.
+func powerShellHashtableKeys(body string) []string {
+	var keys []string
+	for _, entry := range splitPowerShellHashtableEntries(body) {
+		key, _, found := strings.Cut(entry, "=")
+		if !found {
+			continue
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"'`)
+		if psIdentifierPattern.MatchString(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// powerShellHashtableBody returns the contents between the braces of the hashtable literal whose @{ is at
+// start.
+func powerShellHashtableBody(script string, start int) (string, bool) {
+	depth := 0
+	for j := start + 1; j < len(script); j++ {
+		switch script[j] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return script[start+2 : j], true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// powerShellHashtableStart returns the index of the @ opening the hashtable literal whose closing brace is at
+// end, or -1 if the braces do not belong to a hashtable literal.
+func powerShellHashtableStart(script string, end int) int {
+	depth := 0
+	for j := end; j >= 0; j-- {
+		switch script[j] {
+		case '}':
+			depth++
+		case '{':
+			depth--
+			if depth == 0 {
+				if j > 0 && script[j-1] == '@' {
+					return j - 1
+				}
+				return -1
+			}
+		}
+	}
+
+	return -1
+}
+
+// stripPowerShellComments removes block (<# #>) and line (#) comments from a PowerShell script. Quoted
+// strings and here-strings are kept as they are, including any # within them.
+func stripPowerShellComments(script string) string {
+	var b strings.Builder
+	for i := 0; i < len(script); {
+		switch {
+		case strings.HasPrefix(script[i:], "@'") || strings.HasPrefix(script[i:], `@"`):
+			// A here-string ends with its quote followed by @ at the start of a line
+			terminator := "\n" + script[i+1:i+2] + "@"
+			end := strings.Index(script[i+2:], terminator)
+			if end < 0 {
+				b.WriteString(script[i:])
+				return b.String()
+			}
+			end = i + 2 + end + len(terminator)
+			b.WriteString(script[i:end])
+			i = end
+		case script[i] == '\'' || script[i] == '"':
+			end := powerShellStringEnd(script, i)
+			b.WriteString(script[i:end])
+			i = end
+		case strings.HasPrefix(script[i:], "<#"):
+			end := strings.Index(script[i+2:], "#>")
+			if end < 0 {
+				return b.String()
+			}
+			i += 2 + end + 2
+		case script[i] == '#':
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				return b.String()
+			}
+			i += end
+		default:
+			b.WriteByte(script[i])
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+// powerShellStringEnd returns the index after the closing quote of the string starting at start. Quotes are
+// escaped by doubling them, and in double quoted strings also by a backtick.
+func powerShellStringEnd(script string, start int) int {
+	quote := script[start]
+	for i := start + 1; i < len(script); i++ {
+		switch {
+		case quote == '"' && script[i] == '`':
+			i++
+		case script[i] == quote:
+			if i+1 < len(script) && script[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+
+	return len(script)
+}
+
+// splitPowerShellHashtableEntries splits a hashtable body on semicolons and new lines that are
+// not nested within braces, brackets or parentheses.
+func splitPowerShellHashtableEntries(body string) []string {
+	var entries []string
+	depth := 0
+	start := 0
+	for i, ch := range body {
+		switch ch {
+		case '{', '(', '[':
+			depth++
+		case '}', ')', ']':
+			depth--
+		case ';', '\n':
+			if depth == 0 {
+				entries = append(entries, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(entries, body[start:])
+}
+
+// ValidateDeviceComplianceScriptRulesAgainstScript checks that every rule's SettingName is emitted by
+// the discovery script's output hashtable. The script content may be plain text or base64 encoded as
+// returned by the deviceComplianceScripts API.
+func ValidateDeviceComplianceScriptRulesAgainstScript(rules *DeviceComplianceScriptRules, scriptContent string) error {
+	if decoded, err := base64.StdEncoding.DecodeString(scriptContent); err == nil {
+		scriptContent = string(decoded)
+	}
+
+	emitted := make(map[string]bool)
+	for _, name := range ExtractDeviceComplianceScriptOutputSettingNames(scriptContent) {
+		emitted[name] = true
+	}
+
+	var errs []error
+	for _, rule := range rules.Rules {
+		if !emitted[rule.SettingName] {
+			errs = append(errs, fmt.Errorf("setting %q is not emitted by the discovery script output", rule.SettingName))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateDeviceComplianceScriptRulesByScriptID retrieves a device compliance script by its ID and checks
+// that every rule's SettingName is emitted by the script's detection output.
+func (c *Client) ValidateDeviceComplianceScriptRulesByScriptID(scriptID string, rules *DeviceComplianceScriptRules) error {
+	script, err := c.GetDeviceComplianceScriptByID(scriptID)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedGetByID, "device compliance script", scriptID, err)
+	}
+
+	return ValidateDeviceComplianceScriptRulesAgainstScript(rules, script.DetectionScriptContent)
+}
+
+// containsString reports whether value is present in values.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,365 @@
+// graphbeta_device_custom_attribute_shell_scripts.go
+// Graph Beta Api - Intune: macOS Custom Attribute (shell) Scripts
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/macos-shell-scripts#custom-attributes-for-macos
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesMacOsMenu/~/customAttributes
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-devices-devicecustomattributeshellscript?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceCustomAttributeShellScripts   = "/beta/deviceManagement/deviceCustomAttributeShellScripts"
+	odataTypeDeviceCustomAttributeShellScript  = "#microsoft.graph.deviceCustomAttributeShellScript"
+	odataTypeCreateDeviceCustomAttributeAssign = "#microsoft.graph.deviceManagementScriptAssignment"
+	odataTypeCreateDeviceCustomAttributeGroup  = "#microsoft.graph.deviceManagementScriptGroupAssignment"
+)
+
+// CustomAttributeType is the data type of the value reported by a custom attribute shell script.
+type CustomAttributeType string
+
+// Data types supported for macOS custom attributes.
+const (
+	CustomAttributeTypeInteger  CustomAttributeType = "integer"
+	CustomAttributeTypeString   CustomAttributeType = "string"
+	CustomAttributeTypeDateTime CustomAttributeType = "dateTime"
+)
+
+// customAttributeDateTimeLayouts lists the ISO 8601 layouts accepted by Intune for dateTime custom attributes.
+var customAttributeDateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ResponseDeviceCustomAttributeShellScriptsList represents a list of Device Custom Attribute Shell Scripts.
+type ResponseDeviceCustomAttributeShellScriptsList struct {
+	ODataContext string                                     `json:"@odata.context"`
+	Value        []ResponseDeviceCustomAttributeShellScript `json:"value"`
+}
+
+// ResponseDeviceCustomAttributeShellScript represents a Device Custom Attribute Shell Script.
+type ResponseDeviceCustomAttributeShellScript struct {
+	ODataContext         string                                `json:"@odata.context,omitempty"`
+	ID                   string                                `json:"id"`
+	CustomAttributeName  string                                `json:"customAttributeName"`
+	CustomAttributeType  CustomAttributeType                   `json:"customAttributeType"`
+	DisplayName          string                                `json:"displayName"`
+	Description          string                                `json:"description"`
+	ScriptContent        string                                `json:"scriptContent"`
+	CreatedDateTime      time.Time                             `json:"createdDateTime"`
+	LastModifiedDateTime time.Time                             `json:"lastModifiedDateTime"`
+	RunAsAccount         string                                `json:"runAsAccount"`
+	FileName             string                                `json:"fileName"`
+	RoleScopeTagIds      []string                              `json:"roleScopeTagIds"`
+	Assignments          []ResponseDeviceShellScriptAssignment `json:"assignments,omitempty"`
+}
+
+// ResourceDeviceCustomAttributeShellScript represents the request payload for creating and updating a
+// Device Custom Attribute Shell Script. ScriptContent must be base64 encoded.
+type ResourceDeviceCustomAttributeShellScript struct {
+	ODataType           string              `json:"@odata.type,omitempty"`
+	CustomAttributeName string              `json:"customAttributeName,omitempty"`
+	CustomAttributeType CustomAttributeType `json:"customAttributeType,omitempty"`
+	DisplayName         string              `json:"displayName,omitempty"`
+	Description         string              `json:"description,omitempty"`
+	ScriptContent       string              `json:"scriptContent,omitempty"`
+	RunAsAccount        string              `json:"runAsAccount,omitempty"`
+	FileName            string              `json:"fileName,omitempty"`
+	RoleScopeTagIds     []string            `json:"roleScopeTagIds,omitempty"`
+}
+
+// DeviceCustomAttributeValue represents the value of a custom attribute collected from a single device.
+// Only the field matching Type is populated; RawValue always holds the script output as reported.
+type DeviceCustomAttributeValue struct {
+	ManagedDeviceID         string
+	DeviceName              string
+	UserPrincipalName       string
+	Type                    CustomAttributeType
+	RunState                ScriptRunState
+	LastStateUpdateDateTime time.Time
+	RawValue                string
+	StringValue             string
+	IntegerValue            int64
+	DateTimeValue           time.Time
+	ParseError              error
+}
+
+// ParseDeviceCustomAttributeValue converts the raw output of a custom attribute script into the given type.
+func ParseDeviceCustomAttributeValue(attributeType CustomAttributeType, rawValue string) (*DeviceCustomAttributeValue, error) {
+	value := &DeviceCustomAttributeValue{
+		Type:     attributeType,
+		RawValue: rawValue,
+	}
+	trimmed := strings.TrimSpace(rawValue)
+
+	switch attributeType {
+	case CustomAttributeTypeString:
+		value.StringValue = trimmed
+	case CustomAttributeTypeInteger:
+		integer, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil {
+			return value, fmt.Errorf("custom attribute value %q is not a valid integer", trimmed)
+		}
+		value.IntegerValue = integer
+	case CustomAttributeTypeDateTime:
+		for _, layout := range customAttributeDateTimeLayouts {
+			if dateTime, err := time.Parse(layout, trimmed); err == nil {
+				value.DateTimeValue = dateTime
+				return value, nil
+			}
+		}
+		return value, fmt.Errorf("custom attribute value %q is not a valid ISO 8601 date", trimmed)
+	default:
+		return value, fmt.Errorf("unsupported custom attribute type %q", attributeType)
+	}
+
+	return value, nil
+}
+
+// GetDeviceCustomAttributeShellScripts gets a list of all Intune Device Custom Attribute Shell Scripts
+// with expanded information on assignments.
+func (c *Client) GetDeviceCustomAttributeShellScripts() (*ResponseDeviceCustomAttributeShellScriptsList, error) {
+	endpoint := uriBetaDeviceCustomAttributeShellScripts + "?$expand=assignments"
+
+	var scripts ResponseDeviceCustomAttributeShellScriptsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &scripts)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device custom attribute shell scripts", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &scripts, nil
+}
+
+// GetDeviceCustomAttributeShellScriptByID retrieves a Device Custom Attribute Shell Script by its ID.
+func (c *Client) GetDeviceCustomAttributeShellScriptByID(id string) (*ResponseDeviceCustomAttributeShellScript, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=assignments", uriBetaDeviceCustomAttributeShellScripts, id)
+
+	var script ResponseDeviceCustomAttributeShellScript
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &script)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device custom attribute shell script", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &script, nil
+}
+
+// GetDeviceCustomAttributeShellScriptByDisplayName retrieves a Device Custom Attribute Shell Script by its display name.
+func (c *Client) GetDeviceCustomAttributeShellScriptByDisplayName(displayName string) (*ResponseDeviceCustomAttributeShellScript, error) {
+	scripts, err := c.GetDeviceCustomAttributeShellScripts()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device custom attribute shell scripts", err)
+	}
+
+	var scriptID string
+	for _, script := range scripts.Value {
+		if script.DisplayName == displayName {
+			scriptID = script.ID
+			break
+		}
+	}
+
+	if scriptID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "device custom attribute shell script", displayName, "script not found")
+	}
+
+	return c.GetDeviceCustomAttributeShellScriptByID(scriptID)
+}
+
+// CreateDeviceCustomAttributeShellScript creates a new Device Custom Attribute Shell Script.
+func (c *Client) CreateDeviceCustomAttributeShellScript(request *ResourceDeviceCustomAttributeShellScript) (*ResponseDeviceCustomAttributeShellScript, error) {
+	request.ODataType = odataTypeDeviceCustomAttributeShellScript
+	endpoint := uriBetaDeviceCustomAttributeShellScripts
+
+	var createdScript ResponseDeviceCustomAttributeShellScript
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdScript)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device custom attribute shell script", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdScript, nil
+}
+
+// CreateDeviceCustomAttributeShellScriptAssignment assigns a Device Custom Attribute Shell Script.
+// Custom attribute scripts use the same assignment payload as device shell scripts.
+func (c *Client) CreateDeviceCustomAttributeShellScriptAssignment(scriptID string, assignment *AssignmentDeviceManagementScript) error {
+	// Set graph metadata values
+	for i := range assignment.ResourceDeviceManagementScriptAssignments {
+		assignment.ResourceDeviceManagementScriptAssignments[i].OdataType = odataTypeCreateDeviceCustomAttributeAssign
+	}
+
+	for i := range assignment.ResourceDeviceManagementScriptGroupAssignments {
+		assignment.ResourceDeviceManagementScriptGroupAssignments[i].OdataType = odataTypeCreateDeviceCustomAttributeGroup
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaDeviceCustomAttributeShellScripts, scriptID)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "device custom attribute shell script", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// CreateDeviceCustomAttributeShellScriptWithAssignment creates a new Device Custom Attribute Shell Script and assigns it.
+func (c *Client) CreateDeviceCustomAttributeShellScriptWithAssignment(request *ResourceDeviceCustomAttributeShellScript, assignment *AssignmentDeviceManagementScript) (*ResponseDeviceCustomAttributeShellScript, error) {
+	createdScript, err := c.CreateDeviceCustomAttributeShellScript(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateDeviceCustomAttributeShellScriptAssignment(createdScript.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdScript, nil
+}
+
+// GetDeviceCustomAttributeShellScriptAssignments retrieves the assignments of a Device Custom Attribute Shell Script.
+func (c *Client) GetDeviceCustomAttributeShellScriptAssignments(scriptID string) (*AssignmentDeviceManagementScript, error) {
+	return c.GetDeviceManagementScriptAssignmentByID(uriBetaDeviceCustomAttributeShellScripts, scriptID)
+}
+
+// UpdateDeviceCustomAttributeShellScriptByID updates a Device Custom Attribute Shell Script by its ID using the PATCH method.
+// The attribute name and type cannot be changed once the script has been created.
+func (c *Client) UpdateDeviceCustomAttributeShellScriptByID(scriptID string, request *ResourceDeviceCustomAttributeShellScript) (*ResponseDeviceCustomAttributeShellScript, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceCustomAttributeShellScripts, scriptID)
+
+	request.ODataType = odataTypeDeviceCustomAttributeShellScript
+
+	var updatedScript ResponseDeviceCustomAttributeShellScript
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, request, &updatedScript)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device custom attribute shell script", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedScript, nil
+}
+
+// UpdateDeviceCustomAttributeShellScriptByDisplayName updates a Device Custom Attribute Shell Script by its display name.
+func (c *Client) UpdateDeviceCustomAttributeShellScriptByDisplayName(displayName string, request *ResourceDeviceCustomAttributeShellScript) (*ResponseDeviceCustomAttributeShellScript, error) {
+	script, err := c.GetDeviceCustomAttributeShellScriptByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "device custom attribute shell script", displayName, err)
+	}
+
+	return c.UpdateDeviceCustomAttributeShellScriptByID(script.ID, request)
+}
+
+// DeleteDeviceCustomAttributeShellScriptByID deletes a Device Custom Attribute Shell Script by its ID.
+func (c *Client) DeleteDeviceCustomAttributeShellScriptByID(scriptID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceCustomAttributeShellScripts, scriptID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device custom attribute shell script", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceCustomAttributeShellScriptByDisplayName deletes a Device Custom Attribute Shell Script by its display name.
+func (c *Client) DeleteDeviceCustomAttributeShellScriptByDisplayName(displayName string) error {
+	script, err := c.GetDeviceCustomAttributeShellScriptByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedGetByName, "device custom attribute shell script", displayName, err)
+	}
+
+	return c.DeleteDeviceCustomAttributeShellScriptByID(script.ID)
+}
+
+// NewDeviceCustomAttributeShellScriptDeviceRunStateIterator returns an iterator over the device run states
+// of a custom attribute shell script. The resultMessage of each run state holds the collected attribute value.
+func (c *Client) NewDeviceCustomAttributeShellScriptDeviceRunStateIterator(scriptID string) *DeviceManagementScriptDeviceRunStateIterator {
+	return &DeviceManagementScriptDeviceRunStateIterator{
+		client:   c,
+		endpoint: fmt.Sprintf("%s/%s/deviceRunStates?%s", uriBetaDeviceCustomAttributeShellScripts, scriptID, deviceHealthScriptDeviceRunStatesExpandDevice),
+	}
+}
+
+// GetDeviceCustomAttributeShellScriptDeviceRunStates retrieves every device run state of a custom attribute shell script.
+func (c *Client) GetDeviceCustomAttributeShellScriptDeviceRunStates(scriptID string) (*ResponseDeviceManagementScriptDeviceRunStatesList, error) {
+	return collectDeviceManagementScriptDeviceRunStates(c.NewDeviceCustomAttributeShellScriptDeviceRunStateIterator(scriptID))
+}
+
+// GetDeviceCustomAttributeShellScriptUserRunStates retrieves the per user run states of a custom attribute shell script.
+func (c *Client) GetDeviceCustomAttributeShellScriptUserRunStates(scriptID string) (*ResponseDeviceManagementScriptUserRunStatesList, error) {
+	return c.getScriptUserRunStates(uriBetaDeviceCustomAttributeShellScripts, scriptID)
+}
+
+// GetDeviceCustomAttributeShellScriptRunSummary retrieves the run summary of a custom attribute shell script.
+func (c *Client) GetDeviceCustomAttributeShellScriptRunSummary(scriptID string) (*ResponseDeviceManagementScriptRunSummary, error) {
+	return c.getScriptRunSummary(uriBetaDeviceCustomAttributeShellScripts, scriptID)
+}
+
+// GetDeviceCustomAttributeValues retrieves the attribute value collected from each device by a custom attribute
+// shell script, converted to the attribute's declared type. Devices whose script has not run successfully are
+// skipped. A value that cannot be converted is still returned with ParseError set, so a single misbehaving
+// device does not hide the rest of the fleet.
+func (c *Client) GetDeviceCustomAttributeValues(scriptID string) ([]DeviceCustomAttributeValue, error) {
+	script, err := c.GetDeviceCustomAttributeShellScriptByID(scriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []DeviceCustomAttributeValue
+	iterator := c.NewDeviceCustomAttributeShellScriptDeviceRunStateIterator(scriptID)
+	for iterator.Next() {
+		state := iterator.RunState()
+		if state.RunState != ScriptRunStateSuccess {
+			continue
+		}
+
+		value, err := ParseDeviceCustomAttributeValue(script.CustomAttributeType, state.ResultMessage)
+		value.ParseError = err
+		value.RunState = state.RunState
+		value.LastStateUpdateDateTime = state.LastStateUpdateDateTime
+		if state.ManagedDevice != nil {
+			value.ManagedDeviceID = state.ManagedDevice.ID
+			value.DeviceName = state.ManagedDevice.DeviceName
+			value.UserPrincipalName = state.ManagedDevice.UserPrincipalName
+		}
+
+		values = append(values, *value)
+	}
+
+	if err := iterator.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
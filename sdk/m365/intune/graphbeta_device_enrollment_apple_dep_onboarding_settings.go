@@ -0,0 +1,179 @@
+// graphbeta_device_enrollment_apple_dep_onboarding_settings.go
+// Graph Beta Api - Intune: Apple Automated Device Enrollment (ADE/DEP) tokens
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/enrollment/device-enrollment-program-enroll-ios
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/EnrollmentProgramTokensBlade
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-deponboardingsetting?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDepOnboardingSettings = "/beta/deviceManagement/depOnboardingSettings"
+
+	DepTokenTypeNone               = "none"
+	DepTokenTypeDep                = "dep"
+	DepTokenTypeAppleSchoolManager = "appleSchoolManager"
+)
+
+// ResponseDepOnboardingSettingsList represents a list of Apple enrollment program tokens.
+type ResponseDepOnboardingSettingsList struct {
+	ODataContext string                         `json:"@odata.context"`
+	Value        []ResourceDepOnboardingSetting `json:"value"`
+}
+
+// ResourceDepOnboardingSetting represents an Apple enrollment program (ADE/DEP) token and its sync state.
+type ResourceDepOnboardingSetting struct {
+	ID                                  string    `json:"id"`
+	AppleIdentifier                     string    `json:"appleIdentifier"`
+	TokenName                           string    `json:"tokenName"`
+	TokenType                           string    `json:"tokenType"`
+	TokenExpirationDateTime             time.Time `json:"tokenExpirationDateTime"`
+	LastModifiedDateTime                time.Time `json:"lastModifiedDateTime"`
+	LastSuccessfulSyncDateTime          time.Time `json:"lastSuccessfulSyncDateTime"`
+	LastSyncTriggeredDateTime           time.Time `json:"lastSyncTriggeredDateTime"`
+	LastSyncErrorCode                   int       `json:"lastSyncErrorCode"`
+	SyncedDeviceCount                   int       `json:"syncedDeviceCount"`
+	ShareTokenWithSchoolDataSyncService bool      `json:"shareTokenWithSchoolDataSyncService"`
+	DataSharingConsentGranted           bool      `json:"dataSharingConsentGranted"`
+	RoleScopeTagIds                     []string  `json:"roleScopeTagIds"`
+}
+
+// TokenExpiresWithin reports whether the token has expired or will expire within the given duration.
+func (s *ResourceDepOnboardingSetting) TokenExpiresWithin(d time.Duration) bool {
+	return time.Until(s.TokenExpirationDateTime) <= d
+}
+
+// UploadDepToken represents the request body used to upload or renew an Apple enrollment program token.
+type UploadDepToken struct {
+	AppleID  string `json:"appleId"`
+	DepToken string `json:"depToken"`
+}
+
+// GetDepOnboardingSettings retrieves a list of Apple enrollment program tokens.
+func (c *Client) GetDepOnboardingSettings() (*ResponseDepOnboardingSettingsList, error) {
+	endpoint := uriBetaDepOnboardingSettings
+
+	var settings ResponseDepOnboardingSettingsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "dep onboarding settings", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &settings, nil
+}
+
+// GetDepOnboardingSettingByID retrieves an Apple enrollment program token by its ID.
+func (c *Client) GetDepOnboardingSettingByID(id string) (*ResourceDepOnboardingSetting, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDepOnboardingSettings, id)
+
+	var setting ResourceDepOnboardingSetting
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &setting)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "dep onboarding setting", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &setting, nil
+}
+
+// GetDepOnboardingSettingByTokenName retrieves an Apple enrollment program token by its token name.
+func (c *Client) GetDepOnboardingSettingByTokenName(tokenName string) (*ResourceDepOnboardingSetting, error) {
+	settings, err := c.GetDepOnboardingSettings()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "dep onboarding settings", err)
+	}
+
+	for _, setting := range settings.Value {
+		if setting.TokenName == tokenName {
+			return &setting, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "dep onboarding setting", tokenName, "token not found")
+}
+
+// GetDepOnboardingSettingsExpiringWithin retrieves the Apple enrollment program tokens that have expired or will
+// expire within the given duration, so they can be renewed before device syncs start failing.
+func (c *Client) GetDepOnboardingSettingsExpiringWithin(d time.Duration) ([]ResourceDepOnboardingSetting, error) {
+	settings, err := c.GetDepOnboardingSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var expiring []ResourceDepOnboardingSetting
+	for _, setting := range settings.Value {
+		if setting.TokenExpiresWithin(d) {
+			expiring = append(expiring, setting)
+		}
+	}
+
+	return expiring, nil
+}
+
+// GetDepOnboardingSettingEncryptionPublicKey retrieves the public key to upload to Apple Business Manager or Apple
+// School Manager when creating or renewing an enrollment program token.
+func (c *Client) GetDepOnboardingSettingEncryptionPublicKey(id string) (string, error) {
+	endpoint := fmt.Sprintf("%s/%s/getEncryptionPublicKey", uriBetaDepOnboardingSettings, id)
+
+	var publicKey struct {
+		Value string `json:"value"`
+	}
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &publicKey)
+	if err != nil {
+		return "", fmt.Errorf(shared.ErrorMsgFailedGetByID, "dep onboarding setting encryption public key", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return publicKey.Value, nil
+}
+
+// UploadDepOnboardingSettingToken uploads a renewed token downloaded from Apple for an existing enrollment program token.
+func (c *Client) UploadDepOnboardingSettingToken(id string, request *UploadDepToken) error {
+	endpoint := fmt.Sprintf("%s/%s/uploadDepToken", uriBetaDepOnboardingSettings, id)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "dep onboarding setting token", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// SyncDepOnboardingSettingByID triggers a sync of devices from Apple for an enrollment program token. Apple limits
+// syncs to one every fifteen minutes per token; the last triggered sync is reported in LastSyncTriggeredDateTime.
+func (c *Client) SyncDepOnboardingSettingByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s/syncWithAppleDeviceEnrollmentProgram", uriBetaDepOnboardingSettings, id)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "dep onboarding setting sync", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
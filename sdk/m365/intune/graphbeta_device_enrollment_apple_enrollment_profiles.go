@@ -0,0 +1,304 @@
+// graphbeta_device_enrollment_apple_enrollment_profiles.go
+// Graph Beta Api - Intune: Apple Automated Device Enrollment profiles
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/enrollment/device-enrollment-program-enroll-ios#create-an-apple-enrollment-profile
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/EnrollmentProgramTokensBlade
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-depiosenrollmentprofile?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-depmacosenrollmentprofile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	ITunesPairingModeDisallow            = "disallow"
+	ITunesPairingModeAllow               = "allow"
+	ITunesPairingModeRequiresCertificate = "requiresCertificate"
+)
+
+// ResponseAppleEnrollmentProfilesList represents a list of Apple enrollment profiles for an enrollment program token.
+type ResponseAppleEnrollmentProfilesList struct {
+	ODataContext string                           `json:"@odata.context"`
+	Value        []ResourceAppleEnrollmentProfile `json:"value"`
+}
+
+// ResourceAppleEnrollmentProfile represents an Apple Automated Device Enrollment profile. ODataType selects between
+// an iOS/iPadOS profile and a macOS profile; the *Disabled fields hide the matching setup assistant screens.
+type ResourceAppleEnrollmentProfile struct {
+	ODataType                                           string   `json:"@odata.type,omitempty"`
+	ID                                                  string   `json:"id,omitempty"`
+	DisplayName                                         string   `json:"displayName"`
+	Description                                         string   `json:"description,omitempty"`
+	IsDefault                                           *bool    `json:"isDefault,omitempty"`
+	RequiresUserAuthentication                          *bool    `json:"requiresUserAuthentication,omitempty"`
+	ConfigurationEndpointUrl                            string   `json:"configurationEndpointUrl,omitempty"`
+	EnableAuthenticationViaCompanyPortal                *bool    `json:"enableAuthenticationViaCompanyPortal,omitempty"`
+	RequireCompanyPortalOnSetupAssistantEnrolledDevices *bool    `json:"requireCompanyPortalOnSetupAssistantEnrolledDevices,omitempty"`
+	SupervisedModeEnabled                               *bool    `json:"supervisedModeEnabled,omitempty"`
+	SupportDepartment                                   string   `json:"supportDepartment,omitempty"`
+	SupportPhoneNumber                                  string   `json:"supportPhoneNumber,omitempty"`
+	IsMandatory                                         *bool    `json:"isMandatory,omitempty"`
+	ProfileRemovalDisabled                              *bool    `json:"profileRemovalDisabled,omitempty"`
+	DeviceNameTemplate                                  string   `json:"deviceNameTemplate,omitempty"`
+	ConfigurationWebUrl                                 *bool    `json:"configurationWebUrl,omitempty"`
+	EnrollmentTimeAzureAdGroupIds                       []string `json:"enrollmentTimeAzureAdGroupIds,omitempty"`
+	// Setup assistant screens
+	LocationDisabled           *bool `json:"locationDisabled,omitempty"`
+	RestoreBlocked             *bool `json:"restoreBlocked,omitempty"`
+	AppleIdDisabled            *bool `json:"appleIdDisabled,omitempty"`
+	TermsAndConditionsDisabled *bool `json:"termsAndConditionsDisabled,omitempty"`
+	TouchIdDisabled            *bool `json:"touchIdDisabled,omitempty"`
+	ApplePayDisabled           *bool `json:"applePayDisabled,omitempty"`
+	SiriDisabled               *bool `json:"siriDisabled,omitempty"`
+	DiagnosticsDisabled        *bool `json:"diagnosticsDisabled,omitempty"`
+	DisplayToneSetupDisabled   *bool `json:"displayToneSetupDisabled,omitempty"`
+	PrivacyPaneDisabled        *bool `json:"privacyPaneDisabled,omitempty"`
+	ScreenTimeScreenDisabled   *bool `json:"screenTimeScreenDisabled,omitempty"`
+	PassCodeDisabled           *bool `json:"passCodeDisabled,omitempty"`
+	ZoomDisabled               *bool `json:"zoomDisabled,omitempty"`
+	// Fields for depIOSEnrollmentProfile
+	ITunesPairingMode                 string                       `json:"iTunesPairingMode,omitempty"`
+	ManagementCertificates            []AppleManagementCertificate `json:"managementCertificates,omitempty"`
+	RestoreFromAndroidDisabled        *bool                        `json:"restoreFromAndroidDisabled,omitempty"`
+	AwaitDeviceConfiguredConfirmation *bool                        `json:"awaitDeviceConfiguredConfirmation,omitempty"`
+	SharedIPadMaximumUserCount        *int                         `json:"sharedIPadMaximumUserCount,omitempty"`
+	EnableSharedIPad                  *bool                        `json:"enableSharedIPad,omitempty"`
+	CompanyPortalVppTokenId           string                       `json:"companyPortalVppTokenId,omitempty"`
+	EnableSingleAppEnrollmentMode     *bool                        `json:"enableSingleAppEnrollmentMode,omitempty"`
+	HomeButtonScreenDisabled          *bool                        `json:"homeButtonScreenDisabled,omitempty"`
+	IMessageAndFaceTimeScreenDisabled *bool                        `json:"iMessageAndFaceTimeScreenDisabled,omitempty"`
+	OnBoardingScreenDisabled          *bool                        `json:"onBoardingScreenDisabled,omitempty"`
+	SimSetupScreenDisabled            *bool                        `json:"simSetupScreenDisabled,omitempty"`
+	SoftwareUpdateScreenDisabled      *bool                        `json:"softwareUpdateScreenDisabled,omitempty"`
+	WatchMigrationScreenDisabled      *bool                        `json:"watchMigrationScreenDisabled,omitempty"`
+	AppearanceScreenDisabled          *bool                        `json:"appearanceScreenDisabled,omitempty"`
+	ExpressLanguageScreenDisabled     *bool                        `json:"expressLanguageScreenDisabled,omitempty"`
+	PreferredLanguageScreenDisabled   *bool                        `json:"preferredLanguageScreenDisabled,omitempty"`
+	DeviceToDeviceMigrationDisabled   *bool                        `json:"deviceToDeviceMigrationDisabled,omitempty"`
+	WelcomeScreenDisabled             *bool                        `json:"welcomeScreenDisabled,omitempty"`
+	RestoreCompletedScreenDisabled    *bool                        `json:"restoreCompletedScreenDisabled,omitempty"`
+	UpdateCompleteScreenDisabled      *bool                        `json:"updateCompleteScreenDisabled,omitempty"`
+	ForceTemporarySession             *bool                        `json:"forceTemporarySession,omitempty"`
+	TemporarySessionTimeoutInSeconds  *int                         `json:"temporarySessionTimeoutInSeconds,omitempty"`
+	UserSessionTimeoutInSeconds       *int                         `json:"userSessionTimeoutInSeconds,omitempty"`
+	PasscodeLockGracePeriodInSeconds  *int                         `json:"passcodeLockGracePeriodInSeconds,omitempty"`
+	CarrierActivationUrl              string                       `json:"carrierActivationUrl,omitempty"`
+	UserlessSharedAadModeEnabled      *bool                        `json:"userlessSharedAadModeEnabled,omitempty"`
+	// Fields for depMacOSEnrollmentProfile
+	RegistrationDisabled                *bool  `json:"registrationDisabled,omitempty"`
+	FileVaultDisabled                   *bool  `json:"fileVaultDisabled,omitempty"`
+	ICloudDiagnosticsDisabled           *bool  `json:"iCloudDiagnosticsDisabled,omitempty"`
+	ICloudStorageDisabled               *bool  `json:"iCloudStorageDisabled,omitempty"`
+	ChooseYourLockScreenDisabled        *bool  `json:"chooseYourLockScreenDisabled,omitempty"`
+	AccessibilityScreenDisabled         *bool  `json:"accessibilityScreenDisabled,omitempty"`
+	AutoUnlockWithWatchDisabled         *bool  `json:"autoUnlockWithWatchDisabled,omitempty"`
+	SkipPrimarySetupAccountCreation     *bool  `json:"skipPrimarySetupAccountCreation,omitempty"`
+	SetPrimarySetupAccountAsRegularUser *bool  `json:"setPrimarySetupAccountAsRegularUser,omitempty"`
+	DontAutoPopulatePrimaryAccountInfo  *bool  `json:"dontAutoPopulatePrimaryAccountInfo,omitempty"`
+	PrimaryAccountFullName              string `json:"primaryAccountFullName,omitempty"`
+	PrimaryAccountUserName              string `json:"primaryAccountUserName,omitempty"`
+	EnableRestrictEditing               *bool  `json:"enableRestrictEditing,omitempty"`
+	AdminAccountUserName                string `json:"adminAccountUserName,omitempty"`
+	AdminAccountFullName                string `json:"adminAccountFullName,omitempty"`
+	AdminAccountPassword                string `json:"adminAccountPassword,omitempty"`
+	HideAdminAccount                    *bool  `json:"hideAdminAccount,omitempty"`
+	RequestRequiresNetworkTether        *bool  `json:"requestRequiresNetworkTether,omitempty"`
+	AutoAdvanceSetupEnabled             *bool  `json:"autoAdvanceSetupEnabled,omitempty"`
+}
+
+// AppleManagementCertificate represents a certificate trusted for iTunes pairing when ITunesPairingMode requires one.
+type AppleManagementCertificate struct {
+	Thumbprint  string `json:"thumbprint"`
+	Certificate string `json:"certificate"`
+}
+
+// GetAppleEnrollmentProfiles retrieves the enrollment profiles of an Apple enrollment program token.
+func (c *Client) GetAppleEnrollmentProfiles(depOnboardingSettingID string) (*ResponseAppleEnrollmentProfilesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles", uriBetaDepOnboardingSettings, depOnboardingSettingID)
+
+	var profiles ResponseAppleEnrollmentProfilesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "apple enrollment profiles", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profiles, nil
+}
+
+// GetAppleEnrollmentProfileByID retrieves an Apple enrollment profile by its ID.
+func (c *Client) GetAppleEnrollmentProfileByID(depOnboardingSettingID, id string) (*ResourceAppleEnrollmentProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	var profile ResourceAppleEnrollmentProfile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "apple enrollment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profile, nil
+}
+
+// GetAppleEnrollmentProfileByDisplayName retrieves an Apple enrollment profile by its display name.
+func (c *Client) GetAppleEnrollmentProfileByDisplayName(depOnboardingSettingID, displayName string) (*ResourceAppleEnrollmentProfile, error) {
+	profiles, err := c.GetAppleEnrollmentProfiles(depOnboardingSettingID)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "apple enrollment profiles", err)
+	}
+
+	for _, profile := range profiles.Value {
+		if profile.DisplayName == displayName {
+			return &profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "apple enrollment profile", displayName, "profile not found")
+}
+
+// CreateAppleEnrollmentProfile creates an enrollment profile for an Apple enrollment program token. An iOS/iPadOS
+// profile is created when no ODataType is set.
+func (c *Client) CreateAppleEnrollmentProfile(depOnboardingSettingID string, request *ResourceAppleEnrollmentProfile) (*ResourceAppleEnrollmentProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles", uriBetaDepOnboardingSettings, depOnboardingSettingID)
+
+	if request.ODataType == "" {
+		request.ODataType = ODataTypeDepIOSEnrollmentProfile
+	}
+
+	var createdProfile ResourceAppleEnrollmentProfile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "apple enrollment profile", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdProfile, nil
+}
+
+// UpdateAppleEnrollmentProfileByID updates an Apple enrollment profile by its ID.
+func (c *Client) UpdateAppleEnrollmentProfileByID(depOnboardingSettingID, id string, request *ResourceAppleEnrollmentProfile) error {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	// isDefault is read only and is changed through setDefaultProfile
+	payload := *request
+	payload.ID = ""
+	payload.IsDefault = nil
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "apple enrollment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateAppleEnrollmentProfileByDisplayName updates an Apple enrollment profile by its display name.
+func (c *Client) UpdateAppleEnrollmentProfileByDisplayName(depOnboardingSettingID, displayName string, request *ResourceAppleEnrollmentProfile) error {
+	profile, err := c.GetAppleEnrollmentProfileByDisplayName(depOnboardingSettingID, displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "apple enrollment profile", displayName, err)
+	}
+
+	return c.UpdateAppleEnrollmentProfileByID(depOnboardingSettingID, profile.ID, request)
+}
+
+// SetDefaultAppleEnrollmentProfileByID makes an enrollment profile the default for devices synced from Apple that
+// have no profile assigned.
+func (c *Client) SetDefaultAppleEnrollmentProfileByID(depOnboardingSettingID, id string) error {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s/setDefaultProfile", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "default apple enrollment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// AssignAppleEnrollmentProfileToDevices assigns an enrollment profile to devices synced from Apple, identified by
+// their serial numbers.
+func (c *Client) AssignAppleEnrollmentProfileToDevices(depOnboardingSettingID, id string, serialNumbers []string) error {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s/updateDeviceProfileAssignment", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	requestBody := map[string][]string{
+		"deviceIds": serialNumbers,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "apple enrollment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// ExportAppleEnrollmentProfileMobileConfig exports an enrollment profile as a .mobileconfig for Apple Configurator.
+func (c *Client) ExportAppleEnrollmentProfileMobileConfig(depOnboardingSettingID, id string) (string, error) {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s/exportMobileConfig", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	var mobileConfig struct {
+		Value string `json:"value"`
+	}
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &mobileConfig)
+	if err != nil {
+		return "", fmt.Errorf(shared.ErrorMsgFailedGetByID, "apple enrollment profile mobile config", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return mobileConfig.Value, nil
+}
+
+// DeleteAppleEnrollmentProfileByID deletes an Apple enrollment profile by its ID.
+func (c *Client) DeleteAppleEnrollmentProfileByID(depOnboardingSettingID, id string) error {
+	endpoint := fmt.Sprintf("%s/%s/enrollmentProfiles/%s", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "apple enrollment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteAppleEnrollmentProfileByDisplayName deletes an Apple enrollment profile by its display name.
+func (c *Client) DeleteAppleEnrollmentProfileByDisplayName(depOnboardingSettingID, displayName string) error {
+	profile, err := c.GetAppleEnrollmentProfileByDisplayName(depOnboardingSettingID, displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "apple enrollment profile", displayName, err)
+	}
+
+	return c.DeleteAppleEnrollmentProfileByID(depOnboardingSettingID, profile.ID)
+}
@@ -0,0 +1,155 @@
+// graphbeta_device_enrollment_apple_imported_device_identities.go
+// Graph Beta Api - Intune: Apple imported device identities (serial numbers)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/enrollment/apple-configurator-enroll-ios
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/EnrollmentProgramTokensBlade
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-importedappledeviceidentity?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	AppleDeviceDiscoverySourceUnknown                 = "unknown"
+	AppleDeviceDiscoverySourceAdminImport             = "adminImport"
+	AppleDeviceDiscoverySourceDeviceEnrollmentProgram = "deviceEnrollmentProgram"
+
+	AppleDevicePlatformIOS   = "ios"
+	AppleDevicePlatformMacOS = "macOS"
+)
+
+// ResponseImportedAppleDeviceIdentitiesList represents a list of Apple devices known to an enrollment program token.
+type ResponseImportedAppleDeviceIdentitiesList struct {
+	ODataContext  string                                `json:"@odata.context"`
+	ODataNextLink string                                `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceImportedAppleDeviceIdentity `json:"value"`
+}
+
+// ResourceImportedAppleDeviceIdentity represents an Apple device synced from Apple or imported by serial number.
+type ResourceImportedAppleDeviceIdentity struct {
+	ID                                           string     `json:"id,omitempty"`
+	SerialNumber                                 string     `json:"serialNumber"`
+	Description                                  string     `json:"description,omitempty"`
+	Platform                                     string     `json:"platform,omitempty"`
+	RequestedEnrollmentProfileId                 string     `json:"requestedEnrollmentProfileId,omitempty"`
+	RequestedEnrollmentProfileAssignmentDateTime *time.Time `json:"requestedEnrollmentProfileAssignmentDateTime,omitempty"`
+	IsSupervised                                 bool       `json:"isSupervised,omitempty"`
+	DiscoverySource                              string     `json:"discoverySource,omitempty"`
+	IsDeleted                                    bool       `json:"isDeleted,omitempty"`
+	CreatedDateTime                              *time.Time `json:"createdDateTime,omitempty"`
+	LastContactedDateTime                        *time.Time `json:"lastContactedDateTime,omitempty"`
+	EnrollmentState                              string     `json:"enrollmentState,omitempty"`
+	UserPrincipalName                            string     `json:"userPrincipalName,omitempty"`
+}
+
+// ResponseImportedAppleDeviceIdentityResultsList represents the outcome of an Apple device identity import.
+type ResponseImportedAppleDeviceIdentityResultsList struct {
+	ODataContext string                              `json:"@odata.context"`
+	Value        []ImportedAppleDeviceIdentityResult `json:"value"`
+}
+
+// ImportedAppleDeviceIdentityResult represents the outcome of importing a single Apple device identity.
+type ImportedAppleDeviceIdentityResult struct {
+	ResourceImportedAppleDeviceIdentity
+	Status bool `json:"status"`
+}
+
+// importAppleDeviceIdentityList represents the request body of the importAppleDeviceIdentityList action.
+type importAppleDeviceIdentityList struct {
+	ImportedAppleDeviceIdentities     []ResourceImportedAppleDeviceIdentity `json:"importedAppleDeviceIdentities"`
+	OverwriteImportedDeviceIdentities bool                                  `json:"overwriteImportedDeviceIdentities"`
+}
+
+// GetImportedAppleDeviceIdentities retrieves every Apple device known to an enrollment program token, following pagination.
+func (c *Client) GetImportedAppleDeviceIdentities(depOnboardingSettingID string) (*ResponseImportedAppleDeviceIdentitiesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/importedAppleDeviceIdentities", uriBetaDepOnboardingSettings, depOnboardingSettingID)
+
+	var devices ResponseImportedAppleDeviceIdentitiesList
+	for endpoint != "" {
+		var page ResponseImportedAppleDeviceIdentitiesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "imported apple device identities", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if devices.ODataContext == "" {
+			devices.ODataContext = page.ODataContext
+		}
+		devices.Value = append(devices.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "imported apple device identities", err)
+			}
+		}
+	}
+
+	return &devices, nil
+}
+
+// GetImportedAppleDeviceIdentityBySerialNumber retrieves an Apple device known to an enrollment program token by its serial number.
+func (c *Client) GetImportedAppleDeviceIdentityBySerialNumber(depOnboardingSettingID, serialNumber string) (*ResourceImportedAppleDeviceIdentity, error) {
+	devices, err := c.GetImportedAppleDeviceIdentities(depOnboardingSettingID)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "imported apple device identity", serialNumber, err)
+	}
+
+	for _, device := range devices.Value {
+		if device.SerialNumber == serialNumber {
+			return &device, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "imported apple device identity", serialNumber, "device not found")
+}
+
+// ImportAppleDeviceIdentities imports Apple devices by serial number so they can be assigned an enrollment profile.
+// When overwrite is true, existing records for the same serial numbers are replaced. The returned list reports the
+// outcome for each device; devices that failed to import have Status set to false.
+func (c *Client) ImportAppleDeviceIdentities(depOnboardingSettingID string, devices []ResourceImportedAppleDeviceIdentity, overwrite bool) (*ResponseImportedAppleDeviceIdentityResultsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/importedAppleDeviceIdentities/importAppleDeviceIdentityList", uriBetaDepOnboardingSettings, depOnboardingSettingID)
+
+	request := importAppleDeviceIdentityList{
+		ImportedAppleDeviceIdentities:     devices,
+		OverwriteImportedDeviceIdentities: overwrite,
+	}
+
+	var results ResponseImportedAppleDeviceIdentityResultsList
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &request, &results)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "imported apple device identities", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &results, nil
+}
+
+// DeleteImportedAppleDeviceIdentityByID deletes an imported Apple device identity by its ID.
+func (c *Client) DeleteImportedAppleDeviceIdentityByID(depOnboardingSettingID, id string) error {
+	endpoint := fmt.Sprintf("%s/%s/importedAppleDeviceIdentities/%s", uriBetaDepOnboardingSettings, depOnboardingSettingID, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "imported apple device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
@@ -8,29 +8,128 @@
 package intune
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
 )
 
-const uriBetaDeviceEnrollmentConfigurations = "/beta/deviceManagement/deviceEnrollmentConfigurations"
+const (
+	uriBetaDeviceEnrollmentConfigurations = "/beta/deviceManagement/deviceEnrollmentConfigurations"
+
+	ODataTypeDeviceEnrollmentLimitConfiguration                   = "#microsoft.graph.deviceEnrollmentLimitConfiguration"
+	ODataTypeDeviceEnrollmentPlatformRestrictionConfiguration     = "#microsoft.graph.deviceEnrollmentPlatformRestrictionConfiguration"
+	ODataTypeDeviceEnrollmentPlatformRestrictionsConfiguration    = "#microsoft.graph.deviceEnrollmentPlatformRestrictionsConfiguration"
+	ODataTypeDeviceEnrollmentWindowsHelloForBusinessConfiguration = "#microsoft.graph.deviceEnrollmentWindowsHelloForBusinessConfiguration"
+	ODataTypeWindows10EnrollmentCompletionPageConfiguration       = "#microsoft.graph.windows10EnrollmentCompletionPageConfiguration"
+	ODataTypeDeviceEnrollmentNotificationConfiguration            = "#microsoft.graph.deviceEnrollmentNotificationConfiguration"
+
+	DeviceEnrollmentConfigurationTypeLimit                     = "limit"
+	DeviceEnrollmentConfigurationTypeSinglePlatformRestriction = "singlePlatformRestriction"
+	DeviceEnrollmentConfigurationTypeWindowsHelloForBusiness   = "windowsHelloForBusiness"
+	DeviceEnrollmentConfigurationTypeEnrollmentStatusPage      = "windows10EnrollmentCompletionPageConfiguration"
+	DeviceEnrollmentConfigurationTypeEnrollmentNotifications   = "enrollmentNotificationsConfiguration"
+
+	EnrollmentRestrictionPlatformTypeAllPlatforms   = "allPlatforms"
+	EnrollmentRestrictionPlatformTypeIOS            = "ios"
+	EnrollmentRestrictionPlatformTypeWindows        = "windows"
+	EnrollmentRestrictionPlatformTypeAndroid        = "android"
+	EnrollmentRestrictionPlatformTypeAndroidForWork = "androidForWork"
+	EnrollmentRestrictionPlatformTypeMac            = "mac"
+	EnrollmentRestrictionPlatformTypeLinux          = "linux"
+)
+
+// deviceEnrollmentConfigurationTypesByODataType maps each creatable subtype to the deviceEnrollmentConfigurationType Graph expects.
+var deviceEnrollmentConfigurationTypesByODataType = map[string]string{
+	ODataTypeDeviceEnrollmentLimitConfiguration:                   DeviceEnrollmentConfigurationTypeLimit,
+	ODataTypeDeviceEnrollmentPlatformRestrictionConfiguration:     DeviceEnrollmentConfigurationTypeSinglePlatformRestriction,
+	ODataTypeDeviceEnrollmentWindowsHelloForBusinessConfiguration: DeviceEnrollmentConfigurationTypeWindowsHelloForBusiness,
+	ODataTypeWindows10EnrollmentCompletionPageConfiguration:       DeviceEnrollmentConfigurationTypeEnrollmentStatusPage,
+	ODataTypeDeviceEnrollmentNotificationConfiguration:            DeviceEnrollmentConfigurationTypeEnrollmentNotifications,
+}
 
 // ResourceDeviceEnrollmentConfigurationsList represents the response structure for device enrollment configuration requests.
 type ResourceDeviceEnrollmentConfigurationsList struct {
 	Value []ResourceDeviceEnrollmentConfiguration `json:"value"`
 }
 
-// DeviceEnrollmentConfiguration represents a device enrollment configuration.
+// DeviceEnrollmentConfiguration represents a device enrollment configuration of any subtype. It is used as both
+// the request and response structure; only the fields of the configuration's @odata.type are populated.
 type ResourceDeviceEnrollmentConfiguration struct {
-	OdataType            string    `json:"@odata.type"`
-	ID                   string    `json:"id"`
-	DisplayName          string    `json:"displayName"`
-	Description          string    `json:"description"`
-	Priority             int       `json:"priority"`
-	CreatedDateTime      time.Time `json:"createdDateTime"`
-	LastModifiedDateTime time.Time `json:"lastModifiedDateTime"`
-	Version              int       `json:"version"`
+	OdataType                         string                              `json:"@odata.type"`
+	ID                                string                              `json:"id"`
+	DisplayName                       string                              `json:"displayName"`
+	Description                       string                              `json:"description"`
+	Priority                          int                                 `json:"priority"`
+	CreatedDateTime                   time.Time                           `json:"createdDateTime"`
+	LastModifiedDateTime              time.Time                           `json:"lastModifiedDateTime"`
+	Version                           int                                 `json:"version"`
+	RoleScopeTagIds                   []string                            `json:"roleScopeTagIds,omitempty"`
+	DeviceEnrollmentConfigurationType string                              `json:"deviceEnrollmentConfigurationType,omitempty"`
+	Assignments                       []EnrollmentConfigurationAssignment `json:"assignments,omitempty"`
+	// Fields for device limit restrictions
+	Limit int `json:"limit,omitempty"`
+	// Fields for single platform restrictions
+	PlatformRestriction *DeviceEnrollmentPlatformRestriction `json:"platformRestriction,omitempty"`
+	PlatformType        string                               `json:"platformType,omitempty"`
+	// Fields for the default all platforms restriction
+	IosRestriction            *DeviceEnrollmentPlatformRestriction `json:"iosRestriction,omitempty"`
+	WindowsRestriction        *DeviceEnrollmentPlatformRestriction `json:"windowsRestriction,omitempty"`
+	WindowsHomeSkuRestriction *DeviceEnrollmentPlatformRestriction `json:"windowsHomeSkuRestriction,omitempty"`
+	WindowsMobileRestriction  *DeviceEnrollmentPlatformRestriction `json:"windowsMobileRestriction,omitempty"`
+	AndroidRestriction        *DeviceEnrollmentPlatformRestriction `json:"androidRestriction,omitempty"`
+	AndroidForWorkRestriction *DeviceEnrollmentPlatformRestriction `json:"androidForWorkRestriction,omitempty"`
+	MacRestriction            *DeviceEnrollmentPlatformRestriction `json:"macRestriction,omitempty"`
+	MacOSRestriction          *DeviceEnrollmentPlatformRestriction `json:"macOSRestriction,omitempty"`
+	// Fields for Windows Hello for Business
+	// Values: notConfigured, enabled, disabled
+	State            string `json:"state,omitempty"`
+	PinMinimumLength int    `json:"pinMinimumLength,omitempty"`
+	PinMaximumLength int    `json:"pinMaximumLength,omitempty"`
+	// Values: allowed, required, disallowed
+	PinUppercaseCharactersUsage string `json:"pinUppercaseCharactersUsage,omitempty"`
+	PinLowercaseCharactersUsage string `json:"pinLowercaseCharactersUsage,omitempty"`
+	PinSpecialCharactersUsage   string `json:"pinSpecialCharactersUsage,omitempty"`
+	SecurityDeviceRequired      *bool  `json:"securityDeviceRequired,omitempty"`
+	UnlockWithBiometricsEnabled *bool  `json:"unlockWithBiometricsEnabled,omitempty"`
+	RemotePassportEnabled       *bool  `json:"remotePassportEnabled,omitempty"`
+	PinPreviousBlockCount       int    `json:"pinPreviousBlockCount,omitempty"`
+	PinExpirationInDays         int    `json:"pinExpirationInDays,omitempty"`
+	EnhancedBiometricsState     string `json:"enhancedBiometricsState,omitempty"`
+	SecurityKeyForSignIn        string `json:"securityKeyForSignIn,omitempty"`
+	EnhancedSignInSecurity      int    `json:"enhancedSignInSecurity,omitempty"`
+	// Fields for the Windows enrollment status page
+	ShowInstallationProgress                *bool    `json:"showInstallationProgress,omitempty"`
+	BlockDeviceSetupRetryByUser             *bool    `json:"blockDeviceSetupRetryByUser,omitempty"`
+	AllowDeviceResetOnInstallFailure        *bool    `json:"allowDeviceResetOnInstallFailure,omitempty"`
+	AllowLogCollectionOnInstallFailure      *bool    `json:"allowLogCollectionOnInstallFailure,omitempty"`
+	CustomErrorMessage                      string   `json:"customErrorMessage,omitempty"`
+	InstallProgressTimeoutInMinutes         int      `json:"installProgressTimeoutInMinutes,omitempty"`
+	AllowDeviceUseOnInstallFailure          *bool    `json:"allowDeviceUseOnInstallFailure,omitempty"`
+	SelectedMobileAppIds                    []string `json:"selectedMobileAppIds,omitempty"`
+	AllowNonBlockingAppInstallation         *bool    `json:"allowNonBlockingAppInstallation,omitempty"`
+	InstallQualityUpdates                   *bool    `json:"installQualityUpdates,omitempty"`
+	TrackInstallProgressForAutopilotOnly    *bool    `json:"trackInstallProgressForAutopilotOnly,omitempty"`
+	DisableUserStatusTrackingAfterFirstUser *bool    `json:"disableUserStatusTrackingAfterFirstUser,omitempty"`
+	// Fields for enrollment notifications
+	// Values: comma separated combination of none, includeCompanyLogo, includeCompanyName, includeContactInformation,
+	// includeCompanyPortalLink, includeDeviceDetails
+	BrandingOptions string `json:"brandingOptions,omitempty"`
+	// Values: email, push
+	TemplateType                  string   `json:"templateType,omitempty"`
+	NotificationMessageTemplateId string   `json:"notificationMessageTemplateId,omitempty"`
+	NotificationTemplates         []string `json:"notificationTemplates,omitempty"`
+}
+
+// DeviceEnrollmentPlatformRestriction represents the enrollment restrictions applied to a single platform.
+type DeviceEnrollmentPlatformRestriction struct {
+	PlatformBlocked                 bool     `json:"platformBlocked"`
+	PersonalDeviceEnrollmentBlocked bool     `json:"personalDeviceEnrollmentBlocked"`
+	OsMinimumVersion                string   `json:"osMinimumVersion,omitempty"`
+	OsMaximumVersion                string   `json:"osMaximumVersion,omitempty"`
+	BlockedManufacturers            []string `json:"blockedManufacturers,omitempty"`
+	BlockedSkus                     []string `json:"blockedSkus,omitempty"`
 }
 
 // GetDeviceEnrollmentConfigurations retrieves a list of all device enrollment configurations.
@@ -88,3 +187,166 @@ func (c *Client) GetDeviceEnrollmentConfigurationByDisplayName(displayName strin
 
 	return c.GetDeviceEnrollmentConfigurationByID(deviceEnrollmentConfigurationID)
 }
+
+// deviceEnrollmentConfigurationPayload returns the request body for creating or updating a device enrollment
+// configuration, excluding the navigation and read-only properties that Graph rejects.
+func deviceEnrollmentConfigurationPayload(request *ResourceDeviceEnrollmentConfiguration) (map[string]interface{}, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedJsonMarshal, "device enrollment configuration", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedJsonMarshal, "device enrollment configuration", err)
+	}
+
+	for _, property := range []string{"id", "priority", "createdDateTime", "lastModifiedDateTime", "version", "assignments"} {
+		delete(payload, property)
+	}
+	// Empty names and descriptions are left out so that a partial update does not clear them
+	for _, property := range []string{"displayName", "description"} {
+		if payload[property] == "" {
+			delete(payload, property)
+		}
+	}
+
+	return payload, nil
+}
+
+// CreateDeviceEnrollmentConfiguration creates a new device enrollment configuration. The deviceEnrollmentConfigurationType
+// is derived from the @odata.type when not set. New configurations are created with the lowest priority.
+func (c *Client) CreateDeviceEnrollmentConfiguration(request *ResourceDeviceEnrollmentConfiguration) (*ResourceDeviceEnrollmentConfiguration, error) {
+	configurationType, ok := deviceEnrollmentConfigurationTypesByODataType[request.OdataType]
+	if !ok {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device enrollment configuration", fmt.Sprintf("unsupported @odata.type %q", request.OdataType))
+	}
+	if request.DeviceEnrollmentConfigurationType == "" {
+		request.DeviceEnrollmentConfigurationType = configurationType
+	}
+
+	endpoint := uriBetaDeviceEnrollmentConfigurations
+
+	payload, err := deviceEnrollmentConfigurationPayload(request)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device enrollment configuration", err)
+	}
+
+	var createdConfiguration ResourceDeviceEnrollmentConfiguration
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &createdConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device enrollment configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdConfiguration, nil
+}
+
+// CreateDeviceEnrollmentConfigurationWithAssignment creates a new device enrollment configuration and assigns it.
+func (c *Client) CreateDeviceEnrollmentConfigurationWithAssignment(request *ResourceDeviceEnrollmentConfiguration, assignment *AssignmentDeviceEnrollmentConfiguration) (*ResourceDeviceEnrollmentConfiguration, error) {
+	createdConfiguration, err := c.CreateDeviceEnrollmentConfiguration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateDeviceEnrollmentConfigurationAssignment(createdConfiguration.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdConfiguration, nil
+}
+
+// UpdateDeviceEnrollmentConfigurationByID updates a device enrollment configuration by its ID. The @odata.type must
+// match the type of the existing configuration. Priority is ignored; use SetDeviceEnrollmentConfigurationPriorityByID.
+func (c *Client) UpdateDeviceEnrollmentConfigurationByID(id string, request *ResourceDeviceEnrollmentConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceEnrollmentConfigurations, id)
+
+	patch, err := deviceEnrollmentConfigurationPayload(request)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device enrollment configuration", id, err)
+	}
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, patch, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device enrollment configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateDeviceEnrollmentConfigurationByDisplayName updates a device enrollment configuration by its display name.
+func (c *Client) UpdateDeviceEnrollmentConfigurationByDisplayName(displayName string, request *ResourceDeviceEnrollmentConfiguration) error {
+	configuration, err := c.GetDeviceEnrollmentConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "device enrollment configuration", displayName, err)
+	}
+
+	return c.UpdateDeviceEnrollmentConfigurationByID(configuration.ID, request)
+}
+
+// SetDeviceEnrollmentConfigurationPriorityByID sets the priority of a device enrollment configuration. Priority 1 is
+// evaluated first; the default configurations have priority 0 and cannot be reordered.
+func (c *Client) SetDeviceEnrollmentConfigurationPriorityByID(id string, priority int) error {
+	endpoint := fmt.Sprintf("%s/%s/setPriority", uriBetaDeviceEnrollmentConfigurations, id)
+
+	requestBody := map[string]int{
+		"priority": priority,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedReorder, "device enrollment configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// ReorderDeviceEnrollmentConfigurations sets the priorities of the given device enrollment configurations so that
+// they are evaluated in the order listed, starting at priority 1. Configurations are updated one at a time as each
+// setPriority call shifts the priorities of the others.
+func (c *Client) ReorderDeviceEnrollmentConfigurations(ids []string) error {
+	for i, id := range ids {
+		if err := c.SetDeviceEnrollmentConfigurationPriorityByID(id, i+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteDeviceEnrollmentConfigurationByID deletes a device enrollment configuration by its ID.
+func (c *Client) DeleteDeviceEnrollmentConfigurationByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceEnrollmentConfigurations, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device enrollment configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceEnrollmentConfigurationByDisplayName deletes a device enrollment configuration by its display name.
+func (c *Client) DeleteDeviceEnrollmentConfigurationByDisplayName(displayName string) error {
+	configuration, err := c.GetDeviceEnrollmentConfigurationByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "device enrollment configuration", displayName, err)
+	}
+
+	return c.DeleteDeviceEnrollmentConfigurationByID(configuration.ID)
+}
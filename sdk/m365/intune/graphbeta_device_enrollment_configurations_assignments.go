@@ -13,7 +13,10 @@ import (
 	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
 )
 
-const uriBetaDeviceEnrollmentConfigurationAssignments = "/beta/deviceManagement/deviceEnrollmentConfigurations"
+const (
+	uriBetaDeviceEnrollmentConfigurationAssignments = "/beta/deviceManagement/deviceEnrollmentConfigurations"
+	odataTypeEnrollmentConfigurationAssignment      = "#microsoft.graph.enrollmentConfigurationAssignment"
+)
 
 // ResourceDeviceEnrollmentConfigurationAssignmentsList represents the response structure for device enrollment configuration assignments.
 type ResourceDeviceEnrollmentConfigurationAssignmentsList struct {
@@ -38,6 +41,18 @@ type EnrollmentAssignmentTarget struct {
 	EntraObjectId                              string `json:"entraObjectId"`
 }
 
+// AssignmentDeviceEnrollmentConfiguration represents the request body of the assign action of a device enrollment configuration.
+type AssignmentDeviceEnrollmentConfiguration struct {
+	EnrollmentConfigurationAssignments []DeviceEnrollmentConfigurationAssignment `json:"enrollmentConfigurationAssignments"`
+}
+
+// DeviceEnrollmentConfigurationAssignment represents a single assignment of a device enrollment configuration.
+type DeviceEnrollmentConfigurationAssignment struct {
+	OdataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
 // GetDeviceEnrollmentConfigurationAssignmentsByDeviceEnrollmentConfigurationID retrieves all assignments for a device enrollment configuration by its ID.
 func (c *Client) GetDeviceEnrollmentConfigurationAssignmentsByDeviceEnrollmentConfigurationID(configId string) (*ResourceDeviceEnrollmentConfigurationAssignmentsList, error) {
 	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaDeviceEnrollmentConfigurationAssignments, configId)
@@ -54,3 +69,24 @@ func (c *Client) GetDeviceEnrollmentConfigurationAssignmentsByDeviceEnrollmentCo
 
 	return &assignments, nil
 }
+
+// CreateDeviceEnrollmentConfigurationAssignment replaces the assignments of a device enrollment configuration.
+func (c *Client) CreateDeviceEnrollmentConfigurationAssignment(configId string, assignment *AssignmentDeviceEnrollmentConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaDeviceEnrollmentConfigurationAssignments, configId)
+
+	// Set graph metadata values
+	for i := range assignment.EnrollmentConfigurationAssignments {
+		assignment.EnrollmentConfigurationAssignments[i].OdataType = odataTypeEnrollmentConfigurationAssignment
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "device enrollment configuration", configId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+// graphbeta_device_enrollment_windows_autopilot_deployment_profiles.go
+// Graph Beta Api - Intune: Windows Autopilot Deployment Profiles
+// Documentation: https://learn.microsoft.com/en-us/autopilot/profiles
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/AutopilotDeploymentProfiles.ReactView
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-windowsautopilotdeploymentprofile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsAutopilotDeploymentProfiles = "/beta/deviceManagement/windowsAutopilotDeploymentProfiles"
+
+	ODataTypeAzureADWindowsAutopilotDeploymentProfile         = "#microsoft.graph.azureADWindowsAutopilotDeploymentProfile"
+	ODataTypeActiveDirectoryWindowsAutopilotDeploymentProfile = "#microsoft.graph.activeDirectoryWindowsAutopilotDeploymentProfile"
+	odataTypeWindowsAutopilotDeploymentProfileAssignment      = "#microsoft.graph.windowsAutopilotDeploymentProfileAssignment"
+
+	WindowsAutopilotDeviceTypeWindowsPc      = "windowsPc"
+	WindowsAutopilotDeviceTypeSurfaceHub2    = "surfaceHub2"
+	WindowsAutopilotDeviceTypeHoloLens       = "holoLens"
+	WindowsAutopilotDeviceTypeSurfaceHub2S   = "surfaceHub2S"
+	WindowsAutopilotDeviceTypeVirtualMachine = "virtualMachine"
+
+	WindowsAutopilotUserTypeAdministrator = "administrator"
+	WindowsAutopilotUserTypeStandard      = "standard"
+
+	WindowsAutopilotDeviceUsageTypeSingleUser = "singleUser"
+	WindowsAutopilotDeviceUsageTypeShared     = "shared"
+)
+
+// ResponseWindowsAutopilotDeploymentProfilesList represents a list of Windows Autopilot deployment profiles.
+type ResponseWindowsAutopilotDeploymentProfilesList struct {
+	ODataContext string                                      `json:"@odata.context"`
+	Value        []ResourceWindowsAutopilotDeploymentProfile `json:"value"`
+}
+
+// ResourceWindowsAutopilotDeploymentProfile represents a Windows Autopilot deployment profile. ODataType selects
+// between an Azure AD joined profile and a hybrid Azure AD joined (Active Directory) profile.
+type ResourceWindowsAutopilotDeploymentProfile struct {
+	ODataType                      string                                        `json:"@odata.type,omitempty"`
+	ID                             string                                        `json:"id,omitempty"`
+	DisplayName                    string                                        `json:"displayName"`
+	Description                    string                                        `json:"description,omitempty"`
+	Language                       string                                        `json:"language,omitempty"`
+	Locale                         string                                        `json:"locale,omitempty"`
+	CreatedDateTime                *time.Time                                    `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime           *time.Time                                    `json:"lastModifiedDateTime,omitempty"`
+	OutOfBoxExperienceSettings     *WindowsAutopilotOutOfBoxExperienceSettings   `json:"outOfBoxExperienceSettings,omitempty"`
+	EnrollmentStatusScreenSettings *WindowsEnrollmentStatusScreenSettings        `json:"enrollmentStatusScreenSettings,omitempty"`
+	ExtractHardwareHash            bool                                          `json:"extractHardwareHash"`
+	DeviceNameTemplate             string                                        `json:"deviceNameTemplate,omitempty"`
+	DeviceType                     string                                        `json:"deviceType,omitempty"`
+	EnableWhiteGlove               bool                                          `json:"enableWhiteGlove"`
+	ManagementServiceAppId         string                                        `json:"managementServiceAppId,omitempty"`
+	RoleScopeTagIds                []string                                      `json:"roleScopeTagIds,omitempty"`
+	Assignments                    []WindowsAutopilotDeploymentProfileAssignment `json:"assignments,omitempty"`
+	// Fields for activeDirectoryWindowsAutopilotDeploymentProfile
+	HybridAzureADJoinSkipConnectivityCheck *bool `json:"hybridAzureADJoinSkipConnectivityCheck,omitempty"`
+}
+
+// WindowsAutopilotOutOfBoxExperienceSettings represents the out of box experience configured by a deployment profile.
+type WindowsAutopilotOutOfBoxExperienceSettings struct {
+	HidePrivacySettings       bool   `json:"hidePrivacySettings"`
+	HideEULA                  bool   `json:"hideEULA"`
+	UserType                  string `json:"userType,omitempty"`
+	DeviceUsageType           string `json:"deviceUsageType,omitempty"`
+	SkipKeyboardSelectionPage bool   `json:"skipKeyboardSelectionPage"`
+	HideEscapeLink            bool   `json:"hideEscapeLink"`
+}
+
+// WindowsEnrollmentStatusScreenSettings represents the enrollment status screen shown during Autopilot provisioning.
+type WindowsEnrollmentStatusScreenSettings struct {
+	HideInstallationProgress                         bool   `json:"hideInstallationProgress"`
+	AllowDeviceUseBeforeProfileAndAppInstallComplete bool   `json:"allowDeviceUseBeforeProfileAndAppInstallComplete"`
+	BlockDeviceSetupRetryByUser                      bool   `json:"blockDeviceSetupRetryByUser"`
+	AllowLogCollectionOnInstallFailure               bool   `json:"allowLogCollectionOnInstallFailure"`
+	CustomErrorMessage                               string `json:"customErrorMessage,omitempty"`
+	InstallProgressTimeoutInMinutes                  int    `json:"installProgressTimeoutInMinutes,omitempty"`
+	AllowDeviceUseOnInstallFailure                   bool   `json:"allowDeviceUseOnInstallFailure"`
+}
+
+// ResponseWindowsAutopilotDeploymentProfileAssignmentsList represents a list of deployment profile assignments.
+type ResponseWindowsAutopilotDeploymentProfileAssignmentsList struct {
+	ODataContext string                                        `json:"@odata.context"`
+	Value        []WindowsAutopilotDeploymentProfileAssignment `json:"value"`
+}
+
+// WindowsAutopilotDeploymentProfileAssignment represents the assignment of a deployment profile to a group.
+type WindowsAutopilotDeploymentProfileAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Source    string                                 `json:"source,omitempty"`
+	SourceId  string                                 `json:"sourceId,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// GetWindowsAutopilotDeploymentProfiles retrieves a list of Windows Autopilot deployment profiles.
+func (c *Client) GetWindowsAutopilotDeploymentProfiles() (*ResponseWindowsAutopilotDeploymentProfilesList, error) {
+	endpoint := uriBetaWindowsAutopilotDeploymentProfiles
+
+	var profiles ResponseWindowsAutopilotDeploymentProfilesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows autopilot deployment profiles", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profiles, nil
+}
+
+// GetWindowsAutopilotDeploymentProfileByID retrieves a Windows Autopilot deployment profile and its assignments by its ID.
+func (c *Client) GetWindowsAutopilotDeploymentProfileByID(id string) (*ResourceWindowsAutopilotDeploymentProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=assignments", uriBetaWindowsAutopilotDeploymentProfiles, id)
+
+	var profile ResourceWindowsAutopilotDeploymentProfile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows autopilot deployment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profile, nil
+}
+
+// GetWindowsAutopilotDeploymentProfileByDisplayName retrieves a Windows Autopilot deployment profile by its display name.
+func (c *Client) GetWindowsAutopilotDeploymentProfileByDisplayName(displayName string) (*ResourceWindowsAutopilotDeploymentProfile, error) {
+	profiles, err := c.GetWindowsAutopilotDeploymentProfiles()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows autopilot deployment profiles", err)
+	}
+
+	for _, profile := range profiles.Value {
+		if profile.DisplayName == displayName {
+			return c.GetWindowsAutopilotDeploymentProfileByID(profile.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows autopilot deployment profile", displayName, "profile not found")
+}
+
+// CreateWindowsAutopilotDeploymentProfile creates a Windows Autopilot deployment profile. An Azure AD joined profile
+// is created when no ODataType is set.
+func (c *Client) CreateWindowsAutopilotDeploymentProfile(request *ResourceWindowsAutopilotDeploymentProfile) (*ResourceWindowsAutopilotDeploymentProfile, error) {
+	endpoint := uriBetaWindowsAutopilotDeploymentProfiles
+
+	if request.ODataType == "" {
+		request.ODataType = ODataTypeAzureADWindowsAutopilotDeploymentProfile
+	}
+
+	// Assignments are created separately once the profile exists
+	payload := *request
+	payload.Assignments = nil
+
+	var createdProfile ResourceWindowsAutopilotDeploymentProfile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &payload, &createdProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows autopilot deployment profile", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdProfile, nil
+}
+
+// CreateWindowsAutopilotDeploymentProfileAssignment assigns a Windows Autopilot deployment profile to a target.
+func (c *Client) CreateWindowsAutopilotDeploymentProfileAssignment(profileID string, assignment *WindowsAutopilotDeploymentProfileAssignment) (*WindowsAutopilotDeploymentProfileAssignment, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaWindowsAutopilotDeploymentProfiles, profileID)
+
+	// Set graph metadata values
+	assignment.ODataType = odataTypeWindowsAutopilotDeploymentProfileAssignment
+
+	var createdAssignment WindowsAutopilotDeploymentProfileAssignment
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, &createdAssignment)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedAssign, "windows autopilot deployment profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdAssignment, nil
+}
+
+// CreateWindowsAutopilotDeploymentProfileWithAssignment creates a Windows Autopilot deployment profile and then
+// creates each of the assignments supplied in the request.
+func (c *Client) CreateWindowsAutopilotDeploymentProfileWithAssignment(request *ResourceWindowsAutopilotDeploymentProfile) (*ResourceWindowsAutopilotDeploymentProfile, error) {
+	createdProfile, err := c.CreateWindowsAutopilotDeploymentProfile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range request.Assignments {
+		createdAssignment, err := c.CreateWindowsAutopilotDeploymentProfileAssignment(createdProfile.ID, &request.Assignments[i])
+		if err != nil {
+			return nil, err
+		}
+		createdProfile.Assignments = append(createdProfile.Assignments, *createdAssignment)
+	}
+
+	return createdProfile, nil
+}
+
+// GetWindowsAutopilotDeploymentProfileAssignments retrieves the assignments of a Windows Autopilot deployment profile.
+func (c *Client) GetWindowsAutopilotDeploymentProfileAssignments(profileID string) (*ResponseWindowsAutopilotDeploymentProfileAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaWindowsAutopilotDeploymentProfiles, profileID)
+
+	var assignments ResponseWindowsAutopilotDeploymentProfileAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows autopilot deployment profile assignments", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// DeleteWindowsAutopilotDeploymentProfileAssignmentByID removes an assignment from a Windows Autopilot deployment profile.
+func (c *Client) DeleteWindowsAutopilotDeploymentProfileAssignmentByID(profileID, assignmentID string) error {
+	endpoint := fmt.Sprintf("%s/%s/assignments/%s", uriBetaWindowsAutopilotDeploymentProfiles, profileID, assignmentID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows autopilot deployment profile assignment", assignmentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateWindowsAutopilotDeploymentProfileByID updates a Windows Autopilot deployment profile by its ID.
+// Assignments are not changed by an update.
+func (c *Client) UpdateWindowsAutopilotDeploymentProfileByID(id string, request *ResourceWindowsAutopilotDeploymentProfile) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsAutopilotDeploymentProfiles, id)
+
+	payload := *request
+	payload.ID = ""
+	payload.CreatedDateTime = nil
+	payload.LastModifiedDateTime = nil
+	payload.Assignments = nil
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows autopilot deployment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateWindowsAutopilotDeploymentProfileByDisplayName updates a Windows Autopilot deployment profile by its display name.
+func (c *Client) UpdateWindowsAutopilotDeploymentProfileByDisplayName(displayName string, request *ResourceWindowsAutopilotDeploymentProfile) error {
+	profile, err := c.GetWindowsAutopilotDeploymentProfileByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "windows autopilot deployment profile", displayName, err)
+	}
+
+	return c.UpdateWindowsAutopilotDeploymentProfileByID(profile.ID, request)
+}
+
+// DeleteWindowsAutopilotDeploymentProfileByID deletes a Windows Autopilot deployment profile by its ID. Graph refuses
+// to delete a profile that is still assigned, so its assignments are removed first.
+func (c *Client) DeleteWindowsAutopilotDeploymentProfileByID(id string) error {
+	assignments, err := c.GetWindowsAutopilotDeploymentProfileAssignments(id)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows autopilot deployment profile", id, err)
+	}
+
+	for _, assignment := range assignments.Value {
+		if err := c.DeleteWindowsAutopilotDeploymentProfileAssignmentByID(id, assignment.ID); err != nil {
+			return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows autopilot deployment profile", id, err)
+		}
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsAutopilotDeploymentProfiles, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows autopilot deployment profile", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsAutopilotDeploymentProfileByDisplayName deletes a Windows Autopilot deployment profile by its display name.
+func (c *Client) DeleteWindowsAutopilotDeploymentProfileByDisplayName(displayName string) error {
+	profile, err := c.GetWindowsAutopilotDeploymentProfileByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "windows autopilot deployment profile", displayName, err)
+	}
+
+	return c.DeleteWindowsAutopilotDeploymentProfileByID(profile.ID)
+}
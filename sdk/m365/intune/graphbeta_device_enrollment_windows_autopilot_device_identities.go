@@ -0,0 +1,409 @@
+// graphbeta_device_enrollment_windows_autopilot_device_identities.go
+// Graph Beta Api - Intune: Windows Autopilot Devices
+// Documentation: https://learn.microsoft.com/en-us/autopilot/add-devices
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/AutopilotDevices.ReactView
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-windowsautopilotdeviceidentity?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-enrollment-importedwindowsautopilotdeviceidentity?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsAutopilotDeviceIdentities         = "/beta/deviceManagement/windowsAutopilotDeviceIdentities"
+	uriBetaImportedWindowsAutopilotDeviceIdentities = "/beta/deviceManagement/importedWindowsAutopilotDeviceIdentities"
+	odataTypeImportedWindowsAutopilotDeviceIdentity = "#microsoft.graph.importedWindowsAutopilotDeviceIdentity"
+
+	ImportedWindowsAutopilotDeviceImportStatusUnknown  = "unknown"
+	ImportedWindowsAutopilotDeviceImportStatusPending  = "pending"
+	ImportedWindowsAutopilotDeviceImportStatusPartial  = "partial"
+	ImportedWindowsAutopilotDeviceImportStatusComplete = "complete"
+	ImportedWindowsAutopilotDeviceImportStatusError    = "error"
+)
+
+// ResponseWindowsAutopilotDeviceIdentitiesList represents a list of Windows Autopilot devices.
+type ResponseWindowsAutopilotDeviceIdentitiesList struct {
+	ODataContext  string                                   `json:"@odata.context"`
+	ODataNextLink string                                   `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWindowsAutopilotDeviceIdentity `json:"value"`
+}
+
+// ResourceWindowsAutopilotDeviceIdentity represents a device registered with Windows Autopilot.
+type ResourceWindowsAutopilotDeviceIdentity struct {
+	ID                                        string    `json:"id"`
+	DisplayName                               string    `json:"displayName"`
+	GroupTag                                  string    `json:"groupTag"`
+	PurchaseOrderIdentifier                   string    `json:"purchaseOrderIdentifier"`
+	SerialNumber                              string    `json:"serialNumber"`
+	ProductKey                                string    `json:"productKey"`
+	Manufacturer                              string    `json:"manufacturer"`
+	Model                                     string    `json:"model"`
+	SkuNumber                                 string    `json:"skuNumber"`
+	SystemFamily                              string    `json:"systemFamily"`
+	EnrollmentState                           string    `json:"enrollmentState"`
+	LastContactedDateTime                     time.Time `json:"lastContactedDateTime"`
+	AddressableUserName                       string    `json:"addressableUserName"`
+	UserPrincipalName                         string    `json:"userPrincipalName"`
+	ResourceName                              string    `json:"resourceName"`
+	AzureActiveDirectoryDeviceId              string    `json:"azureActiveDirectoryDeviceId"`
+	AzureAdDeviceId                           string    `json:"azureAdDeviceId"`
+	ManagedDeviceId                           string    `json:"managedDeviceId"`
+	DeploymentProfileAssignmentStatus         string    `json:"deploymentProfileAssignmentStatus"`
+	DeploymentProfileAssignmentDetailedStatus string    `json:"deploymentProfileAssignmentDetailedStatus"`
+	DeploymentProfileAssignedDateTime         time.Time `json:"deploymentProfileAssignedDateTime"`
+	RemediationState                          string    `json:"remediationState"`
+	UserlessEnrollmentStatus                  string    `json:"userlessEnrollmentStatus"`
+}
+
+// UpdateWindowsAutopilotDeviceProperties represents the request body of the updateDeviceProperties action.
+// Only the properties that are set are changed.
+type UpdateWindowsAutopilotDeviceProperties struct {
+	UserPrincipalName   string `json:"userPrincipalName,omitempty"`
+	AddressableUserName string `json:"addressableUserName,omitempty"`
+	GroupTag            string `json:"groupTag,omitempty"`
+	DisplayName         string `json:"displayName,omitempty"`
+}
+
+// ResponseImportedWindowsAutopilotDeviceIdentitiesList represents a list of imported Windows Autopilot devices.
+type ResponseImportedWindowsAutopilotDeviceIdentitiesList struct {
+	ODataContext string                                           `json:"@odata.context"`
+	Value        []ResourceImportedWindowsAutopilotDeviceIdentity `json:"value"`
+}
+
+// ResourceImportedWindowsAutopilotDeviceIdentity represents a hardware hash uploaded for import into Windows Autopilot.
+// HardwareIdentifier holds the base64 encoded hardware hash.
+type ResourceImportedWindowsAutopilotDeviceIdentity struct {
+	ODataType                 string                                       `json:"@odata.type,omitempty"`
+	ID                        string                                       `json:"id,omitempty"`
+	ImportID                  string                                       `json:"importId,omitempty"`
+	GroupTag                  string                                       `json:"groupTag,omitempty"`
+	SerialNumber              string                                       `json:"serialNumber"`
+	ProductKey                string                                       `json:"productKey,omitempty"`
+	HardwareIdentifier        string                                       `json:"hardwareIdentifier"`
+	AssignedUserPrincipalName string                                       `json:"assignedUserPrincipalName,omitempty"`
+	State                     *ImportedWindowsAutopilotDeviceIdentityState `json:"state,omitempty"`
+}
+
+// ImportedWindowsAutopilotDeviceIdentityState represents the import status of an uploaded hardware hash.
+type ImportedWindowsAutopilotDeviceIdentityState struct {
+	DeviceImportStatus   string `json:"deviceImportStatus"`
+	DeviceRegistrationId string `json:"deviceRegistrationId"`
+	DeviceErrorCode      int    `json:"deviceErrorCode"`
+	DeviceErrorName      string `json:"deviceErrorName"`
+}
+
+// importWindowsAutopilotDeviceIdentities represents the request body of the import action.
+type importWindowsAutopilotDeviceIdentities struct {
+	ImportedWindowsAutopilotDeviceIdentities []ResourceImportedWindowsAutopilotDeviceIdentity `json:"importedWindowsAutopilotDeviceIdentities"`
+}
+
+// GetWindowsAutopilotDeviceIdentities retrieves every Windows Autopilot device, following pagination.
+func (c *Client) GetWindowsAutopilotDeviceIdentities() (*ResponseWindowsAutopilotDeviceIdentitiesList, error) {
+	return c.getWindowsAutopilotDeviceIdentities(uriBetaWindowsAutopilotDeviceIdentities)
+}
+
+// GetWindowsAutopilotDeviceIdentitiesByGroupTag retrieves the Windows Autopilot devices with the given group tag.
+func (c *Client) GetWindowsAutopilotDeviceIdentitiesByGroupTag(groupTag string) (*ResponseWindowsAutopilotDeviceIdentitiesList, error) {
+	devices, err := c.GetWindowsAutopilotDeviceIdentities()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := devices.Value[:0]
+	for _, device := range devices.Value {
+		if strings.EqualFold(device.GroupTag, groupTag) {
+			matched = append(matched, device)
+		}
+	}
+	devices.Value = matched
+
+	return devices, nil
+}
+
+// getWindowsAutopilotDeviceIdentities retrieves all pages of Windows Autopilot devices starting from the given endpoint.
+func (c *Client) getWindowsAutopilotDeviceIdentities(endpoint string) (*ResponseWindowsAutopilotDeviceIdentitiesList, error) {
+	var devices ResponseWindowsAutopilotDeviceIdentitiesList
+
+	for endpoint != "" {
+		var page ResponseWindowsAutopilotDeviceIdentitiesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows autopilot device identities", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if devices.ODataContext == "" {
+			devices.ODataContext = page.ODataContext
+		}
+		devices.Value = append(devices.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows autopilot device identities", err)
+			}
+		}
+	}
+
+	return &devices, nil
+}
+
+// GetWindowsAutopilotDeviceIdentityByID retrieves a Windows Autopilot device by its ID.
+func (c *Client) GetWindowsAutopilotDeviceIdentityByID(id string) (*ResourceWindowsAutopilotDeviceIdentity, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	var device ResourceWindowsAutopilotDeviceIdentity
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &device)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &device, nil
+}
+
+// GetWindowsAutopilotDeviceIdentityBySerialNumber retrieves a Windows Autopilot device by its serial number.
+func (c *Client) GetWindowsAutopilotDeviceIdentityBySerialNumber(serialNumber string) (*ResourceWindowsAutopilotDeviceIdentity, error) {
+	endpoint := fmt.Sprintf("%s?$filter=contains(serialNumber,'%s')", uriBetaWindowsAutopilotDeviceIdentities, odataEscapeString(serialNumber))
+
+	devices, err := c.getWindowsAutopilotDeviceIdentities(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices.Value {
+		if strings.EqualFold(device.SerialNumber, serialNumber) {
+			return &device, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows autopilot device identity", serialNumber, "device not found")
+}
+
+// UpdateWindowsAutopilotDevicePropertiesByID updates the group tag, display name and assigned user of a Windows Autopilot device.
+func (c *Client) UpdateWindowsAutopilotDevicePropertiesByID(id string, request *UpdateWindowsAutopilotDeviceProperties) error {
+	endpoint := fmt.Sprintf("%s/%s/updateDeviceProperties", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateWindowsAutopilotDeviceGroupTagByID sets the group tag of a Windows Autopilot device. An empty group tag
+// removes it.
+func (c *Client) UpdateWindowsAutopilotDeviceGroupTagByID(id, groupTag string) error {
+	endpoint := fmt.Sprintf("%s/%s/updateDeviceProperties", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	// The group tag is always sent, unlike in UpdateWindowsAutopilotDeviceProperties, so that it can be cleared
+	requestBody := map[string]string{
+		"groupTag": groupTag,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows autopilot device identity group tag", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// AssignUserToWindowsAutopilotDeviceByID assigns a user to a Windows Autopilot device for a personalised out of box experience.
+func (c *Client) AssignUserToWindowsAutopilotDeviceByID(id, userPrincipalName, addressableUserName string) error {
+	endpoint := fmt.Sprintf("%s/%s/assignUserToDevice", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	requestBody := map[string]string{
+		"userPrincipalName":   userPrincipalName,
+		"addressableUserName": addressableUserName,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "user to windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UnassignUserFromWindowsAutopilotDeviceByID removes the assigned user from a Windows Autopilot device.
+func (c *Client) UnassignUserFromWindowsAutopilotDeviceByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s/unassignUserFromDevice", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsAutopilotDeviceIdentityByID deregisters a device from Windows Autopilot.
+func (c *Client) DeleteWindowsAutopilotDeviceIdentityByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsAutopilotDeviceIdentities, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// ImportWindowsAutopilotDeviceIdentities uploads hardware hashes for import into Windows Autopilot. Import happens
+// asynchronously; use WaitForImportedWindowsAutopilotDeviceIdentities to wait for the outcome.
+func (c *Client) ImportWindowsAutopilotDeviceIdentities(devices []ResourceImportedWindowsAutopilotDeviceIdentity) (*ResponseImportedWindowsAutopilotDeviceIdentitiesList, error) {
+	endpoint := uriBetaImportedWindowsAutopilotDeviceIdentities + "/import"
+
+	request := importWindowsAutopilotDeviceIdentities{
+		ImportedWindowsAutopilotDeviceIdentities: make([]ResourceImportedWindowsAutopilotDeviceIdentity, len(devices)),
+	}
+	for i, device := range devices {
+		device.ODataType = odataTypeImportedWindowsAutopilotDeviceIdentity
+		request.ImportedWindowsAutopilotDeviceIdentities[i] = device
+	}
+
+	var importedDevices ResponseImportedWindowsAutopilotDeviceIdentitiesList
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &request, &importedDevices)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "imported windows autopilot device identities", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &importedDevices, nil
+}
+
+// GetImportedWindowsAutopilotDeviceIdentities retrieves all hardware hash uploads and their import status.
+func (c *Client) GetImportedWindowsAutopilotDeviceIdentities() (*ResponseImportedWindowsAutopilotDeviceIdentitiesList, error) {
+	endpoint := uriBetaImportedWindowsAutopilotDeviceIdentities
+
+	var importedDevices ResponseImportedWindowsAutopilotDeviceIdentitiesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &importedDevices)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "imported windows autopilot device identities", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &importedDevices, nil
+}
+
+// GetImportedWindowsAutopilotDeviceIdentityByID retrieves a hardware hash upload and its import status by its ID.
+func (c *Client) GetImportedWindowsAutopilotDeviceIdentityByID(id string) (*ResourceImportedWindowsAutopilotDeviceIdentity, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaImportedWindowsAutopilotDeviceIdentities, id)
+
+	var importedDevice ResourceImportedWindowsAutopilotDeviceIdentity
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &importedDevice)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "imported windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &importedDevice, nil
+}
+
+// DeleteImportedWindowsAutopilotDeviceIdentityByID deletes a hardware hash upload record once its import has finished.
+func (c *Client) DeleteImportedWindowsAutopilotDeviceIdentityByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaImportedWindowsAutopilotDeviceIdentities, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "imported windows autopilot device identity", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// WaitForImportedWindowsAutopilotDeviceIdentities polls hardware hash uploads until every import has completed or
+// failed, or the timeout elapses. The final state of each upload is returned in the order of the given IDs; uploads
+// that failed carry their error in State and do not cause an error to be returned.
+func (c *Client) WaitForImportedWindowsAutopilotDeviceIdentities(ids []string, pollInterval, timeout time.Duration) ([]ResourceImportedWindowsAutopilotDeviceIdentity, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		importedDevices, err := shared.RunWorkerPool(c.maxConcurrentRequests(), len(ids), func(i int) (ResourceImportedWindowsAutopilotDeviceIdentity, error) {
+			importedDevice, err := c.GetImportedWindowsAutopilotDeviceIdentityByID(ids[i])
+			if err != nil {
+				return ResourceImportedWindowsAutopilotDeviceIdentity{}, err
+			}
+			return *importedDevice, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var pending int
+		for _, importedDevice := range importedDevices {
+			if !importedWindowsAutopilotDeviceImportFinished(importedDevice.State) {
+				pending++
+			}
+		}
+
+		if pending == 0 {
+			return importedDevices, nil
+		}
+
+		if time.Now().After(deadline) {
+			return importedDevices, fmt.Errorf("timed out after %s waiting for %d of %d windows autopilot device imports", timeout, pending, len(ids))
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// importedWindowsAutopilotDeviceImportFinished reports whether an import has reached a final status.
+func importedWindowsAutopilotDeviceImportFinished(state *ImportedWindowsAutopilotDeviceIdentityState) bool {
+	if state == nil {
+		return false
+	}
+
+	return state.DeviceImportStatus == ImportedWindowsAutopilotDeviceImportStatusComplete ||
+		state.DeviceImportStatus == ImportedWindowsAutopilotDeviceImportStatusError
+}
@@ -0,0 +1,164 @@
+// graphbeta_device_enrollment_windows_autopilot_hardware_hash_csv.go
+// Graph Beta Api - Intune: Windows Autopilot Hardware Hash CSV
+// Documentation: https://learn.microsoft.com/en-us/autopilot/add-devices#ensure-proper-csv-file-formatting
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/AutopilotDevices.ReactView
+// Parses the hardware hash CSV produced by Get-WindowsAutopilotInfo and OEM tooling into devices ready for import.
+
+package intune
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	WindowsAutopilotCSVColumnSerialNumber = "Device Serial Number"
+	WindowsAutopilotCSVColumnProductID    = "Windows Product ID"
+	WindowsAutopilotCSVColumnHardwareHash = "Hardware Hash"
+	WindowsAutopilotCSVColumnGroupTag     = "Group Tag"
+	WindowsAutopilotCSVColumnAssignedUser = "Assigned User"
+)
+
+// ParseWindowsAutopilotHardwareHashCSV parses a hardware hash CSV in the standard Autopilot format. The file must
+// contain the Device Serial Number, Windows Product ID and Hardware Hash columns and may contain the optional Group Tag
+// and Assigned User columns. UTF-8 and UTF-16 files with a byte order mark are accepted.
+//
+// Every row is validated: the serial number and hardware hash are required, the hash must be valid base64 and serial
+// numbers must be unique within the file. All problems are reported together, each prefixed with its line number.
+func ParseWindowsAutopilotHardwareHashCSV(r io.Reader) ([]ResourceImportedWindowsAutopilotDeviceIdentity, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read windows autopilot hardware hash csv: %v", err)
+	}
+
+	data, err = decodeWindowsAutopilotCSVEncoding(data)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("windows autopilot hardware hash csv is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read windows autopilot hardware hash csv header: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{WindowsAutopilotCSVColumnSerialNumber, WindowsAutopilotCSVColumnProductID, WindowsAutopilotCSVColumnHardwareHash} {
+		if _, ok := columns[strings.ToLower(required)]; !ok {
+			return nil, fmt.Errorf("windows autopilot hardware hash csv is missing the %q column", required)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[strings.ToLower(column)]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var devices []ResourceImportedWindowsAutopilotDeviceIdentity
+	var errs []error
+	seen := make(map[string]int)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// csv.ParseError already carries the line number
+			errs = append(errs, err)
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		device := ResourceImportedWindowsAutopilotDeviceIdentity{
+			SerialNumber:              field(record, WindowsAutopilotCSVColumnSerialNumber),
+			ProductKey:                field(record, WindowsAutopilotCSVColumnProductID),
+			HardwareIdentifier:        field(record, WindowsAutopilotCSVColumnHardwareHash),
+			GroupTag:                  field(record, WindowsAutopilotCSVColumnGroupTag),
+			AssignedUserPrincipalName: field(record, WindowsAutopilotCSVColumnAssignedUser),
+		}
+
+		if device.SerialNumber == "" {
+			errs = append(errs, fmt.Errorf("line %d: %s is empty", line, WindowsAutopilotCSVColumnSerialNumber))
+		} else if firstLine, ok := seen[strings.ToLower(device.SerialNumber)]; ok {
+			errs = append(errs, fmt.Errorf("line %d: serial number %s is a duplicate of line %d", line, device.SerialNumber, firstLine))
+		} else {
+			seen[strings.ToLower(device.SerialNumber)] = line
+		}
+
+		if device.HardwareIdentifier == "" {
+			errs = append(errs, fmt.Errorf("line %d: %s is empty", line, WindowsAutopilotCSVColumnHardwareHash))
+		} else if _, err := base64.StdEncoding.DecodeString(device.HardwareIdentifier); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %s is not valid base64: %v", line, WindowsAutopilotCSVColumnHardwareHash, err))
+		}
+
+		devices = append(devices, device)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("windows autopilot hardware hash csv contains no devices")
+	}
+
+	return devices, nil
+}
+
+// ParseWindowsAutopilotHardwareHashCSVFile parses a hardware hash CSV file from disk.
+func ParseWindowsAutopilotHardwareHashCSVFile(path string) ([]ResourceImportedWindowsAutopilotDeviceIdentity, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open windows autopilot hardware hash csv %s: %v", path, err)
+	}
+	defer file.Close()
+
+	return ParseWindowsAutopilotHardwareHashCSV(file)
+}
+
+// decodeWindowsAutopilotCSVEncoding strips a UTF-8 byte order mark and converts UTF-16 content to UTF-8.
+func decodeWindowsAutopilotCSVEncoding(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return data[3:], nil
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}), bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		littleEndian := data[0] == 0xFF
+		data = data[2:]
+		if len(data)%2 != 0 {
+			return nil, fmt.Errorf("windows autopilot hardware hash csv is not valid utf-16")
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if littleEndian {
+				units[i] = uint16(data[2*i]) | uint16(data[2*i+1])<<8
+			} else {
+				units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+			}
+		}
+		return []byte(string(utf16.Decode(units))), nil
+	default:
+		return data, nil
+	}
+}
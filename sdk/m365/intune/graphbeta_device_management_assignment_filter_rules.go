@@ -0,0 +1,723 @@
+// graphbeta_device_management_assignment_filter_rules.go
+// Graph Beta Api - Intune: Assignment Filters (rule syntax)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/filters-device-properties
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesMenu/~/assignmentFilters
+// A filter rule compares device (or app) properties with values, for example
+// (device.osVersion -startsWith "10.0.22") and (device.model -in ["Surface Pro 8", "Surface Laptop 4"]).
+// Rules are parsed into a syntax tree so they can be validated for a platform and evaluated locally
+// before they are sent to Intune.
+
+package intune
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Platforms an assignment filter can target.
+const (
+	AssignmentFilterPlatformAndroid                            = "android"
+	AssignmentFilterPlatformAndroidForWork                     = "androidForWork"
+	AssignmentFilterPlatformAndroidWorkProfile                 = "androidWorkProfile"
+	AssignmentFilterPlatformAndroidAOSP                        = "androidAOSP"
+	AssignmentFilterPlatformIOS                                = "iOS"
+	AssignmentFilterPlatformMacOS                              = "macOS"
+	AssignmentFilterPlatformWindows10AndLater                  = "windows10AndLater"
+	AssignmentFilterPlatformAndroidMobileApplicationManagement = "androidMobileApplicationManagement"
+	AssignmentFilterPlatformIOSMobileApplicationManagement     = "iOSMobileApplicationManagement"
+	AssignmentFilterPlatformWindowsMobileApplicationManagement = "windowsMobileApplicationManagement"
+)
+
+// Operators supported by assignment filter rules.
+const (
+	AssignmentFilterOperatorEquals         = "-eq"
+	AssignmentFilterOperatorNotEquals      = "-ne"
+	AssignmentFilterOperatorStartsWith     = "-startsWith"
+	AssignmentFilterOperatorEndsWith       = "-endsWith"
+	AssignmentFilterOperatorNotEndsWith    = "-notEndsWith"
+	AssignmentFilterOperatorContains       = "-contains"
+	AssignmentFilterOperatorNotContains    = "-notContains"
+	AssignmentFilterOperatorIn             = "-in"
+	AssignmentFilterOperatorNotIn          = "-notIn"
+	AssignmentFilterOperatorGreaterThan    = "-gt"
+	AssignmentFilterOperatorGreaterOrEqual = "-ge"
+	AssignmentFilterOperatorLessThan       = "-lt"
+	AssignmentFilterOperatorLessOrEqual    = "-le"
+)
+
+// Logical operators joining assignment filter rule expressions.
+const (
+	AssignmentFilterLogicalAnd = "and"
+	AssignmentFilterLogicalOr  = "or"
+)
+
+// Property types used to decide which operators and values a filter property accepts.
+const (
+	AssignmentFilterPropertyTypeString  = "string"
+	AssignmentFilterPropertyTypeVersion = "version"
+	AssignmentFilterPropertyTypeBoolean = "boolean"
+	AssignmentFilterPropertyTypeEnum    = "enum"
+)
+
+var allAssignmentFilterOperators = []string{
+	AssignmentFilterOperatorEquals,
+	AssignmentFilterOperatorNotEquals,
+	AssignmentFilterOperatorStartsWith,
+	AssignmentFilterOperatorEndsWith,
+	AssignmentFilterOperatorNotEndsWith,
+	AssignmentFilterOperatorContains,
+	AssignmentFilterOperatorNotContains,
+	AssignmentFilterOperatorIn,
+	AssignmentFilterOperatorNotIn,
+	AssignmentFilterOperatorGreaterThan,
+	AssignmentFilterOperatorGreaterOrEqual,
+	AssignmentFilterOperatorLessThan,
+	AssignmentFilterOperatorLessOrEqual,
+}
+
+// assignmentFilterOperatorsByPropertyType lists the operators Intune accepts for each property type.
+var assignmentFilterOperatorsByPropertyType = map[string][]string{
+	AssignmentFilterPropertyTypeString: {
+		AssignmentFilterOperatorEquals, AssignmentFilterOperatorNotEquals, AssignmentFilterOperatorStartsWith,
+		AssignmentFilterOperatorEndsWith, AssignmentFilterOperatorNotEndsWith, AssignmentFilterOperatorContains,
+		AssignmentFilterOperatorNotContains, AssignmentFilterOperatorIn, AssignmentFilterOperatorNotIn,
+	},
+	AssignmentFilterPropertyTypeVersion: {
+		AssignmentFilterOperatorEquals, AssignmentFilterOperatorNotEquals, AssignmentFilterOperatorStartsWith,
+		AssignmentFilterOperatorIn, AssignmentFilterOperatorNotIn, AssignmentFilterOperatorGreaterThan,
+		AssignmentFilterOperatorGreaterOrEqual, AssignmentFilterOperatorLessThan, AssignmentFilterOperatorLessOrEqual,
+	},
+	AssignmentFilterPropertyTypeBoolean: {
+		AssignmentFilterOperatorEquals, AssignmentFilterOperatorNotEquals,
+	},
+	AssignmentFilterPropertyTypeEnum: {
+		AssignmentFilterOperatorEquals, AssignmentFilterOperatorNotEquals, AssignmentFilterOperatorIn,
+		AssignmentFilterOperatorNotIn,
+	},
+}
+
+// managedDeviceFilterProperties are the device properties available to every managed device platform.
+var managedDeviceFilterProperties = map[string]string{
+	"deviceName":            AssignmentFilterPropertyTypeString,
+	"manufacturer":          AssignmentFilterPropertyTypeString,
+	"model":                 AssignmentFilterPropertyTypeString,
+	"deviceCategory":        AssignmentFilterPropertyTypeString,
+	"osVersion":             AssignmentFilterPropertyTypeVersion,
+	"deviceOwnership":       AssignmentFilterPropertyTypeEnum,
+	"enrollmentProfileName": AssignmentFilterPropertyTypeString,
+}
+
+// managedAppFilterProperties are the app properties available to app protection (MAM) platforms.
+var managedAppFilterProperties = map[string]string{
+	"appVersion":         AssignmentFilterPropertyTypeVersion,
+	"deviceManufacturer": AssignmentFilterPropertyTypeString,
+	"deviceModel":        AssignmentFilterPropertyTypeString,
+	"osVersion":          AssignmentFilterPropertyTypeVersion,
+	"managementType":     AssignmentFilterPropertyTypeEnum,
+}
+
+// assignmentFilterPropertiesByPlatform maps each platform to the entity its rules use and the properties
+// that entity exposes on that platform.
+var assignmentFilterPropertiesByPlatform = map[string]assignmentFilterPlatformProperties{
+	AssignmentFilterPlatformAndroid:            {"device", withFilterProperties(managedDeviceFilterProperties, "isRooted", AssignmentFilterPropertyTypeBoolean)},
+	AssignmentFilterPlatformAndroidForWork:     {"device", withFilterProperties(managedDeviceFilterProperties, "isRooted", AssignmentFilterPropertyTypeBoolean)},
+	AssignmentFilterPlatformAndroidWorkProfile: {"device", withFilterProperties(managedDeviceFilterProperties, "isRooted", AssignmentFilterPropertyTypeBoolean)},
+	AssignmentFilterPlatformAndroidAOSP:        {"device", managedDeviceFilterProperties},
+	AssignmentFilterPlatformIOS:                {"device", managedDeviceFilterProperties},
+	AssignmentFilterPlatformMacOS:              {"device", withFilterProperties(managedDeviceFilterProperties, "cpuArchitecture", AssignmentFilterPropertyTypeEnum)},
+	AssignmentFilterPlatformWindows10AndLater: {"device", withFilterProperties(managedDeviceFilterProperties,
+		"operatingSystemSKU", AssignmentFilterPropertyTypeEnum,
+		"operatingSystemVersion", AssignmentFilterPropertyTypeVersion,
+		"deviceTrustType", AssignmentFilterPropertyTypeEnum,
+		"cpuArchitecture", AssignmentFilterPropertyTypeEnum,
+	)},
+	AssignmentFilterPlatformAndroidMobileApplicationManagement: {"app", managedAppFilterProperties},
+	AssignmentFilterPlatformIOSMobileApplicationManagement:     {"app", managedAppFilterProperties},
+	AssignmentFilterPlatformWindowsMobileApplicationManagement: {"app", managedAppFilterProperties},
+}
+
+type assignmentFilterPlatformProperties struct {
+	entity     string
+	properties map[string]string
+}
+
+// withFilterProperties returns a copy of base with the given name, type pairs added.
+func withFilterProperties(base map[string]string, nameTypePairs ...string) map[string]string {
+	properties := make(map[string]string, len(base)+len(nameTypePairs)/2)
+	for name, propertyType := range base {
+		properties[name] = propertyType
+	}
+	for i := 0; i+1 < len(nameTypePairs); i += 2 {
+		properties[nameTypePairs[i]] = nameTypePairs[i+1]
+	}
+	return properties
+}
+
+// AssignmentFilterRuleSyntaxError reports a problem found while parsing a filter rule. Position is the
+// 1-based character offset in the rule at which the problem was found.
+type AssignmentFilterRuleSyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *AssignmentFilterRuleSyntaxError) Error() string {
+	return fmt.Sprintf("assignment filter rule syntax error at position %d: %s", e.Position, e.Message)
+}
+
+// AssignmentFilterRuleNode is a node of a parsed filter rule: either an AssignmentFilterRuleLogical
+// joining two expressions or an AssignmentFilterRuleComparison.
+type AssignmentFilterRuleNode interface {
+	String() string
+	evaluate(properties map[string]string) bool
+}
+
+// AssignmentFilterRuleLogical joins two expressions with "and" or "or".
+type AssignmentFilterRuleLogical struct {
+	Operator string
+	Left     AssignmentFilterRuleNode
+	Right    AssignmentFilterRuleNode
+}
+
+// String renders the expression in filter rule syntax, parenthesising each side.
+func (n *AssignmentFilterRuleLogical) String() string {
+	return fmt.Sprintf("(%s) %s (%s)", n.Left, n.Operator, n.Right)
+}
+
+func (n *AssignmentFilterRuleLogical) evaluate(properties map[string]string) bool {
+	if n.Operator == AssignmentFilterLogicalAnd {
+		return n.Left.evaluate(properties) && n.Right.evaluate(properties)
+	}
+	return n.Left.evaluate(properties) || n.Right.evaluate(properties)
+}
+
+// AssignmentFilterRuleComparison compares a single property with one value, or with a list of values
+// for the -in and -notIn operators. Operator holds the canonical spelling, e.g. "-startsWith".
+type AssignmentFilterRuleComparison struct {
+	Entity   string
+	Property string
+	Operator string
+	Values   []string
+	Position int
+}
+
+// String renders the comparison in filter rule syntax.
+func (n *AssignmentFilterRuleComparison) String() string {
+	quoted := make([]string, len(n.Values))
+	for i, value := range n.Values {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	value := strings.Join(quoted, ", ")
+	if n.Operator == AssignmentFilterOperatorIn || n.Operator == AssignmentFilterOperatorNotIn {
+		value = "[" + value + "]"
+	}
+
+	return fmt.Sprintf("%s.%s %s %s", n.Entity, n.Property, n.Operator, value)
+}
+
+// evaluate compares the property case-insensitively, as Intune does. A property missing from the record
+// is treated as an empty string.
+func (n *AssignmentFilterRuleComparison) evaluate(properties map[string]string) bool {
+	actual := strings.ToLower(properties[strings.ToLower(n.Property)])
+	expected := strings.ToLower(n.Values[0])
+
+	switch n.Operator {
+	case AssignmentFilterOperatorEquals:
+		return actual == expected
+	case AssignmentFilterOperatorNotEquals:
+		return actual != expected
+	case AssignmentFilterOperatorStartsWith:
+		return strings.HasPrefix(actual, expected)
+	case AssignmentFilterOperatorEndsWith:
+		return strings.HasSuffix(actual, expected)
+	case AssignmentFilterOperatorNotEndsWith:
+		return !strings.HasSuffix(actual, expected)
+	case AssignmentFilterOperatorContains:
+		return strings.Contains(actual, expected)
+	case AssignmentFilterOperatorNotContains:
+		return !strings.Contains(actual, expected)
+	case AssignmentFilterOperatorIn, AssignmentFilterOperatorNotIn:
+		found := false
+		for _, value := range n.Values {
+			if actual == strings.ToLower(value) {
+				found = true
+				break
+			}
+		}
+		return found == (n.Operator == AssignmentFilterOperatorIn)
+	case AssignmentFilterOperatorGreaterThan:
+		return compareAssignmentFilterVersions(actual, expected) > 0
+	case AssignmentFilterOperatorGreaterOrEqual:
+		return compareAssignmentFilterVersions(actual, expected) >= 0
+	case AssignmentFilterOperatorLessThan:
+		return compareAssignmentFilterVersions(actual, expected) < 0
+	case AssignmentFilterOperatorLessOrEqual:
+		return compareAssignmentFilterVersions(actual, expected) <= 0
+	}
+
+	return false
+}
+
+// compareAssignmentFilterVersions compares dotted version strings component by component, falling back to
+// a string comparison when either side is not a numeric version.
+func compareAssignmentFilterVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aValue, bValue int
+		var err error
+		if i < len(aParts) {
+			if aValue, err = strconv.Atoi(aParts[i]); err != nil {
+				return strings.Compare(a, b)
+			}
+		}
+		if i < len(bParts) {
+			if bValue, err = strconv.Atoi(bParts[i]); err != nil {
+				return strings.Compare(a, b)
+			}
+		}
+		if aValue != bValue {
+			if aValue < bValue {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// AssignmentFilterRule is a parsed assignment filter rule.
+type AssignmentFilterRule struct {
+	Root AssignmentFilterRuleNode
+}
+
+// String renders the rule in filter rule syntax.
+func (r *AssignmentFilterRule) String() string {
+	return r.Root.String()
+}
+
+// Comparisons returns every comparison in the rule in the order they appear.
+func (r *AssignmentFilterRule) Comparisons() []*AssignmentFilterRuleComparison {
+	var comparisons []*AssignmentFilterRuleComparison
+
+	var walk func(node AssignmentFilterRuleNode)
+	walk = func(node AssignmentFilterRuleNode) {
+		switch n := node.(type) {
+		case *AssignmentFilterRuleLogical:
+			walk(n.Left)
+			walk(n.Right)
+		case *AssignmentFilterRuleComparison:
+			comparisons = append(comparisons, n)
+		}
+	}
+	walk(r.Root)
+
+	return comparisons
+}
+
+// Validate checks that every comparison uses the entity, a property and an operator supported on the platform
+// and that boolean and version values are well formed. All problems are returned joined into a single error.
+func (r *AssignmentFilterRule) Validate(platform string) error {
+	platformProperties, ok := assignmentFilterPropertiesByPlatform[platform]
+	if !ok {
+		return fmt.Errorf("unsupported assignment filter platform %q", platform)
+	}
+
+	var errs []error
+	for _, comparison := range r.Comparisons() {
+		prefix := fmt.Sprintf("position %d (%s.%s)", comparison.Position, comparison.Entity, comparison.Property)
+
+		if comparison.Entity != platformProperties.entity {
+			errs = append(errs, fmt.Errorf("%s: platform %s filters on %s properties, not %s", prefix, platform, platformProperties.entity, comparison.Entity))
+			continue
+		}
+
+		propertyType, ok := platformProperties.properties[comparison.Property]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: property is not supported on platform %s", prefix, platform))
+			continue
+		}
+
+		if !containsString(assignmentFilterOperatorsByPropertyType[propertyType], comparison.Operator) {
+			errs = append(errs, fmt.Errorf("%s: operator %s is not supported for %s properties", prefix, comparison.Operator, propertyType))
+		}
+
+		for _, value := range comparison.Values {
+			switch propertyType {
+			case AssignmentFilterPropertyTypeBoolean:
+				if _, err := strconv.ParseBool(value); err != nil {
+					errs = append(errs, fmt.Errorf("%s: value %q is not a boolean", prefix, value))
+				}
+			case AssignmentFilterPropertyTypeVersion:
+				if comparison.Operator != AssignmentFilterOperatorStartsWith && !assignmentFilterVersionValue(value) {
+					errs = append(errs, fmt.Errorf("%s: value %q is not a version", prefix, value))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// assignmentFilterVersionValue reports whether value is a dotted numeric version such as 10.0.22621.
+func assignmentFilterVersionValue(value string) bool {
+	for _, part := range strings.Split(value, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate tests the rule against a record of property values keyed by property name without the entity
+// prefix, e.g. "osVersion". Property names and values are matched case-insensitively.
+func (r *AssignmentFilterRule) Evaluate(properties map[string]string) bool {
+	normalised := make(map[string]string, len(properties))
+	for name, value := range properties {
+		normalised[strings.ToLower(name)] = value
+	}
+
+	return r.Root.evaluate(normalised)
+}
+
+// EvaluateAll tests the rule against each record and returns whether each one matches, in order.
+func (r *AssignmentFilterRule) EvaluateAll(records []map[string]string) []bool {
+	matches := make([]bool, len(records))
+	for i, record := range records {
+		matches[i] = r.Evaluate(record)
+	}
+
+	return matches
+}
+
+// ParseAssignmentFilterRule parses a filter rule into a syntax tree. "and" binds more tightly than "or";
+// operators and logical keywords are case-insensitive. Syntax errors are returned as
+// *AssignmentFilterRuleSyntaxError.
+func ParseAssignmentFilterRule(rule string) (*AssignmentFilterRule, error) {
+	tokens, err := lexAssignmentFilterRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &assignmentFilterRuleParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != filterTokenEOF {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: next.position, Message: fmt.Sprintf("unexpected %s", next)}
+	}
+
+	return &AssignmentFilterRule{Root: root}, nil
+}
+
+// ParseAssignmentFilterRuleForPlatform parses a filter rule and validates it for the platform.
+func ParseAssignmentFilterRuleForPlatform(rule, platform string) (*AssignmentFilterRule, error) {
+	parsed, err := ParseAssignmentFilterRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := parsed.Validate(platform); err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// ValidateRule parses the filter's rule and validates it for the filter's platform.
+func (f *ResourceDeviceManagementAssignmentFilter) ValidateRule() error {
+	_, err := ParseAssignmentFilterRuleForPlatform(f.Rule, f.Platform)
+	return err
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenLeftParen
+	filterTokenRightParen
+	filterTokenLeftBracket
+	filterTokenRightBracket
+	filterTokenComma
+	filterTokenOperator
+	filterTokenString
+	filterTokenWord
+)
+
+type filterToken struct {
+	kind     filterTokenKind
+	text     string
+	position int
+}
+
+func (t filterToken) String() string {
+	switch t.kind {
+	case filterTokenEOF:
+		return "end of rule"
+	case filterTokenString:
+		return strconv.Quote(t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// lexAssignmentFilterRule splits a rule into tokens. Strings are double quoted and support \" and \\ escapes.
+func lexAssignmentFilterRule(rule string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(rule)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{filterTokenLeftParen, "(", position})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{filterTokenRightParen, ")", position})
+			i++
+		case r == '[':
+			tokens = append(tokens, filterToken{filterTokenLeftBracket, "[", position})
+			i++
+		case r == ']':
+			tokens = append(tokens, filterToken{filterTokenRightBracket, "]", position})
+			i++
+		case r == ',':
+			tokens = append(tokens, filterToken{filterTokenComma, ",", position})
+			i++
+		case r == '"':
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, &AssignmentFilterRuleSyntaxError{Position: position, Message: "unterminated string"}
+				}
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					value.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, filterToken{filterTokenString, value.String(), position})
+		case r == '-':
+			start := i
+			i++
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+			text := string(runes[start:i])
+			operator, ok := canonicalAssignmentFilterOperator(text)
+			if !ok {
+				return nil, &AssignmentFilterRuleSyntaxError{Position: position, Message: fmt.Sprintf("unknown operator %q", text)}
+			}
+			tokens = append(tokens, filterToken{filterTokenOperator, operator, position})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, filterToken{filterTokenWord, string(runes[start:i]), position})
+		default:
+			return nil, &AssignmentFilterRuleSyntaxError{Position: position, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, filterToken{filterTokenEOF, "", len(runes) + 1}), nil
+}
+
+// canonicalAssignmentFilterOperator matches an operator case-insensitively and returns its canonical spelling.
+func canonicalAssignmentFilterOperator(text string) (string, bool) {
+	for _, operator := range allAssignmentFilterOperators {
+		if strings.EqualFold(operator, text) {
+			return operator, true
+		}
+	}
+	return "", false
+}
+
+type assignmentFilterRuleParser struct {
+	tokens []filterToken
+	index  int
+}
+
+func (p *assignmentFilterRuleParser) peek() filterToken {
+	return p.tokens[p.index]
+}
+
+func (p *assignmentFilterRuleParser) next() filterToken {
+	token := p.tokens[p.index]
+	if token.kind != filterTokenEOF {
+		p.index++
+	}
+	return token
+}
+
+// peekKeyword reports whether the next token is the given logical keyword.
+func (p *assignmentFilterRuleParser) peekKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == filterTokenWord && strings.EqualFold(token.text, keyword)
+}
+
+func (p *assignmentFilterRuleParser) parseOr() (AssignmentFilterRuleNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword(AssignmentFilterLogicalOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &AssignmentFilterRuleLogical{Operator: AssignmentFilterLogicalOr, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *assignmentFilterRuleParser) parseAnd() (AssignmentFilterRuleNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword(AssignmentFilterLogicalAnd) {
+		p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = &AssignmentFilterRuleLogical{Operator: AssignmentFilterLogicalAnd, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *assignmentFilterRuleParser) parsePrimary() (AssignmentFilterRuleNode, error) {
+	token := p.peek()
+
+	if token.kind == filterTokenLeftParen {
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != filterTokenRightParen {
+			return nil, &AssignmentFilterRuleSyntaxError{Position: closing.position, Message: fmt.Sprintf("expected \")\" to close \"(\" at position %d, found %s", token.position, closing)}
+		}
+		return node, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *assignmentFilterRuleParser) parseComparison() (AssignmentFilterRuleNode, error) {
+	property := p.next()
+	if property.kind != filterTokenWord {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: property.position, Message: fmt.Sprintf("expected a property such as device.osVersion, found %s", property)}
+	}
+
+	entity, name, found := strings.Cut(property.text, ".")
+	if !found || name == "" || strings.Contains(name, ".") {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: property.position, Message: fmt.Sprintf("property %q must be of the form device.<property> or app.<property>", property.text)}
+	}
+	entity = strings.ToLower(entity)
+	if entity != "device" && entity != "app" {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: property.position, Message: fmt.Sprintf("unknown entity %q, expected device or app", entity)}
+	}
+
+	operator := p.next()
+	if operator.kind != filterTokenOperator {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: operator.position, Message: fmt.Sprintf("expected an operator such as -eq after %s, found %s", property.text, operator)}
+	}
+
+	comparison := &AssignmentFilterRuleComparison{
+		Entity:   entity,
+		Property: canonicalAssignmentFilterProperty(entity, name),
+		Operator: operator.text,
+		Position: property.position,
+	}
+
+	if operator.text == AssignmentFilterOperatorIn || operator.text == AssignmentFilterOperatorNotIn {
+		values, err := p.parseList(operator)
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = values
+		return comparison, nil
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Values = []string{value}
+
+	return comparison, nil
+}
+
+func (p *assignmentFilterRuleParser) parseList(operator filterToken) ([]string, error) {
+	open := p.next()
+	if open.kind != filterTokenLeftBracket {
+		return nil, &AssignmentFilterRuleSyntaxError{Position: open.position, Message: fmt.Sprintf("expected \"[\" to start the value list of %s, found %s", operator.text, open)}
+	}
+
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		separator := p.next()
+		if separator.kind == filterTokenRightBracket {
+			return values, nil
+		}
+		if separator.kind != filterTokenComma {
+			return nil, &AssignmentFilterRuleSyntaxError{Position: separator.position, Message: fmt.Sprintf("expected \",\" or \"]\" in value list, found %s", separator)}
+		}
+	}
+}
+
+// parseValue accepts a quoted string or a bare word such as True or a version number.
+func (p *assignmentFilterRuleParser) parseValue() (string, error) {
+	value := p.next()
+	if value.kind != filterTokenString && value.kind != filterTokenWord {
+		return "", &AssignmentFilterRuleSyntaxError{Position: value.position, Message: fmt.Sprintf("expected a value, found %s", value)}
+	}
+
+	return value.text, nil
+}
+
+// canonicalAssignmentFilterProperty returns the documented spelling of a property name, matched
+// case-insensitively across all platforms, or the name unchanged when it is unknown.
+func canonicalAssignmentFilterProperty(entity, name string) string {
+	for _, platformProperties := range assignmentFilterPropertiesByPlatform {
+		if platformProperties.entity != entity {
+			continue
+		}
+		for property := range platformProperties.properties {
+			if strings.EqualFold(property, name) {
+				return property
+			}
+		}
+	}
+
+	return name
+}
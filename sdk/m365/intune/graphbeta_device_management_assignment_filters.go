@@ -16,7 +16,9 @@ import (
 
 const (
 	uriBetaDeviceManagementAssignmentFilters   = "/beta/deviceManagement/assignmentFilters"
+	uriBetaEvaluateAssignmentFilter            = "/beta/deviceManagement/evaluateAssignmentFilter"
 	odataTypeDeviceManagementAssignmentFilters = "#microsoft.graph.deviceAndAppManagementAssignmentFilter"
+	odataTypeAssignmentFilterEvaluateRequest   = "#microsoft.graph.assignmentFilterEvaluateRequest"
 )
 
 // ResponseAssignmentFiltersList represents a list of Assignment Filters.
@@ -69,6 +71,55 @@ type ResourceDeviceManagementAssignmentFilterPayload struct {
 	AssignmentFilterType string `json:"assignmentFilterType"`
 }
 
+// ResponseAssignmentFilterValidation represents the result of the validateFilter action.
+type ResponseAssignmentFilterValidation struct {
+	ODataContext string `json:"@odata.context"`
+	IsValidRule  bool   `json:"isValidRule"`
+}
+
+// ResponseAssignmentFilterState represents whether assignment filters are enabled for the tenant.
+type ResponseAssignmentFilterState struct {
+	ODataContext string `json:"@odata.context"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// ResponseAssignmentFilterSupportedPropertiesList represents the filter properties supported on a platform.
+type ResponseAssignmentFilterSupportedPropertiesList struct {
+	ODataContext string                              `json:"@odata.context"`
+	Value        []AssignmentFilterSupportedProperty `json:"value"`
+}
+
+// AssignmentFilterSupportedProperty represents a property that can be used in a filter rule on a platform.
+type AssignmentFilterSupportedProperty struct {
+	Name                    string   `json:"name"`
+	DataType                string   `json:"dataType"`
+	IsCollection            bool     `json:"isCollection"`
+	PropertyRegexConstraint string   `json:"propertyRegexConstraint"`
+	SupportedOperators      []string `json:"supportedOperators"`
+	SupportedValues         []string `json:"supportedValues"`
+}
+
+// AssignmentFilterEvaluateRequest represents a request to preview the devices a filter rule includes.
+type AssignmentFilterEvaluateRequest struct {
+	ODataType string   `json:"@odata.type"`
+	Platform  string   `json:"platform"`
+	Rule      string   `json:"rule"`
+	Top       int      `json:"top,omitempty"`
+	Skip      int      `json:"skip,omitempty"`
+	OrderBy   []string `json:"orderBy,omitempty"`
+	Search    string   `json:"search,omitempty"`
+}
+
+// AssignmentFilterInUseError is returned when deleting a filter that policies or apps still reference.
+type AssignmentFilterInUseError struct {
+	FilterID string
+	Payloads []ResponseAssignmentFilterPayload
+}
+
+func (e *AssignmentFilterInUseError) Error() string {
+	return fmt.Sprintf("assignment filter %s is referenced by %d assignment(s)", e.FilterID, len(e.Payloads))
+}
+
 // GetDeviceManagementAssignmentFilters gets a list of all Intune Assignment Filters.
 func (c *Client) GetDeviceManagementAssignmentFilters() (*ResponseAssignmentFiltersList, error) {
 	endpoint := uriBetaDeviceManagementAssignmentFilters
@@ -232,3 +283,141 @@ func (c *Client) DeleteDeviceManagementAssignmentFilterByDisplayName(displayName
 	// Delete the filter by its ID
 	return c.DeleteDeviceManagementAssignmentFilterByID(filterID)
 }
+
+// DeleteDeviceManagementAssignmentFilterByIDIfUnreferenced deletes an Assignment Filter by its ID only if no
+// assignment references it; otherwise an *AssignmentFilterInUseError listing the referencing payloads is returned.
+func (c *Client) DeleteDeviceManagementAssignmentFilterByIDIfUnreferenced(filterID string) error {
+	payloads, err := c.GetDeviceManagementAssignmentFilterPayloads(filterID)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "assignment filter", filterID, err)
+	}
+	if len(payloads) > 0 {
+		return &AssignmentFilterInUseError{FilterID: filterID, Payloads: payloads}
+	}
+
+	return c.DeleteDeviceManagementAssignmentFilterByID(filterID)
+}
+
+// DeleteDeviceManagementAssignmentFilterByDisplayNameIfUnreferenced deletes an Assignment Filter by its display
+// name only if no assignment references it, as for DeleteDeviceManagementAssignmentFilterByIDIfUnreferenced.
+func (c *Client) DeleteDeviceManagementAssignmentFilterByDisplayNameIfUnreferenced(displayName string) error {
+	// Retrieve all assignment filters
+	filtersList, err := c.GetDeviceManagementAssignmentFilters()
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedGet, "assignment filters", err)
+	}
+
+	// Search for the filter with the matching display name
+	var filterID string
+	for _, filter := range filtersList.Value {
+		if filter.DisplayName == displayName {
+			filterID = filter.ID
+			break
+		}
+	}
+
+	if filterID == "" {
+		return fmt.Errorf(shared.ErrorMsgFailedGetByName, "assignment filter", displayName, "Filter not found")
+	}
+
+	return c.DeleteDeviceManagementAssignmentFilterByIDIfUnreferenced(filterID)
+}
+
+// ValidateDeviceManagementAssignmentFilter asks Intune whether the rule of an Assignment Filter is valid for its
+// platform without creating the filter.
+func (c *Client) ValidateDeviceManagementAssignmentFilter(request *ResourceDeviceManagementAssignmentFilter) (*ResponseAssignmentFilterValidation, error) {
+	// Set graph metadata values
+	request.ODataType = odataTypeDeviceManagementAssignmentFilters
+
+	endpoint := uriBetaDeviceManagementAssignmentFilters + "/validateFilter"
+
+	requestBody := map[string]*ResourceDeviceManagementAssignmentFilter{
+		"deviceAndAppManagementAssignmentFilter": request,
+	}
+
+	var validation ResponseAssignmentFilterValidation
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, &validation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate assignment filter, error: %v", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &validation, nil
+}
+
+// GetDeviceManagementAssignmentFilterState retrieves whether assignment filters are enabled for the tenant.
+func (c *Client) GetDeviceManagementAssignmentFilterState() (*ResponseAssignmentFilterState, error) {
+	endpoint := uriBetaDeviceManagementAssignmentFilters + "/getState"
+
+	var state ResponseAssignmentFilterState
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &state)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "assignment filter state", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &state, nil
+}
+
+// GetDeviceManagementAssignmentFilterSupportedProperties retrieves the properties, operators and values that
+// Intune supports in filter rules for a platform.
+func (c *Client) GetDeviceManagementAssignmentFilterSupportedProperties(platform string) (*ResponseAssignmentFilterSupportedPropertiesList, error) {
+	endpoint := fmt.Sprintf("%s/getPlatformSupportedProperties(platform='%s')", uriBetaDeviceManagementAssignmentFilters, platform)
+
+	var properties ResponseAssignmentFilterSupportedPropertiesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &properties)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "assignment filter supported properties", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &properties, nil
+}
+
+// PreviewDeviceManagementAssignmentFilter evaluates a filter rule against the tenant's devices and returns the
+// devices it would include as a report stream. Top and Skip page through the matching devices.
+func (c *Client) PreviewDeviceManagementAssignmentFilter(request *AssignmentFilterEvaluateRequest) (*ResponseReportStream, error) {
+	// Set graph metadata values
+	request.ODataType = odataTypeAssignmentFilterEvaluateRequest
+
+	requestBody := map[string]*AssignmentFilterEvaluateRequest{
+		"data": request,
+	}
+
+	return c.postReportStream(uriBetaEvaluateAssignmentFilter, requestBody, "assignment filter preview")
+}
+
+// PreviewDeviceManagementAssignmentFilterByID previews the devices included by an existing Assignment Filter.
+func (c *Client) PreviewDeviceManagementAssignmentFilterByID(filterID string, top, skip int) (*ResponseReportStream, error) {
+	filter, err := c.GetDeviceManagementAssignmentFilterByID(filterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.PreviewDeviceManagementAssignmentFilter(&AssignmentFilterEvaluateRequest{
+		Platform: filter.Platform,
+		Rule:     filter.Rule,
+		Top:      top,
+		Skip:     skip,
+	})
+}
+
+// GetDeviceManagementAssignmentFilterPayloads retrieves the assignments (policies and apps) that reference an
+// Assignment Filter.
+func (c *Client) GetDeviceManagementAssignmentFilterPayloads(filterID string) ([]ResponseAssignmentFilterPayload, error) {
+	filter, err := c.GetDeviceManagementAssignmentFilterByID(filterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.Payloads, nil
+}
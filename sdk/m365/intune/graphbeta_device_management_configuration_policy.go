@@ -20,9 +20,10 @@ const uriBetaDeviceManagementConfigurationPolicies = "/beta/deviceManagement/con
 
 // ResourceDeviceManagementConfigurationPoliciesList represents the response structure for configuration policies.
 type ResponseDeviceManagementConfigurationPoliciesList struct {
-	ODataContext string                                        `json:"@odata.context"`
-	ODataCount   int                                           `json:"@odata.count"`
-	Value        []ResourceDeviceManagementConfigurationPolicy `json:"value"`
+	ODataContext  string                                        `json:"@odata.context"`
+	ODataCount    int                                           `json:"@odata.count"`
+	ODataNextLink string                                        `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceDeviceManagementConfigurationPolicy `json:"value"`
 }
 
 // ResourceDeviceManagementConfigurationPolicy represents a device management configuration policy.
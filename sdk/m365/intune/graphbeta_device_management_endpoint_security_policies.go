@@ -0,0 +1,336 @@
+// graphbeta_device_management_endpoint_security_policies.go
+// Graph Beta Api - Intune: Endpoint security policies (settings catalog)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/endpoint-security-policy
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Workflows/SecurityManagementMenu/~/overview
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfigv2-devicemanagementconfigurationpolicytemplate?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfigv2-devicemanagementconfigurationsettingtemplate?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// Endpoint security policies and security baselines created in the settings catalog are configuration
+// policies whose template reference names an endpoint security template family. Each setting of such a
+// policy must reference the setting template it instantiates. Policies created before the settings catalog
+// are device management intents; see graphbeta_device_management_intents.go. The per-setting state of a
+// settings catalog policy is read with GetConfigurationPolicySettingStatuses.
+
+package intune
+
+import (
+	"fmt"
+	"net/url"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceManagementConfigurationPolicyTemplates = "/beta/deviceManagement/configurationPolicyTemplates"
+	odataTypeConfigurationPolicyTemplateReference       = "#microsoft.graph.deviceManagementConfigurationPolicyTemplateReference"
+
+	TemplateFamilyEndpointSecurityAntivirus                    = "endpointSecurityAntivirus"
+	TemplateFamilyEndpointSecurityDiskEncryption               = "endpointSecurityDiskEncryption"
+	TemplateFamilyEndpointSecurityFirewall                     = "endpointSecurityFirewall"
+	TemplateFamilyEndpointSecurityEndpointDetectionAndResponse = "endpointSecurityEndpointDetectionAndResponse"
+	TemplateFamilyEndpointSecurityAttackSurfaceReduction       = "endpointSecurityAttackSurfaceReduction"
+	TemplateFamilyEndpointSecurityAccountProtection            = "endpointSecurityAccountProtection"
+	TemplateFamilyEndpointSecurityApplicationControl           = "endpointSecurityApplicationControl"
+	TemplateFamilyEndpointSecurityEndpointPrivilegeManagement  = "endpointSecurityEndpointPrivilegeManagement"
+	TemplateFamilyBaseline                                     = "baseline"
+
+	ConfigurationPolicyTemplateLifecycleStateActive     = "active"
+	ConfigurationPolicyTemplateLifecycleStateSuperseded = "superseded"
+	ConfigurationPolicyTemplateLifecycleStateDeprecated = "deprecated"
+	ConfigurationPolicyTemplateLifecycleStateRetired    = "retired"
+)
+
+// ResponseConfigurationPolicyTemplatesList represents a list of configuration policy templates.
+type ResponseConfigurationPolicyTemplatesList struct {
+	ODataContext  string                                `json:"@odata.context"`
+	ODataNextLink string                                `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceConfigurationPolicyTemplate `json:"value"`
+}
+
+// ResourceConfigurationPolicyTemplate represents a settings catalog template, such as the Microsoft Defender
+// Antivirus template of the endpointSecurityAntivirus family. The ID is the base ID followed by the version,
+// e.g. 804339ad-1553-4478-a742-138fb5807418_1.
+type ResourceConfigurationPolicyTemplate struct {
+	ODataContext           string `json:"@odata.context,omitempty"`
+	ID                     string `json:"id"`
+	BaseID                 string `json:"baseId"`
+	Version                int    `json:"version"`
+	DisplayName            string `json:"displayName"`
+	Description            string `json:"description"`
+	DisplayVersion         string `json:"displayVersion"`
+	LifecycleState         string `json:"lifecycleState"`
+	Platforms              string `json:"platforms"`
+	Technologies           string `json:"technologies"`
+	TemplateFamily         string `json:"templateFamily"`
+	AllowUnmanagedSettings bool   `json:"allowUnmanagedSettings"`
+	SettingTemplateCount   int    `json:"settingTemplateCount"`
+}
+
+// ResponseConfigurationPolicySettingTemplatesList represents the setting templates of a configuration policy template.
+type ResponseConfigurationPolicySettingTemplatesList struct {
+	ODataContext  string                               `json:"@odata.context"`
+	ODataNextLink string                               `json:"@odata.nextLink,omitempty"`
+	Value         []ConfigurationPolicySettingTemplate `json:"value"`
+}
+
+// ConfigurationPolicySettingTemplate represents a setting that a policy created from a template can configure.
+type ConfigurationPolicySettingTemplate struct {
+	ID                      string                                     `json:"id"`
+	SettingInstanceTemplate ConfigurationPolicySettingInstanceTemplate `json:"settingInstanceTemplate"`
+}
+
+// ConfigurationPolicySettingInstanceTemplate identifies the setting definition a setting template is for and
+// the template IDs that a setting instance and its value must reference.
+type ConfigurationPolicySettingInstanceTemplate struct {
+	ODataType                  string                                   `json:"@odata.type"`
+	SettingInstanceTemplateID  string                                   `json:"settingInstanceTemplateId"`
+	SettingDefinitionID        string                                   `json:"settingDefinitionId"`
+	IsRequired                 bool                                     `json:"isRequired"`
+	ChoiceSettingValueTemplate *ConfigurationPolicySettingValueTemplate `json:"choiceSettingValueTemplate,omitempty"`
+	SimpleSettingValueTemplate *ConfigurationPolicySettingValueTemplate `json:"simpleSettingValueTemplate,omitempty"`
+}
+
+// ConfigurationPolicySettingValueTemplate identifies the template of a setting value.
+type ConfigurationPolicySettingValueTemplate struct {
+	ODataType              string `json:"@odata.type,omitempty"`
+	SettingValueTemplateID string `json:"settingValueTemplateId"`
+}
+
+// GetEndpointSecurityPolicies retrieves the settings catalog policies of a template family, such as
+// TemplateFamilyEndpointSecurityAntivirus or TemplateFamilyBaseline.
+func (c *Client) GetEndpointSecurityPolicies(templateFamily string) (*ResponseDeviceManagementConfigurationPoliciesList, error) {
+	endpoint := fmt.Sprintf("%s?$filter=%s", uriBetaDeviceManagementConfigurationPolicies,
+		url.QueryEscape(fmt.Sprintf("templateReference/templateFamily eq '%s'", odataEscapeString(templateFamily))))
+
+	var policies ResponseDeviceManagementConfigurationPoliciesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementConfigurationPoliciesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "endpoint security policies", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if policies.ODataContext == "" {
+			policies.ODataContext = page.ODataContext
+		}
+		policies.Value = append(policies.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "endpoint security policies", err)
+			}
+		}
+	}
+	policies.ODataCount = len(policies.Value)
+
+	return &policies, nil
+}
+
+// GetEndpointSecurityPolicyByName retrieves a settings catalog policy of a template family by its name, with
+// its settings.
+func (c *Client) GetEndpointSecurityPolicyByName(templateFamily, policyName string) (*ResourceDeviceManagementConfigurationPolicy, error) {
+	policies, err := c.GetEndpointSecurityPolicies(templateFamily)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "endpoint security policies", err)
+	}
+
+	var policyID string
+	for _, policy := range policies.Value {
+		if policy.Name == policyName {
+			policyID = policy.ID
+			break
+		}
+	}
+
+	if policyID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "endpoint security policy", policyName, "policy not found")
+	}
+
+	return c.GetDeviceManagementConfigurationPolicyByID(policyID)
+}
+
+// GetConfigurationPolicyTemplates retrieves the active settings catalog templates of a template family.
+func (c *Client) GetConfigurationPolicyTemplates(templateFamily string) (*ResponseConfigurationPolicyTemplatesList, error) {
+	filter := fmt.Sprintf("templateFamily eq '%s' and lifecycleState eq '%s'", odataEscapeString(templateFamily), ConfigurationPolicyTemplateLifecycleStateActive)
+	endpoint := fmt.Sprintf("%s?$filter=%s", uriBetaDeviceManagementConfigurationPolicyTemplates, url.QueryEscape(filter))
+
+	var templates ResponseConfigurationPolicyTemplatesList
+	for endpoint != "" {
+		var page ResponseConfigurationPolicyTemplatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "configuration policy templates", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if templates.ODataContext == "" {
+			templates.ODataContext = page.ODataContext
+		}
+		templates.Value = append(templates.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "configuration policy templates", err)
+			}
+		}
+	}
+
+	return &templates, nil
+}
+
+// GetConfigurationPolicyTemplateByID retrieves a settings catalog template by its ID.
+func (c *Client) GetConfigurationPolicyTemplateByID(templateID string) (*ResourceConfigurationPolicyTemplate, error) {
+	endpoint := fmt.Sprintf("%s('%s')", uriBetaDeviceManagementConfigurationPolicyTemplates, templateID)
+
+	var template ResourceConfigurationPolicyTemplate
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &template)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "configuration policy template", templateID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &template, nil
+}
+
+// GetConfigurationPolicySettingTemplates retrieves the setting templates of a settings catalog template.
+func (c *Client) GetConfigurationPolicySettingTemplates(templateID string) (*ResponseConfigurationPolicySettingTemplatesList, error) {
+	endpoint := fmt.Sprintf("%s('%s')/settingTemplates", uriBetaDeviceManagementConfigurationPolicyTemplates, templateID)
+
+	var settingTemplates ResponseConfigurationPolicySettingTemplatesList
+	for endpoint != "" {
+		var page ResponseConfigurationPolicySettingTemplatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "configuration policy setting templates", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if settingTemplates.ODataContext == "" {
+			settingTemplates.ODataContext = page.ODataContext
+		}
+		settingTemplates.Value = append(settingTemplates.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "configuration policy setting templates", err)
+			}
+		}
+	}
+
+	return &settingTemplates, nil
+}
+
+// applyConfigurationPolicyTemplate points a policy at a template, taking its platforms and technologies, and
+// fills in the setting instance and value template references of the top level settings that do not carry
+// them. Settings whose definition is not part of the template are rejected.
+func applyConfigurationPolicyTemplate(policy *ResourceDeviceManagementConfigurationPolicy, template *ResourceConfigurationPolicyTemplate, settingTemplates []ConfigurationPolicySettingTemplate) error {
+	policy.Platforms = template.Platforms
+	policy.Technologies = template.Technologies
+	policy.TemplateReference = DeviceManagementConfigurationPolicySubsetTemplateReference{
+		OdataType:      odataTypeConfigurationPolicyTemplateReference,
+		TemplateId:     template.ID,
+		TemplateFamily: template.TemplateFamily,
+	}
+
+	instanceTemplates := make(map[string]ConfigurationPolicySettingInstanceTemplate, len(settingTemplates))
+	for _, settingTemplate := range settingTemplates {
+		instanceTemplates[settingTemplate.SettingInstanceTemplate.SettingDefinitionID] = settingTemplate.SettingInstanceTemplate
+	}
+
+	for i := range policy.Settings {
+		instance := &policy.Settings[i].SettingInstance
+		instanceTemplate, ok := instanceTemplates[instance.SettingDefinitionId]
+		if !ok {
+			return fmt.Errorf("setting %s is not part of template %s", instance.SettingDefinitionId, template.DisplayName)
+		}
+
+		if instance.SettingInstanceTemplateReference == nil {
+			instance.SettingInstanceTemplateReference = &DeviceManagementConfigurationSubsetSettingInstanceReference{
+				SettingInstanceTemplateId: instanceTemplate.SettingInstanceTemplateID,
+			}
+		}
+		if instance.ChoiceSettingValue != nil && instance.ChoiceSettingValue.SettingValueTemplateReference == nil && instanceTemplate.ChoiceSettingValueTemplate != nil {
+			instance.ChoiceSettingValue.SettingValueTemplateReference = &DeviceManagementSettingValueTemplateReference{
+				SettingValueTemplateId: instanceTemplate.ChoiceSettingValueTemplate.SettingValueTemplateID,
+			}
+		}
+		if instance.SimpleSettingValue != nil && instance.SimpleSettingValue.SettingValueTemplateReference == nil && instanceTemplate.SimpleSettingValueTemplate != nil {
+			instance.SimpleSettingValue.SettingValueTemplateReference = &DeviceManagementSettingValueTemplateReference{
+				SettingValueTemplateId: instanceTemplate.SimpleSettingValueTemplate.SettingValueTemplateID,
+			}
+		}
+	}
+
+	return nil
+}
+
+// instantiateConfigurationPolicyTemplate retrieves a template and its setting templates and applies them to
+// a policy.
+func (c *Client) instantiateConfigurationPolicyTemplate(templateID string, policy *ResourceDeviceManagementConfigurationPolicy) error {
+	template, err := c.GetConfigurationPolicyTemplateByID(templateID)
+	if err != nil {
+		return err
+	}
+
+	settingTemplates, err := c.GetConfigurationPolicySettingTemplates(templateID)
+	if err != nil {
+		return err
+	}
+
+	return applyConfigurationPolicyTemplate(policy, template, settingTemplates.Value)
+}
+
+// CreateEndpointSecurityPolicyFromTemplate creates a settings catalog policy from an endpoint security or
+// security baseline template. The template sets the platforms, technologies and template reference of the
+// policy, and the template references of its top level settings are filled in from the setting templates.
+func (c *Client) CreateEndpointSecurityPolicyFromTemplate(templateID string, request *ResourceDeviceManagementConfigurationPolicy) (*ResourceDeviceManagementConfigurationPolicy, error) {
+	if err := c.instantiateConfigurationPolicyTemplate(templateID, request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "endpoint security policy", err)
+	}
+
+	return c.CreateDeviceManagementConfigurationPolicy(request)
+}
+
+// ReplaceEndpointSecurityPolicyByID replaces the settings, name and description of a settings catalog policy
+// created from a template using the PUT method. The policy keeps its template; UpdateDeviceManagementConfigurationPolicyByID
+// cannot change settings, as they are a navigation property.
+func (c *Client) ReplaceEndpointSecurityPolicyByID(policyID string, request *ResourceDeviceManagementConfigurationPolicy) error {
+	existingPolicy, err := c.GetDeviceManagementConfigurationPolicyByID(policyID)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "endpoint security policy", policyID, err)
+	}
+
+	if err := c.instantiateConfigurationPolicyTemplate(existingPolicy.TemplateReference.TemplateId, request); err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "endpoint security policy", policyID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s('%s')", uriBetaDeviceManagementConfigurationPolicies, policyID)
+
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, request, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "endpoint security policy", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
@@ -13,4 +13,6 @@ const (
 	odataTypeMacOSCustomConfigurationProfile           = "#microsoft.graph.macOSCustomConfiguration"
 	odataTypeIOSCustomConfigurationProfile             = "#microsoft.graph.iosCustomConfiguration"
 	odataTypeIOSTemplateConfigurationProfile           = "#microsoft.graph.iosGeneralDeviceConfiguration"
+	ODataTypeDepIOSEnrollmentProfile                   = "#microsoft.graph.depIOSEnrollmentProfile"
+	ODataTypeDepMacOSEnrollmentProfile                 = "#microsoft.graph.depMacOSEnrollmentProfile"
 )
@@ -0,0 +1,671 @@
+// graphbeta_device_management_intents.go
+// Graph Beta Api - Intune: Endpoint security and security baseline intents (legacy templates)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/security-baselines
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Workflows/SecurityManagementMenu/~/overview
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceintent-devicemanagementintent?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceintent-devicemanagementtemplate?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// Intents are endpoint security policies and security baselines created from the templates that preceded the
+// settings catalog. Their settings are instances with a JSON encoded value, they can be migrated to newer
+// versions of their template, and their state is summarised per setting.
+
+package intune
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceManagementIntents   = "/beta/deviceManagement/intents"
+	uriBetaDeviceManagementTemplates = "/beta/deviceManagement/templates"
+
+	ODataTypeDeviceManagementBooleanSettingInstance    = "#microsoft.graph.deviceManagementBooleanSettingInstance"
+	ODataTypeDeviceManagementIntegerSettingInstance    = "#microsoft.graph.deviceManagementIntegerSettingInstance"
+	ODataTypeDeviceManagementStringSettingInstance     = "#microsoft.graph.deviceManagementStringSettingInstance"
+	ODataTypeDeviceManagementCollectionSettingInstance = "#microsoft.graph.deviceManagementCollectionSettingInstance"
+	ODataTypeDeviceManagementComplexSettingInstance    = "#microsoft.graph.deviceManagementComplexSettingInstance"
+
+	DeviceManagementTemplateTypeSecurityBaseline                          = "securityBaseline"
+	DeviceManagementTemplateTypeAdvancedThreatProtectionSecurityBaseline  = "advancedThreatProtectionSecurityBaseline"
+	DeviceManagementTemplateTypeMicrosoftEdgeSecurityBaseline             = "microsoftEdgeSecurityBaseline"
+	DeviceManagementTemplateTypeMicrosoftOffice365ProPlusSecurityBaseline = "microsoftOffice365ProPlusSecurityBaseline"
+	DeviceManagementTemplateTypeSecurityTemplate                          = "securityTemplate"
+	DeviceManagementTemplateTypeCloudPC                                   = "cloudPC"
+
+	// Subtypes of securityTemplate templates, one per endpoint security area
+	DeviceManagementTemplateSubtypeAntivirus                = "antivirus"
+	DeviceManagementTemplateSubtypeDiskEncryption           = "diskEncryption"
+	DeviceManagementTemplateSubtypeFirewall                 = "firewall"
+	DeviceManagementTemplateSubtypeEndpointDetectionReponse = "endpointDetectionReponse" // sic, as spelled by Graph
+	DeviceManagementTemplateSubtypeAttackSurfaceReduction   = "attackSurfaceReduction"
+	DeviceManagementTemplateSubtypeAccountProtection        = "accountProtection"
+
+	DeviceManagementComparisonResultEqual    = "equal"
+	DeviceManagementComparisonResultNotEqual = "notEqual"
+	DeviceManagementComparisonResultAdded    = "added"
+	DeviceManagementComparisonResultRemoved  = "removed"
+)
+
+// ResponseDeviceManagementIntentsList represents a list of device management intents.
+type ResponseDeviceManagementIntentsList struct {
+	ODataContext  string                           `json:"@odata.context"`
+	ODataNextLink string                           `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceDeviceManagementIntent `json:"value"`
+}
+
+// ResourceDeviceManagementIntent represents an endpoint security policy or security baseline created from a
+// legacy template.
+type ResourceDeviceManagementIntent struct {
+	ODataContext                     string     `json:"@odata.context,omitempty"`
+	ID                               string     `json:"id"`
+	DisplayName                      string     `json:"displayName"`
+	Description                      string     `json:"description"`
+	IsAssigned                       bool       `json:"isAssigned"`
+	IsMigratingToConfigurationPolicy bool       `json:"isMigratingToConfigurationPolicy"`
+	LastModifiedDateTime             *time.Time `json:"lastModifiedDateTime,omitempty"`
+	TemplateID                       string     `json:"templateId"`
+	RoleScopeTagIds                  []string   `json:"roleScopeTagIds"`
+}
+
+// ResponseDeviceManagementTemplatesList represents a list of legacy device management templates.
+type ResponseDeviceManagementTemplatesList struct {
+	ODataContext  string                             `json:"@odata.context"`
+	ODataNextLink string                             `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceDeviceManagementTemplate `json:"value"`
+}
+
+// ResourceDeviceManagementTemplate represents a legacy endpoint security or security baseline template.
+type ResourceDeviceManagementTemplate struct {
+	ID                string     `json:"id"`
+	DisplayName       string     `json:"displayName"`
+	Description       string     `json:"description"`
+	VersionInfo       string     `json:"versionInfo"`
+	IsDeprecated      bool       `json:"isDeprecated"`
+	IntentCount       int        `json:"intentCount"`
+	TemplateType      string     `json:"templateType"`
+	TemplateSubtype   string     `json:"templateSubtype"`
+	PlatformType      string     `json:"platformType"`
+	PublishedDateTime *time.Time `json:"publishedDateTime,omitempty"`
+}
+
+// ResponseDeviceManagementSettingInstancesList represents the settings of an intent.
+type ResponseDeviceManagementSettingInstancesList struct {
+	ODataContext  string                            `json:"@odata.context"`
+	ODataNextLink string                            `json:"@odata.nextLink,omitempty"`
+	Value         []DeviceManagementSettingInstance `json:"value"`
+}
+
+// DeviceManagementSettingInstance represents a setting of an intent. ValueJSON holds the value JSON encoded,
+// e.g. true or "text".
+type DeviceManagementSettingInstance struct {
+	ODataType    string `json:"@odata.type"`
+	ID           string `json:"id,omitempty"`
+	DefinitionID string `json:"definitionId"`
+	ValueJSON    string `json:"valueJson"`
+}
+
+// CreateInstanceDeviceManagementTemplate represents the request body of the template createInstance action.
+// SettingsDelta holds the settings that differ from the template defaults.
+type CreateInstanceDeviceManagementTemplate struct {
+	DisplayName     string                            `json:"displayName"`
+	Description     string                            `json:"description,omitempty"`
+	SettingsDelta   []DeviceManagementSettingInstance `json:"settingsDelta"`
+	RoleScopeTagIds []string                          `json:"roleScopeTagIds,omitempty"`
+}
+
+// ResponseDeviceManagementSettingComparisonsList represents the differences between the settings of an intent
+// and another version of its template.
+type ResponseDeviceManagementSettingComparisonsList struct {
+	ODataContext string                              `json:"@odata.context"`
+	Value        []DeviceManagementSettingComparison `json:"value"`
+}
+
+// DeviceManagementSettingComparison represents how a setting of an intent would change when migrated.
+type DeviceManagementSettingComparison struct {
+	ID               string `json:"id"`
+	DisplayName      string `json:"displayName"`
+	DefinitionID     string `json:"definitionId"`
+	CurrentValueJSON string `json:"currentValueJson"`
+	NewValueJSON     string `json:"newValueJson"`
+	ComparisonResult string `json:"comparisonResult"`
+}
+
+// ResponseDeviceManagementIntentAssignmentsList represents the assignments of an intent.
+type ResponseDeviceManagementIntentAssignmentsList struct {
+	ODataContext string                             `json:"@odata.context"`
+	Value        []DeviceManagementIntentAssignment `json:"value"`
+}
+
+// AssignmentDeviceManagementIntent represents the request body of the intent assign action.
+type AssignmentDeviceManagementIntent struct {
+	Assignments []DeviceManagementIntentAssignment `json:"assignments"`
+}
+
+// DeviceManagementIntentAssignment represents the assignment of an intent to a group.
+type DeviceManagementIntentAssignment struct {
+	ID     string                                 `json:"id,omitempty"`
+	Target DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// ResponseDeviceManagementIntentDeviceStateSummary represents the number of devices an intent is in each state on.
+type ResponseDeviceManagementIntentDeviceStateSummary struct {
+	ODataContext               string `json:"@odata.context"`
+	ID                         string `json:"id"`
+	ConflictCount              int    `json:"conflictCount"`
+	ErrorCount                 int    `json:"errorCount"`
+	FailedCount                int    `json:"failedCount"`
+	NotApplicableCount         int    `json:"notApplicableCount"`
+	NotApplicablePlatformCount int    `json:"notApplicablePlatformCount"`
+	SuccessCount               int    `json:"successCount"`
+}
+
+// ResponseDeviceManagementIntentSettingStateSummariesList represents the per-setting state of an intent.
+type ResponseDeviceManagementIntentSettingStateSummariesList struct {
+	ODataContext  string                                      `json:"@odata.context"`
+	ODataNextLink string                                      `json:"@odata.nextLink,omitempty"`
+	Value         []DeviceManagementIntentSettingStateSummary `json:"value"`
+}
+
+// DeviceManagementIntentSettingStateSummary represents the number of devices a setting of an intent is in
+// each state on.
+type DeviceManagementIntentSettingStateSummary struct {
+	ID                 string `json:"id"`
+	SettingName        string `json:"settingName"`
+	CompliantCount     int    `json:"compliantCount"`
+	ConflictCount      int    `json:"conflictCount"`
+	ErrorCount         int    `json:"errorCount"`
+	NonCompliantCount  int    `json:"nonCompliantCount"`
+	NotApplicableCount int    `json:"notApplicableCount"`
+	RemediatedCount    int    `json:"remediatedCount"`
+}
+
+// NewDeviceManagementSettingInstance returns an intent setting with its value JSON encoded. Booleans, strings
+// and integers of any size select the matching setting instance type, as do floats with a whole number value.
+// Slices and arrays are encoded as collection settings and maps and structs as complex settings; nil values and
+// other kinds are rejected, as the service cannot store them.
+func NewDeviceManagementSettingInstance(definitionID string, value interface{}) (DeviceManagementSettingInstance, error) {
+	settingValue := reflect.ValueOf(value)
+	for settingValue.Kind() == reflect.Ptr || settingValue.Kind() == reflect.Interface {
+		if settingValue.IsNil() {
+			break
+		}
+		settingValue = settingValue.Elem()
+	}
+
+	var odataType string
+	switch settingValue.Kind() {
+	case reflect.Bool:
+		odataType = ODataTypeDeviceManagementBooleanSettingInstance
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		odataType = ODataTypeDeviceManagementIntegerSettingInstance
+	case reflect.Float32, reflect.Float64:
+		number := settingValue.Float()
+		if number != math.Trunc(number) || number < math.MinInt64 || number >= math.MaxInt64 {
+			return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: %v is not an integer", definitionID, number)
+		}
+		odataType = ODataTypeDeviceManagementIntegerSettingInstance
+		value = int64(number)
+	case reflect.String:
+		odataType = ODataTypeDeviceManagementStringSettingInstance
+	case reflect.Slice, reflect.Array:
+		if settingValue.Kind() == reflect.Slice && settingValue.IsNil() {
+			return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: collection value is nil", definitionID)
+		}
+		if settingValue.Type().Elem().Kind() == reflect.Uint8 {
+			return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: unsupported value type %T", definitionID, value)
+		}
+		odataType = ODataTypeDeviceManagementCollectionSettingInstance
+	case reflect.Map, reflect.Struct:
+		if settingValue.Kind() == reflect.Map && settingValue.IsNil() {
+			return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: complex value is nil", definitionID)
+		}
+		odataType = ODataTypeDeviceManagementComplexSettingInstance
+	case reflect.Invalid, reflect.Ptr, reflect.Interface:
+		return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: value is nil", definitionID)
+	default:
+		return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: unsupported value type %T", definitionID, value)
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return DeviceManagementSettingInstance{}, fmt.Errorf(shared.ErrorMsgFailedJsonMarshal, "setting value of "+definitionID, err)
+	}
+
+	// Types with their own JSON encoding, e.g. time.Time, may not encode as the object or array their kind implies
+	if (odataType == ODataTypeDeviceManagementComplexSettingInstance && valueJSON[0] != '{') ||
+		(odataType == ODataTypeDeviceManagementCollectionSettingInstance && valueJSON[0] != '[') {
+		return DeviceManagementSettingInstance{}, fmt.Errorf("setting %s: unsupported value type %T", definitionID, value)
+	}
+
+	return DeviceManagementSettingInstance{
+		ODataType:    odataType,
+		DefinitionID: definitionID,
+		ValueJSON:    string(valueJSON),
+	}, nil
+}
+
+// GetDeviceManagementTemplates retrieves the legacy templates of a template type and subtype, e.g.
+// securityTemplate and antivirus. An empty type or subtype is not filtered on.
+func (c *Client) GetDeviceManagementTemplates(templateType, templateSubtype string) (*ResponseDeviceManagementTemplatesList, error) {
+	var filters []string
+	if templateType != "" {
+		filters = append(filters, fmt.Sprintf("templateType eq '%s'", odataEscapeString(templateType)))
+	}
+	if templateSubtype != "" {
+		filters = append(filters, fmt.Sprintf("templateSubtype eq '%s'", odataEscapeString(templateSubtype)))
+	}
+
+	endpoint := uriBetaDeviceManagementTemplates
+	if len(filters) > 0 {
+		endpoint += "?$filter=" + url.QueryEscape(strings.Join(filters, " and "))
+	}
+
+	var templates ResponseDeviceManagementTemplatesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementTemplatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management templates", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if templates.ODataContext == "" {
+			templates.ODataContext = page.ODataContext
+		}
+		templates.Value = append(templates.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management templates", err)
+			}
+		}
+	}
+
+	return &templates, nil
+}
+
+// GetDeviceManagementIntents retrieves a list of all intents.
+func (c *Client) GetDeviceManagementIntents() (*ResponseDeviceManagementIntentsList, error) {
+	endpoint := uriBetaDeviceManagementIntents
+
+	var intents ResponseDeviceManagementIntentsList
+	for endpoint != "" {
+		var page ResponseDeviceManagementIntentsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intents", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if intents.ODataContext == "" {
+			intents.ODataContext = page.ODataContext
+		}
+		intents.Value = append(intents.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intents", err)
+			}
+		}
+	}
+
+	return &intents, nil
+}
+
+// GetDeviceManagementIntentsByTemplate retrieves the intents created from the templates of a template type and
+// subtype, e.g. the antivirus policies or the security baselines.
+func (c *Client) GetDeviceManagementIntentsByTemplate(templateType, templateSubtype string) (*ResponseDeviceManagementIntentsList, error) {
+	templates, err := c.GetDeviceManagementTemplates(templateType, templateSubtype)
+	if err != nil {
+		return nil, err
+	}
+
+	templateIDs := make(map[string]bool, len(templates.Value))
+	for _, template := range templates.Value {
+		templateIDs[template.ID] = true
+	}
+
+	intents, err := c.GetDeviceManagementIntents()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := ResponseDeviceManagementIntentsList{ODataContext: intents.ODataContext}
+	for _, intent := range intents.Value {
+		if templateIDs[intent.TemplateID] {
+			matched.Value = append(matched.Value, intent)
+		}
+	}
+
+	return &matched, nil
+}
+
+// GetDeviceManagementIntentByID retrieves an intent by its ID.
+func (c *Client) GetDeviceManagementIntentByID(intentID string) (*ResourceDeviceManagementIntent, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementIntents, intentID)
+
+	var intent ResourceDeviceManagementIntent
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &intent)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device management intent", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &intent, nil
+}
+
+// GetDeviceManagementIntentByDisplayName retrieves an intent by its display name.
+func (c *Client) GetDeviceManagementIntentByDisplayName(displayName string) (*ResourceDeviceManagementIntent, error) {
+	intents, err := c.GetDeviceManagementIntents()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device management intents", err)
+	}
+
+	for _, intent := range intents.Value {
+		if intent.DisplayName == displayName {
+			return c.GetDeviceManagementIntentByID(intent.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "device management intent", displayName, "intent not found")
+}
+
+// CreateDeviceManagementIntentFromTemplate creates an intent from a legacy template using its createInstance
+// action. Settings not in SettingsDelta take the template defaults.
+func (c *Client) CreateDeviceManagementIntentFromTemplate(templateID string, request *CreateInstanceDeviceManagementTemplate) (*ResourceDeviceManagementIntent, error) {
+	endpoint := fmt.Sprintf("%s/%s/createInstance", uriBetaDeviceManagementTemplates, templateID)
+
+	if request.SettingsDelta == nil {
+		request.SettingsDelta = []DeviceManagementSettingInstance{}
+	}
+
+	var createdIntent ResourceDeviceManagementIntent
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdIntent)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device management intent", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdIntent, nil
+}
+
+// UpdateDeviceManagementIntentByID updates the display name, description and scope tags of an intent using
+// the PATCH method. Settings are updated with UpdateDeviceManagementIntentSettings.
+func (c *Client) UpdateDeviceManagementIntentByID(intentID string, request *ResourceDeviceManagementIntent) (*ResourceDeviceManagementIntent, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementIntents, intentID)
+
+	patch := struct {
+		DisplayName     string   `json:"displayName,omitempty"`
+		Description     string   `json:"description,omitempty"`
+		RoleScopeTagIds []string `json:"roleScopeTagIds,omitempty"`
+	}{
+		DisplayName:     request.DisplayName,
+		Description:     request.Description,
+		RoleScopeTagIds: request.RoleScopeTagIds,
+	}
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, patch, nil)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device management intent", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return c.GetDeviceManagementIntentByID(intentID)
+}
+
+// DeleteDeviceManagementIntentByID deletes an intent by its ID.
+func (c *Client) DeleteDeviceManagementIntentByID(intentID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementIntents, intentID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device management intent", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceManagementIntentByDisplayName deletes an intent by its display name.
+func (c *Client) DeleteDeviceManagementIntentByDisplayName(displayName string) error {
+	intent, err := c.GetDeviceManagementIntentByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "device management intent", displayName, err)
+	}
+
+	return c.DeleteDeviceManagementIntentByID(intent.ID)
+}
+
+// GetDeviceManagementIntentSettings retrieves the settings of an intent.
+func (c *Client) GetDeviceManagementIntentSettings(intentID string) (*ResponseDeviceManagementSettingInstancesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/settings", uriBetaDeviceManagementIntents, intentID)
+
+	var settings ResponseDeviceManagementSettingInstancesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementSettingInstancesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intent settings", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if settings.ODataContext == "" {
+			settings.ODataContext = page.ODataContext
+		}
+		settings.Value = append(settings.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intent settings", err)
+			}
+		}
+	}
+
+	return &settings, nil
+}
+
+// UpdateDeviceManagementIntentSettings updates settings of an intent using the updateSettings action. Only
+// the supplied settings change.
+func (c *Client) UpdateDeviceManagementIntentSettings(intentID string, settings []DeviceManagementSettingInstance) error {
+	endpoint := fmt.Sprintf("%s/%s/updateSettings", uriBetaDeviceManagementIntents, intentID)
+
+	requestBody := struct {
+		Settings []DeviceManagementSettingInstance `json:"settings"`
+	}{
+		Settings: settings,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device management intent settings", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetDeviceManagementTemplateMigrationTargets retrieves the newer versions of a legacy template that its
+// intents can be migrated to.
+func (c *Client) GetDeviceManagementTemplateMigrationTargets(templateID string) (*ResponseDeviceManagementTemplatesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/migratableTo", uriBetaDeviceManagementTemplates, templateID)
+
+	var templates ResponseDeviceManagementTemplatesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &templates)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device management template migration targets", templateID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &templates, nil
+}
+
+// CompareDeviceManagementIntentWithTemplate compares the settings of an intent with another version of its
+// template, showing what a migration would change.
+func (c *Client) CompareDeviceManagementIntentWithTemplate(intentID, templateID string) (*ResponseDeviceManagementSettingComparisonsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/compare(templateId='%s')", uriBetaDeviceManagementIntents, intentID, url.PathEscape(templateID))
+
+	var comparisons ResponseDeviceManagementSettingComparisonsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &comparisons)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device management intent comparison", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &comparisons, nil
+}
+
+// MigrateDeviceManagementIntentToTemplate migrates an intent to a newer version of its template. With
+// preserveCustomValues, settings changed from the old template defaults keep their values.
+func (c *Client) MigrateDeviceManagementIntentToTemplate(intentID, newTemplateID string, preserveCustomValues bool) error {
+	endpoint := fmt.Sprintf("%s/%s/migrateToTemplate", uriBetaDeviceManagementIntents, intentID)
+
+	requestBody := struct {
+		NewTemplateID        string `json:"newTemplateId"`
+		PreserveCustomValues bool   `json:"preserveCustomValues"`
+	}{
+		NewTemplateID:        newTemplateID,
+		PreserveCustomValues: preserveCustomValues,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device management intent template", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetDeviceManagementIntentAssignments retrieves the assignments of an intent.
+func (c *Client) GetDeviceManagementIntentAssignments(intentID string) (*ResponseDeviceManagementIntentAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaDeviceManagementIntents, intentID)
+
+	var assignments ResponseDeviceManagementIntentAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device management intent assignments", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// CreateDeviceManagementIntentAssignment assigns an intent using the assign action. The supplied assignments
+// replace any existing assignments of the intent.
+func (c *Client) CreateDeviceManagementIntentAssignment(intentID string, assignment *AssignmentDeviceManagementIntent) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaDeviceManagementIntents, intentID)
+
+	if assignment.Assignments == nil {
+		assignment.Assignments = []DeviceManagementIntentAssignment{}
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "device management intent", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetDeviceManagementIntentDeviceStateSummary retrieves the number of devices an intent is in each state on.
+func (c *Client) GetDeviceManagementIntentDeviceStateSummary(intentID string) (*ResponseDeviceManagementIntentDeviceStateSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStateSummary", uriBetaDeviceManagementIntents, intentID)
+
+	var summary ResponseDeviceManagementIntentDeviceStateSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &summary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "device management intent device state summary", intentID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &summary, nil
+}
+
+// GetDeviceManagementIntentSettingStateSummaries retrieves the number of devices each setting of an intent is
+// in each state on.
+func (c *Client) GetDeviceManagementIntentSettingStateSummaries(intentID string) (*ResponseDeviceManagementIntentSettingStateSummariesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceSettingStateSummaries", uriBetaDeviceManagementIntents, intentID)
+
+	var summaries ResponseDeviceManagementIntentSettingStateSummariesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementIntentSettingStateSummariesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intent setting state summaries", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if summaries.ODataContext == "" {
+			summaries.ODataContext = page.ODataContext
+		}
+		summaries.Value = append(summaries.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management intent setting state summaries", err)
+			}
+		}
+	}
+
+	return &summaries, nil
+}
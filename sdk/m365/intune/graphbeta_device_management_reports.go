@@ -0,0 +1,289 @@
+// graphbeta_device_management_reports.go
+// Graph Beta Api - Intune: Synchronous reports
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/reports
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/ReportingMenu/~/overview
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-reporting-devicemanagementreports?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/intune-reporting-devicemanagementreports-getcachedreport?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/intune-reporting-devicemanagementreports-getreportfilters?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-reporting-devicemanagementcachedreportconfiguration?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// The report actions below return a page of a report inline as a report stream. Pages are requested with
+// skip and top; the GetReportAllPages variants keep requesting pages until every row has been returned.
+// Reports too large to page through should be exported with an export job instead.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaReports                           = "/beta/deviceManagement/reports"
+	uriBetaReportsCachedReportConfigurations = "/beta/deviceManagement/reports/cachedReportConfigurations"
+	reportStreamPageSize                     = 100
+
+	ReportActionGetCachedReport                           = "getCachedReport"
+	ReportActionGetReportFilters                          = "getReportFilters"
+	ReportActionGetConfigurationPolicyDevicesReport       = "getConfigurationPolicyDevicesReport"
+	ReportActionGetConfigurationSettingsReport            = "getConfigurationSettingsReport"
+	ReportActionGetCompliancePolicyDevicesReport          = "getDeviceStatusByCompliacePolicyReport"
+	ReportActionGetComplianceSettingsReport               = "getComplianceSettingsReport"
+	ReportActionGetDeviceConfigurationPolicyStatusSummary = "getDeviceConfigurationPolicyStatusSummary"
+	ReportActionGetDeviceNonComplianceReport              = "getDeviceNonComplianceReport"
+	ReportActionGetDeviceStatusesReport                   = "getDeviceStatusesReport"
+)
+
+// ReportRequest represents the request body of the synchronous report actions. Name is the report to run for
+// getReportFilters, ID is the cached report configuration for getCachedReport, and both are omitted for the
+// other actions. Filter uses the report syntax, e.g. (PolicyId eq '<policy id>').
+type ReportRequest struct {
+	Name      string   `json:"name,omitempty"`
+	ID        string   `json:"id,omitempty"`
+	Select    []string `json:"select,omitempty"`
+	Filter    string   `json:"filter,omitempty"`
+	Search    string   `json:"search,omitempty"`
+	GroupBy   []string `json:"groupBy,omitempty"`
+	OrderBy   []string `json:"orderBy,omitempty"`
+	SessionID string   `json:"sessionId,omitempty"`
+	Skip      int      `json:"skip,omitempty"`
+	Top       int      `json:"top,omitempty"`
+}
+
+// ResourceDeviceManagementCachedReportConfiguration represents a cached report, a snapshot of a report that
+// Intune prepares in the background and that is then paged through with getCachedReport. The ID must be the
+// report name followed by an underscore and a GUID, e.g. DeviceCompliance_00000000-0000-0000-0000-000000000001.
+type ResourceDeviceManagementCachedReportConfiguration struct {
+	ODataContext        string     `json:"@odata.context,omitempty"`
+	ID                  string     `json:"id"`
+	ReportName          string     `json:"reportName,omitempty"`
+	Filter              string     `json:"filter,omitempty"`
+	Select              []string   `json:"select,omitempty"`
+	OrderBy             []string   `json:"orderBy,omitempty"`
+	Metadata            string     `json:"metadata,omitempty"`
+	Status              string     `json:"status,omitempty"`
+	LastRefreshDateTime *time.Time `json:"lastRefreshDateTime,omitempty"`
+	ExpirationDateTime  *time.Time `json:"expirationDateTime,omitempty"`
+}
+
+// ConfigurationPolicyDeviceStatus represents a row of the configuration policy devices report, the status of a
+// device configuration profile or settings catalog policy on a device.
+type ConfigurationPolicyDeviceStatus struct {
+	DeviceName                string     `report:"DeviceName"`
+	IntuneDeviceID            string     `report:"IntuneDeviceId"`
+	UserPrincipalName         string     `report:"UPN"`
+	UserID                    string     `report:"UserId"`
+	PolicyID                  string     `report:"PolicyId"`
+	PolicyStatus              int        `report:"PolicyStatus"`
+	ReportStatus              string     `report:"ReportStatus"`
+	UnifiedPolicyPlatformType string     `report:"UnifiedPolicyPlatformType"`
+	PolicyBaseTypeName        string     `report:"PolicyBaseTypeName"`
+	LastModifiedDateTime      *time.Time `report:"PspdpuLastModifiedTimeUtc"`
+}
+
+// CompliancePolicyDeviceStatus represents a row of the compliance policy devices report, the compliance state
+// of a device for a compliance policy.
+type CompliancePolicyDeviceStatus struct {
+	DeviceName           string     `report:"DeviceName"`
+	DeviceID             string     `report:"DeviceId"`
+	UserPrincipalName    string     `report:"UPN"`
+	UserName             string     `report:"UserName"`
+	PolicyID             string     `report:"PolicyId"`
+	PolicyStatus         int        `report:"PolicyStatus"`
+	ReportStatus         string     `report:"PolicyStatus_loc"`
+	DeviceType           string     `report:"DeviceType"`
+	OSVersion            string     `report:"OSVersion"`
+	LastModifiedDateTime *time.Time `report:"LastContact"`
+}
+
+// PolicySettingStatus represents a row of the per-setting status reports, the number of devices in each state
+// for a setting of a configuration or compliance policy.
+type PolicySettingStatus struct {
+	SettingName                  string `report:"SettingName"`
+	SettingID                    string `report:"SettingId"`
+	SettingInstancePath          string `report:"SettingInstancePath"`
+	NumberOfCompliantDevices     int    `report:"NumberOfCompliantDevices"`
+	NumberOfNonCompliantDevices  int    `report:"NumberOfNonCompliantDevices"`
+	NumberOfErrorDevices         int    `report:"NumberOfErrorDevices"`
+	NumberOfConflictDevices      int    `report:"NumberOfConflictDevices"`
+	NumberOfNotApplicableDevices int    `report:"NumberOfNotApplicableDevices"`
+}
+
+// GetReport runs a synchronous report action, e.g. getConfigurationPolicyDevicesReport, and returns the page
+// of the report selected by the Skip and Top of the request.
+func (c *Client) GetReport(reportAction string, request *ReportRequest) (*ResponseReportStream, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaReports, reportAction)
+
+	return c.postReportStream(endpoint, request, reportAction+" report")
+}
+
+// GetReportAllPages runs a synchronous report action page by page, starting at the Skip of the request, and
+// returns every row as a single report stream. Top sets the page size and defaults to 100.
+func (c *Client) GetReportAllPages(reportAction string, request *ReportRequest) (*ResponseReportStream, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaReports, reportAction)
+
+	return c.postReportStreamAllPages(endpoint, request, reportAction+" report")
+}
+
+// GetReportRows runs a synchronous report action page by page and decodes every row into the slice of structs
+// v points to. See DecodeReportRow for how columns are matched to fields.
+func (c *Client) GetReportRows(reportAction string, request *ReportRequest, v interface{}) error {
+	report, err := c.GetReportAllPages(reportAction, request)
+	if err != nil {
+		return err
+	}
+
+	return report.DecodeRows(v)
+}
+
+// GetReportFilters returns the values that the filters of a report accept, e.g. the policies that can be
+// selected in the report. The request Name selects the filter, e.g. FilterDeviceConfigurationPolicies.
+func (c *Client) GetReportFilters(request *ReportRequest) (*ResponseReportStream, error) {
+	if request.Name == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "report filters", "a report filter name is required")
+	}
+
+	return c.GetReportAllPages(ReportActionGetReportFilters, request)
+}
+
+// CreateCachedReportConfiguration requests a cached report. Intune prepares it in the background; wait for it
+// with WaitForCachedReportConfiguration before reading it with GetCachedReport.
+func (c *Client) CreateCachedReportConfiguration(configuration *ResourceDeviceManagementCachedReportConfiguration) (*ResourceDeviceManagementCachedReportConfiguration, error) {
+	endpoint := uriBetaReportsCachedReportConfigurations
+
+	var createdConfiguration ResourceDeviceManagementCachedReportConfiguration
+	resp, err := c.HTTP.DoRequest("POST", endpoint, configuration, &createdConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "cached report configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdConfiguration, nil
+}
+
+// GetCachedReportConfigurationByID retrieves a cached report configuration, including its status.
+func (c *Client) GetCachedReportConfigurationByID(configurationID string) (*ResourceDeviceManagementCachedReportConfiguration, error) {
+	endpoint := fmt.Sprintf("%s('%s')", uriBetaReportsCachedReportConfigurations, configurationID)
+
+	var configuration ResourceDeviceManagementCachedReportConfiguration
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "cached report configuration", configurationID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &configuration, nil
+}
+
+// WaitForCachedReportConfiguration polls a cached report configuration, backing off between checks, until the
+// report is ready, fails or the timeout elapses. The polling options are shared with export jobs; the Fetcher
+// is not used.
+func (c *Client) WaitForCachedReportConfiguration(configurationID string, options *ReportExportJobOptions) (*ResourceDeviceManagementCachedReportConfiguration, error) {
+	opts := options.withDefaults()
+	deadline := time.Now().Add(opts.Timeout)
+	pollInterval := opts.PollInterval
+
+	for {
+		configuration, err := c.GetCachedReportConfigurationByID(configurationID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch configuration.Status {
+		case ReportExportJobStatusCompleted:
+			return configuration, nil
+		case ReportExportJobStatusFailed:
+			return configuration, fmt.Errorf("cached report %s failed", configurationID)
+		}
+
+		if time.Now().After(deadline) {
+			return configuration, fmt.Errorf("timed out after %s waiting for cached report %s to complete, last status: %s", opts.Timeout, configurationID, configuration.Status)
+		}
+
+		time.Sleep(pollInterval)
+		if pollInterval *= 2; pollInterval > opts.MaxPollInterval {
+			pollInterval = opts.MaxPollInterval
+		}
+	}
+}
+
+// GetCachedReport returns every row of a completed cached report. The request ID is the cached report
+// configuration ID; Filter, OrderBy and Search narrow the cached rows further.
+func (c *Client) GetCachedReport(request *ReportRequest) (*ResponseReportStream, error) {
+	if request.ID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "cached report", "a cached report configuration ID is required")
+	}
+
+	return c.GetReportAllPages(ReportActionGetCachedReport, request)
+}
+
+// GetDeviceStatusesReport returns every row of the device statuses report. Filter, Select and OrderBy narrow
+// and shape the rows, e.g. a Filter of (PolicyId eq '<policy id>').
+func (c *Client) GetDeviceStatusesReport(request *ReportRequest) (*ResponseReportStream, error) {
+	return c.GetReportAllPages(ReportActionGetDeviceStatusesReport, request)
+}
+
+// GetConfigurationPolicyDeviceStatuses retrieves the status of a device configuration profile or settings
+// catalog policy on each device it applies to, as shown on the Device status page of the policy.
+func (c *Client) GetConfigurationPolicyDeviceStatuses(policyID string) ([]ConfigurationPolicyDeviceStatus, error) {
+	var statuses []ConfigurationPolicyDeviceStatus
+	err := c.GetReportRows(ReportActionGetConfigurationPolicyDevicesReport, &ReportRequest{
+		Filter: fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(policyID)),
+	}, &statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// GetConfigurationPolicySettingStatuses retrieves the number of devices in each state for every setting of a
+// device configuration profile or settings catalog policy, as shown on the Per setting status page.
+func (c *Client) GetConfigurationPolicySettingStatuses(policyID string) ([]PolicySettingStatus, error) {
+	var statuses []PolicySettingStatus
+	err := c.GetReportRows(ReportActionGetConfigurationSettingsReport, &ReportRequest{
+		Filter: fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(policyID)),
+	}, &statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// GetCompliancePolicyDeviceStatuses retrieves the compliance state of each device a compliance policy applies
+// to, as shown on the Device status page of the policy.
+func (c *Client) GetCompliancePolicyDeviceStatuses(policyID string) ([]CompliancePolicyDeviceStatus, error) {
+	var statuses []CompliancePolicyDeviceStatus
+	err := c.GetReportRows(ReportActionGetCompliancePolicyDevicesReport, &ReportRequest{
+		Filter: fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(policyID)),
+	}, &statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// GetCompliancePolicySettingStatuses retrieves the number of devices in each compliance state for every setting
+// of a compliance policy, as shown on the Per setting status page.
+func (c *Client) GetCompliancePolicySettingStatuses(policyID string) ([]PolicySettingStatus, error) {
+	var statuses []PolicySettingStatus
+	err := c.GetReportRows(ReportActionGetComplianceSettingsReport, &ReportRequest{
+		Filter: fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(policyID)),
+	}, &statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,459 @@
+// graphbeta_device_management_reports_export_jobs.go
+// Graph Beta Api - Intune: Report export jobs
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/reports-export-graph-apis
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/reports-export-graph-available-reports
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/ReportingMenu/~/overview
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-reporting-devicemanagementexportjob?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// Large reports are not returned inline. An export job is submitted, Intune prepares the report in the
+// background, and the completed job carries the URL of a ZIP archive holding the report as a CSV file. The
+// archive is downloaded to a temporary file and the CSV is decoded a row at a time, so reports with millions of
+// rows are not held in memory.
+
+package intune
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaReportsExportJobs = "/beta/deviceManagement/reports/exportJobs"
+
+	ReportExportJobFormatCSV  = "csv"
+	ReportExportJobFormatJSON = "json"
+
+	ReportExportJobLocalizationTypeLocalizedValuesAsAdditionalColumn = "localizedValuesAsAdditionalColumn"
+	ReportExportJobLocalizationTypeReplaceLocalizableValues          = "replaceLocalizableValues"
+
+	ReportExportJobStatusUnknown    = "unknown"
+	ReportExportJobStatusNotStarted = "notStarted"
+	ReportExportJobStatusInProgress = "inProgress"
+	ReportExportJobStatusCompleted  = "completed"
+	ReportExportJobStatusFailed     = "failed"
+)
+
+// Names of commonly exported reports. See the available reports documentation for the columns and filters
+// each report supports.
+const (
+	ReportNameDevices                               = "Devices"
+	ReportNameDevicesWithInventory                  = "DevicesWithInventory"
+	ReportNameDeviceCompliance                      = "DeviceCompliance"
+	ReportNameDeviceNonCompliance                   = "DeviceNonCompliance"
+	ReportNameDeviceInstallStatusByApp              = "DeviceInstallStatusByApp"
+	ReportNameUserInstallStatusAggregateByApp       = "UserInstallStatusAggregateByApp"
+	ReportNameAppInvRawData                         = "AppInvRawData"
+	ReportNameDeviceRunStatesByProactiveRemediation = "DeviceRunStatesByProactiveRemediation"
+	ReportNameFeatureUpdateDeviceState              = "FeatureUpdateDeviceState"
+	ReportNameQualityUpdateDeviceStatusByPolicy     = "QualityUpdateDeviceStatusByPolicy"
+	ReportNameDriverUpdatePolicyStatusSummary       = "DriverUpdatePolicyStatusSummary"
+)
+
+// ResourceDeviceManagementExportJob represents a report export job. It is used as both the request and
+// response structure; only ReportName is required when submitting a job.
+type ResourceDeviceManagementExportJob struct {
+	ODataContext       string     `json:"@odata.context,omitempty"`
+	ID                 string     `json:"id,omitempty"`
+	ReportName         string     `json:"reportName"`
+	Filter             string     `json:"filter,omitempty"`
+	Select             []string   `json:"select,omitempty"`
+	Search             string     `json:"search,omitempty"`
+	Format             string     `json:"format,omitempty"`
+	LocalizationType   string     `json:"localizationType,omitempty"`
+	SnapshotID         string     `json:"snapshotId,omitempty"`
+	Status             string     `json:"status,omitempty"`
+	URL                string     `json:"url,omitempty"`
+	RequestDateTime    *time.Time `json:"requestDateTime,omitempty"`
+	ExpirationDateTime *time.Time `json:"expirationDateTime,omitempty"`
+}
+
+// ReportFetcher downloads the archive of a completed export job from the URL returned by Intune. The default
+// implementation fetches it over HTTP; a local stand-in can be supplied in ReportExportJobOptions to decode a
+// saved archive without Intune.
+type ReportFetcher interface {
+	// Fetch returns the content at url. The caller closes it.
+	Fetch(url string) (io.ReadCloser, error)
+}
+
+// HTTPReportFetcher is the ReportFetcher used when none is configured. The export URL carries its own shared
+// access signature, so no Graph credentials are sent. HTTPClient defaults to http.DefaultClient.
+type HTTPReportFetcher struct {
+	HTTPClient *http.Client
+}
+
+// Fetch downloads the export archive with a GET request.
+func (f *HTTPReportFetcher) Fetch(url string) (io.ReadCloser, error) {
+	httpClient := f.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("report download failed: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("report download failed with status code: %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// ReportExportJobOptions configures how an export job is waited for and downloaded. Zero values use the
+// defaults noted on each field.
+type ReportExportJobOptions struct {
+	// Fetcher downloads the completed archive. Defaults to an HTTPReportFetcher.
+	Fetcher ReportFetcher
+	// PollInterval is the delay before the first check of the job status; it doubles after each check up to
+	// MaxPollInterval. Defaults to 2 seconds.
+	PollInterval time.Duration
+	// MaxPollInterval caps the delay between checks of the job status. Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+	// Timeout bounds the wait for the job to complete. Defaults to 15 minutes.
+	Timeout time.Duration
+}
+
+// withDefaults returns a copy of the options with unset fields defaulted.
+func (o *ReportExportJobOptions) withDefaults() ReportExportJobOptions {
+	var options ReportExportJobOptions
+	if o != nil {
+		options = *o
+	}
+
+	if options.Fetcher == nil {
+		options.Fetcher = &HTTPReportFetcher{}
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = 2 * time.Second
+	}
+	if options.MaxPollInterval <= 0 {
+		options.MaxPollInterval = 30 * time.Second
+	}
+	if options.MaxPollInterval < options.PollInterval {
+		options.MaxPollInterval = options.PollInterval
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 15 * time.Minute
+	}
+
+	return options
+}
+
+// CreateReportExportJob submits an export job. The format defaults to CSV.
+func (c *Client) CreateReportExportJob(request *ResourceDeviceManagementExportJob) (*ResourceDeviceManagementExportJob, error) {
+	endpoint := uriBetaReportsExportJobs
+
+	if request.ReportName == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "report export job", "report name is required")
+	}
+
+	job := ResourceDeviceManagementExportJob{
+		ReportName:       request.ReportName,
+		Filter:           request.Filter,
+		Select:           request.Select,
+		Search:           request.Search,
+		Format:           request.Format,
+		LocalizationType: request.LocalizationType,
+		SnapshotID:       request.SnapshotID,
+	}
+	if job.Format == "" {
+		job.Format = ReportExportJobFormatCSV
+	}
+
+	var createdJob ResourceDeviceManagementExportJob
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &job, &createdJob)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "report export job", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdJob, nil
+}
+
+// GetReportExportJobByID retrieves an export job, including its status and, once completed, its download URL.
+func (c *Client) GetReportExportJobByID(jobID string) (*ResourceDeviceManagementExportJob, error) {
+	endpoint := fmt.Sprintf("%s('%s')", uriBetaReportsExportJobs, jobID)
+
+	var job ResourceDeviceManagementExportJob
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &job)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "report export job", jobID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &job, nil
+}
+
+// WaitForReportExportJob polls an export job, backing off between checks, until it completes, fails or the
+// timeout elapses.
+func (c *Client) WaitForReportExportJob(jobID string, options *ReportExportJobOptions) (*ResourceDeviceManagementExportJob, error) {
+	opts := options.withDefaults()
+	deadline := time.Now().Add(opts.Timeout)
+	pollInterval := opts.PollInterval
+
+	for {
+		job, err := c.GetReportExportJobByID(jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case ReportExportJobStatusCompleted:
+			return job, nil
+		case ReportExportJobStatusFailed:
+			return job, fmt.Errorf("report export job %s for %s failed", jobID, job.ReportName)
+		}
+
+		if time.Now().After(deadline) {
+			return job, fmt.Errorf("timed out after %s waiting for report export job %s to complete, last status: %s", opts.Timeout, jobID, job.Status)
+		}
+
+		time.Sleep(pollInterval)
+		if pollInterval *= 2; pollInterval > opts.MaxPollInterval {
+			pollInterval = opts.MaxPollInterval
+		}
+	}
+}
+
+// DownloadReportExportJob downloads the archive of a completed CSV export job and returns an iterator over its
+// rows. The iterator must be closed to remove the downloaded archive.
+func (c *Client) DownloadReportExportJob(job *ResourceDeviceManagementExportJob, options *ReportExportJobOptions) (*ReportExportRowIterator, error) {
+	opts := options.withDefaults()
+
+	if job.Status != ReportExportJobStatusCompleted || job.URL == "" {
+		return nil, fmt.Errorf("report export job %s has not completed, status: %s", job.ID, job.Status)
+	}
+	if job.Format != "" && job.Format != ReportExportJobFormatCSV {
+		return nil, fmt.Errorf("report export job %s is in %s format, only csv exports can be decoded", job.ID, job.Format)
+	}
+
+	content, err := opts.Fetcher.Fetch(job.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download report export job %s: %v", job.ID, err)
+	}
+	defer content.Close()
+
+	archive, err := os.CreateTemp("", "intune-report-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to download report export job %s: %v", job.ID, err)
+	}
+
+	if _, err := io.Copy(archive, content); err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return nil, fmt.Errorf("failed to download report export job %s: %v", job.ID, err)
+	}
+
+	iterator, err := newReportExportRowIterator(archive)
+	if err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return nil, fmt.Errorf("failed to open report export job %s: %v", job.ID, err)
+	}
+
+	return iterator, nil
+}
+
+// ExportReport submits an export job, waits for it to complete and returns an iterator over the rows of the
+// report. The iterator must be closed to remove the downloaded archive.
+func (c *Client) ExportReport(request *ResourceDeviceManagementExportJob, options *ReportExportJobOptions) (*ReportExportRowIterator, error) {
+	job, err := c.CreateReportExportJob(request)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err = c.WaitForReportExportJob(job.ID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DownloadReportExportJob(job, options)
+}
+
+// ExportReportRows exports a report and returns all of its rows as maps keyed by column name. Use ExportReport
+// to stream reports that are too large to hold in memory.
+func (c *Client) ExportReportRows(request *ResourceDeviceManagementExportJob, options *ReportExportJobOptions) ([]map[string]string, error) {
+	iterator, err := c.ExportReport(request, options)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var rows []map[string]string
+	for iterator.Next() {
+		rows = append(rows, iterator.Row())
+	}
+
+	if err := iterator.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ReportExportRowIterator streams the rows of an exported CSV report one at a time.
+//
+//	iterator, err := client.ExportReport(request, nil)
+//	defer iterator.Close()
+//	for iterator.Next() {
+//		var row DeviceComplianceRow
+//		err := iterator.Decode(&row)
+//	}
+//	if err := iterator.Err(); err != nil {
+//	}
+type ReportExportRowIterator struct {
+	archive *os.File
+	entry   io.ReadCloser
+	reader  *csv.Reader
+	columns []string
+	current []string
+	err     error
+}
+
+// newReportExportRowIterator opens the CSV file in a downloaded export archive and reads its header.
+func newReportExportRowIterator(archive *os.File) (*ReportExportRowIterator, error) {
+	info, err := archive.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	zipReader, err := zip.NewReader(archive, info.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	var csvFile *zip.File
+	for _, file := range zipReader.File {
+		if strings.EqualFold(path.Ext(file.Name), ".csv") {
+			csvFile = file
+			break
+		}
+	}
+	if csvFile == nil {
+		return nil, fmt.Errorf("export archive does not contain a CSV file")
+	}
+
+	entry, err := csvFile.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	// Exports are written with a UTF-8 byte order mark, which would otherwise be read as part of the first
+	// column name
+	buffered := bufio.NewReader(entry)
+	if bom, err := buffered.Peek(3); err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		buffered.Discard(3)
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.LazyQuotes = true
+
+	columns, err := reader.Read()
+	if err != nil {
+		entry.Close()
+		return nil, fmt.Errorf("failed to read the header of %s: %v", csvFile.Name, err)
+	}
+
+	return &ReportExportRowIterator{
+		archive: archive,
+		entry:   entry,
+		reader:  reader,
+		columns: columns,
+	}, nil
+}
+
+// Columns returns the column names of the report in CSV order.
+func (it *ReportExportRowIterator) Columns() []string {
+	return it.columns
+}
+
+// Next advances the iterator to the next row. It returns false when there are no more rows or an error
+// occurred.
+func (it *ReportExportRowIterator) Next() bool {
+	if it.err != nil || it.reader == nil {
+		return false
+	}
+
+	record, err := it.reader.Read()
+	if err == io.EOF {
+		return false
+	}
+	if err != nil {
+		it.err = fmt.Errorf("failed to read report row: %v", err)
+		return false
+	}
+
+	it.current = record
+	return true
+}
+
+// Values returns the values of the row the iterator is positioned on in column order.
+func (it *ReportExportRowIterator) Values() []string {
+	return it.current
+}
+
+// Row returns the row the iterator is positioned on as a map keyed by column name.
+func (it *ReportExportRowIterator) Row() map[string]string {
+	row := make(map[string]string, len(it.columns))
+	for i, column := range it.columns {
+		if i < len(it.current) {
+			row[column] = it.current[i]
+		}
+	}
+
+	return row
+}
+
+// Decode copies the row the iterator is positioned on into the struct v points to; see DecodeReportRow.
+func (it *ReportExportRowIterator) Decode(v interface{}) error {
+	row := make(map[string]interface{}, len(it.columns))
+	for i, column := range it.columns {
+		if i < len(it.current) {
+			row[column] = it.current[i]
+		}
+	}
+
+	return DecodeReportRow(row, v)
+}
+
+// Err returns the first error encountered while reading rows, if any.
+func (it *ReportExportRowIterator) Err() error {
+	return it.err
+}
+
+// Close releases the archive and removes it from disk.
+func (it *ReportExportRowIterator) Close() error {
+	if it.archive == nil {
+		return nil
+	}
+
+	it.entry.Close()
+	it.reader = nil
+	err := it.archive.Close()
+	if removeErr := os.Remove(it.archive.Name()); err == nil {
+		err = removeErr
+	}
+	it.archive = nil
+
+	return err
+}
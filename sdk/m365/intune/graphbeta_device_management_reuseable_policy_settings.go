@@ -5,47 +5,78 @@
 // API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfigv2-devicemanagementreusablepolicysetting?view=graph-rest-beta
 // ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
 // Microsoft Graph requires the structs to support a JSON data structure.
+// Reusable settings groups, such as the remote address ranges of firewall rules and the removable storage
+// groups of device control, are referenced by configuration policies. Deleting one that policies still
+// reference breaks them, so deletes are refused while references remain unless forced.
 
 package intune
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
 )
 
-const uriBetaDeviceManagementReusablePolicySettings = "/beta/deviceManagement/reusablePolicySettings"
+const (
+	uriBetaDeviceManagementReusablePolicySettings  = "/beta/deviceManagement/reusablePolicySettings"
+	odataTypeDeviceManagementReusablePolicySetting = "#microsoft.graph.deviceManagementReusablePolicySetting"
+
+	// Setting definitions of the reusable settings groups
+	ReusablePolicySettingDefinitionFirewallRemoteAddresses = "vendor_msft_firewall_mdmstore_dynamickeywords_addresses_{id}"
+	ReusablePolicySettingDefinitionDeviceControlGroup      = "device_vendor_msft_defender_configuration_devicecontrol_policygroups_{groupid}_groupdata"
+
+	ODataTypeDeviceManagementConfigurationChoiceSettingInstance           = "#microsoft.graph.deviceManagementConfigurationChoiceSettingInstance"
+	ODataTypeDeviceManagementConfigurationGroupSettingCollectionInstance  = "#microsoft.graph.deviceManagementConfigurationGroupSettingCollectionInstance"
+	ODataTypeDeviceManagementConfigurationSimpleSettingInstance           = "#microsoft.graph.deviceManagementConfigurationSimpleSettingInstance"
+	ODataTypeDeviceManagementConfigurationSimpleSettingCollectionInstance = "#microsoft.graph.deviceManagementConfigurationSimpleSettingCollectionInstance"
+)
 
 // ResourceDeviceManagementReusablePolicySetting represents a list of reusable policy settings in device management.
 type ResponseDeviceManagementReusablePolicySettingsList struct {
-	ODataContext string                                          `json:"@odata.context"`
-	ODataCount   int                                             `json:"@odata.count"`
-	Value        []ResourceDeviceManagementReusablePolicySetting `json:"value"`
+	ODataContext  string                                          `json:"@odata.context"`
+	ODataCount    int                                             `json:"@odata.count"`
+	ODataNextLink string                                          `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceDeviceManagementReusablePolicySetting `json:"value"`
 }
 
 // ResourceDeviceManagementReusablePolicySetting represents a reusable policy setting resource in device management.
 type ResourceDeviceManagementReusablePolicySetting struct {
-	OdataType                           string                                              `json:"@odata.type"`
-	ID                                  string                                              `json:"id"`
-	DisplayName                         string                                              `json:"displayName"`
-	Description                         string                                              `json:"description"`
-	SettingDefinitionId                 string                                              `json:"settingDefinitionId"`
-	SettingInstance                     *DeviceManagementConfigurationChoiceSettingInstance `json:"settingInstance,omitempty"`
-	CreatedDateTime                     time.Time                                           `json:"createdDateTime"`
-	LastModifiedDateTime                time.Time                                           `json:"lastModifiedDateTime"`
-	Version                             int                                                 `json:"version"`
-	ReferencingConfigurationPolicyCount int                                                 `json:"referencingConfigurationPolicyCount"`
-}
-
-// DeviceManagementConfigurationChoiceSettingInstance represents an instance of a choice setting.
-type DeviceManagementConfigurationChoiceSettingInstance struct {
+	OdataType                           string                                                `json:"@odata.type"`
+	ID                                  string                                                `json:"id"`
+	DisplayName                         string                                                `json:"displayName"`
+	Description                         string                                                `json:"description"`
+	SettingDefinitionId                 string                                                `json:"settingDefinitionId"`
+	SettingInstance                     *DeviceManagementConfigurationReusableSettingInstance `json:"settingInstance,omitempty"`
+	CreatedDateTime                     time.Time                                             `json:"createdDateTime"`
+	LastModifiedDateTime                time.Time                                             `json:"lastModifiedDateTime"`
+	Version                             int                                                   `json:"version"`
+	ReferencingConfigurationPolicyCount int                                                   `json:"referencingConfigurationPolicyCount"`
+}
+
+// DeviceManagementConfigurationReusableSettingInstance represents the setting instance of a reusable policy
+// setting, or one of its children. OdataType selects the instance type and the value field that is set: the
+// reusable settings groups of firewall rules and device control are group setting collections whose children
+// are simple, simple collection or choice instances.
+type DeviceManagementConfigurationReusableSettingInstance struct {
 	OdataType                        string                                                         `json:"@odata.type"`
 	SettingDefinitionId              string                                                         `json:"settingDefinitionId"`
 	SettingInstanceTemplateReference *DeviceManagementConfigurationSettingInstanceTemplateReference `json:"settingInstanceTemplateReference,omitempty"`
-	ChoiceSettingValue               *DeviceManagementConfigurationChoiceSettingValue               `json:"choiceSettingValue,omitempty"`
+	// Fields for choice setting instances
+	ChoiceSettingValue *DeviceManagementConfigurationChoiceSettingValue `json:"choiceSettingValue,omitempty"`
+	// Fields for group setting collection instances
+	GroupSettingCollectionValue []DeviceManagementConfigurationGroupSettingValue `json:"groupSettingCollectionValue,omitempty"`
+	// Fields for simple setting instances
+	SimpleSettingValue *DeviceManagementConfigurationSimpleSettingValue `json:"simpleSettingValue,omitempty"`
+	// Fields for simple setting collection instances
+	SimpleSettingCollectionValue []DeviceManagementConfigurationSimpleSettingValue `json:"simpleSettingCollectionValue,omitempty"`
 }
 
+// DeviceManagementConfigurationChoiceSettingInstance represents an instance of a choice setting. It is kept as
+// an alias so that code written against the choice only instance of earlier versions still compiles.
+type DeviceManagementConfigurationChoiceSettingInstance = DeviceManagementConfigurationReusableSettingInstance
+
 // DeviceManagementConfigurationSettingInstanceTemplateReference represents a reference to a setting instance template.
 type DeviceManagementConfigurationSettingInstanceTemplateReference struct {
 	OdataType                 string `json:"@odata.type"`
@@ -57,7 +88,7 @@ type DeviceManagementConfigurationChoiceSettingValue struct {
 	OdataType                     string                                                      `json:"@odata.type"`
 	SettingValueTemplateReference *DeviceManagementConfigurationSettingValueTemplateReference `json:"settingValueTemplateReference,omitempty"`
 	Value                         string                                                      `json:"value"`
-	Children                      []*DeviceManagementConfigurationChoiceSettingInstance       `json:"children,omitempty"`
+	Children                      []*DeviceManagementConfigurationReusableSettingInstance     `json:"children,omitempty"`
 }
 
 // DeviceManagementConfigurationSettingValueTemplateReference represents a template reference for a setting value.
@@ -67,21 +98,72 @@ type DeviceManagementConfigurationSettingValueTemplateReference struct {
 	UseTemplateDefault     bool   `json:"useTemplateDefault"`
 }
 
+// DeviceManagementConfigurationGroupSettingValue represents one group of a group setting collection.
+type DeviceManagementConfigurationGroupSettingValue struct {
+	OdataType                     string                                                      `json:"@odata.type,omitempty"`
+	SettingValueTemplateReference *DeviceManagementConfigurationSettingValueTemplateReference `json:"settingValueTemplateReference,omitempty"`
+	Children                      []*DeviceManagementConfigurationReusableSettingInstance     `json:"children"`
+}
+
+// DeviceManagementConfigurationSimpleSettingValue represents the value of a simple setting, e.g. a string or
+// integer setting value.
+type DeviceManagementConfigurationSimpleSettingValue struct {
+	OdataType                     string                                                      `json:"@odata.type"`
+	SettingValueTemplateReference *DeviceManagementConfigurationSettingValueTemplateReference `json:"settingValueTemplateReference,omitempty"`
+	Value                         interface{}                                                 `json:"value"`
+}
+
+// reusablePolicySettingRequest holds the writable properties of a reusable policy setting.
+type reusablePolicySettingRequest struct {
+	OdataType           string                                                `json:"@odata.type"`
+	DisplayName         string                                                `json:"displayName"`
+	Description         string                                                `json:"description"`
+	SettingDefinitionId string                                                `json:"settingDefinitionId"`
+	SettingInstance     *DeviceManagementConfigurationReusableSettingInstance `json:"settingInstance,omitempty"`
+}
+
+// ReusablePolicySettingInUseError is returned when deleting a reusable policy setting that configuration
+// policies still reference.
+type ReusablePolicySettingInUseError struct {
+	SettingID string
+	Policies  []ResourceDeviceManagementConfigurationPolicy
+}
+
+func (e *ReusablePolicySettingInUseError) Error() string {
+	names := make([]string, 0, len(e.Policies))
+	for _, policy := range e.Policies {
+		names = append(names, policy.Name)
+	}
+	return fmt.Sprintf("reusable policy setting %s is referenced by %d configuration policy(s): %s", e.SettingID, len(e.Policies), strings.Join(names, ", "))
+}
+
 // GetResourceDeviceManagementReusablePolicySettings retrieves a list of all device management reusable policy settings.
 func (c *Client) GetResourceDeviceManagementReusablePolicySettings() ([]ResourceDeviceManagementReusablePolicySetting, error) {
 	endpoint := uriBetaDeviceManagementReusablePolicySettings
 
-	var responseReusablePolicySettings ResponseDeviceManagementReusablePolicySettingsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseReusablePolicySettings)
-	if err != nil {
-		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device management reusable policy settings", err)
-	}
+	var reusablePolicySettings []ResourceDeviceManagementReusablePolicySetting
+	for endpoint != "" {
+		var page ResponseDeviceManagementReusablePolicySettingsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management reusable policy settings", err)
+		}
 
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		reusablePolicySettings = append(reusablePolicySettings, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "device management reusable policy settings", err)
+			}
+		}
 	}
 
-	return responseReusablePolicySettings.Value, nil
+	return reusablePolicySettings, nil
 }
 
 // GetDeviceManagementReusablePolicySettingByID retrieves a specific device management Reusable Policy Setting by its ID.
@@ -101,4 +183,169 @@ func (c *Client) GetDeviceManagementReusablePolicySettingByID(policySettingId st
 	return &responseReusablePolicySetting, nil
 }
 
-//TODO - rest of the CRUD functions
+// GetDeviceManagementReusablePolicySettingByDisplayName retrieves a device management reusable policy setting by its display name.
+func (c *Client) GetDeviceManagementReusablePolicySettingByDisplayName(displayName string) (*ResourceDeviceManagementReusablePolicySetting, error) {
+	reusablePolicySettings, err := c.GetResourceDeviceManagementReusablePolicySettings()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "device management reusable policy settings", err)
+	}
+
+	for _, reusablePolicySetting := range reusablePolicySettings {
+		if reusablePolicySetting.DisplayName == displayName {
+			return c.GetDeviceManagementReusablePolicySettingByID(reusablePolicySetting.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "device management reusable policy setting", displayName, "Reusable policy setting not found")
+}
+
+// CreateDeviceManagementReusablePolicySetting creates a new device management reusable policy setting.
+func (c *Client) CreateDeviceManagementReusablePolicySetting(request *ResourceDeviceManagementReusablePolicySetting) (*ResourceDeviceManagementReusablePolicySetting, error) {
+	endpoint := uriBetaDeviceManagementReusablePolicySettings
+
+	// Exclude read-only properties from the request object
+	payload := reusablePolicySettingRequest{
+		OdataType:           odataTypeDeviceManagementReusablePolicySetting,
+		DisplayName:         request.DisplayName,
+		Description:         request.Description,
+		SettingDefinitionId: request.SettingDefinitionId,
+		SettingInstance:     request.SettingInstance,
+	}
+
+	var createdReusablePolicySetting ResourceDeviceManagementReusablePolicySetting
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &payload, &createdReusablePolicySetting)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "device management reusable policy setting", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdReusablePolicySetting, nil
+}
+
+// UpdateDeviceManagementReusablePolicySettingByID updates a device management reusable policy setting by its ID.
+// The setting is replaced using the PUT method, and the configuration policies that reference it pick up the
+// new version.
+func (c *Client) UpdateDeviceManagementReusablePolicySettingByID(policySettingId string, request *ResourceDeviceManagementReusablePolicySetting) (*ResourceDeviceManagementReusablePolicySetting, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementReusablePolicySettings, policySettingId)
+
+	// Exclude read-only properties from the request object
+	payload := reusablePolicySettingRequest{
+		OdataType:           odataTypeDeviceManagementReusablePolicySetting,
+		DisplayName:         request.DisplayName,
+		Description:         request.Description,
+		SettingDefinitionId: request.SettingDefinitionId,
+		SettingInstance:     request.SettingInstance,
+	}
+
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, &payload, nil)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "device management reusable policy setting", policySettingId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return c.GetDeviceManagementReusablePolicySettingByID(policySettingId)
+}
+
+// UpdateDeviceManagementReusablePolicySettingByDisplayName updates a device management reusable policy setting by its display name.
+func (c *Client) UpdateDeviceManagementReusablePolicySettingByDisplayName(displayName string, request *ResourceDeviceManagementReusablePolicySetting) (*ResourceDeviceManagementReusablePolicySetting, error) {
+	reusablePolicySetting, err := c.GetDeviceManagementReusablePolicySettingByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "device management reusable policy setting", displayName, err)
+	}
+
+	return c.UpdateDeviceManagementReusablePolicySettingByID(reusablePolicySetting.ID, request)
+}
+
+// GetDeviceManagementReusablePolicySettingReferencingPolicies retrieves the configuration policies that
+// reference a device management reusable policy setting.
+func (c *Client) GetDeviceManagementReusablePolicySettingReferencingPolicies(policySettingId string) (*ResponseDeviceManagementConfigurationPoliciesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/referencingConfigurationPolicies", uriBetaDeviceManagementReusablePolicySettings, policySettingId)
+
+	var policies ResponseDeviceManagementConfigurationPoliciesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementConfigurationPoliciesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "reusable policy setting referencing configuration policies", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if policies.ODataContext == "" {
+			policies.ODataContext = page.ODataContext
+		}
+		policies.Value = append(policies.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "reusable policy setting referencing configuration policies", err)
+			}
+		}
+	}
+	policies.ODataCount = len(policies.Value)
+
+	return &policies, nil
+}
+
+// DeleteDeviceManagementReusablePolicySettingByID deletes a device management reusable policy setting by its ID.
+// The setting is only deleted if no configuration policy references it; otherwise a
+// *ReusablePolicySettingInUseError listing the referencing policies is returned.
+func (c *Client) DeleteDeviceManagementReusablePolicySettingByID(policySettingId string) error {
+	policies, err := c.GetDeviceManagementReusablePolicySettingReferencingPolicies(policySettingId)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device management reusable policy setting", policySettingId, err)
+	}
+	if len(policies.Value) > 0 {
+		return &ReusablePolicySettingInUseError{SettingID: policySettingId, Policies: policies.Value}
+	}
+
+	return c.DeleteDeviceManagementReusablePolicySettingByIDForce(policySettingId)
+}
+
+// DeleteDeviceManagementReusablePolicySettingByIDForce deletes a device management reusable policy setting by its
+// ID even if configuration policies still reference it.
+func (c *Client) DeleteDeviceManagementReusablePolicySettingByIDForce(policySettingId string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementReusablePolicySettings, policySettingId)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "device management reusable policy setting", policySettingId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceManagementReusablePolicySettingByDisplayName deletes a device management reusable policy setting by its
+// display name, refusing as DeleteDeviceManagementReusablePolicySettingByID does while policies reference it.
+func (c *Client) DeleteDeviceManagementReusablePolicySettingByDisplayName(displayName string) error {
+	reusablePolicySetting, err := c.GetDeviceManagementReusablePolicySettingByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "device management reusable policy setting", displayName, err)
+	}
+
+	return c.DeleteDeviceManagementReusablePolicySettingByID(reusablePolicySetting.ID)
+}
+
+// DeleteDeviceManagementReusablePolicySettingByDisplayNameForce deletes a device management reusable policy setting
+// by its display name even if configuration policies still reference it.
+func (c *Client) DeleteDeviceManagementReusablePolicySettingByDisplayNameForce(displayName string) error {
+	reusablePolicySetting, err := c.GetDeviceManagementReusablePolicySettingByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "device management reusable policy setting", displayName, err)
+	}
+
+	return c.DeleteDeviceManagementReusablePolicySettingByIDForce(reusablePolicySetting.ID)
+}
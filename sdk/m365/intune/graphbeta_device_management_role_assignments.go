@@ -0,0 +1,246 @@
+// graphbeta_device_management_role_assignments.go
+// Graph Beta Api - Intune: Role Assignments
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/role-based-access-control#assign-a-role-to-a-user
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/RolesLandingMenuBlade/~/roles
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-rbac-deviceandappmanagementroleassignment?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaRoleAssignments                        = "/beta/deviceManagement/roleAssignments"
+	odataTypeDeviceAndAppManagementRoleAssignment = "#microsoft.graph.deviceAndAppManagementRoleAssignment"
+
+	RoleAssignmentScopeTypeResourceScope              = "resourceScope"
+	RoleAssignmentScopeTypeAllDevices                 = "allDevices"
+	RoleAssignmentScopeTypeAllLicensedUsers           = "allLicensedUsers"
+	RoleAssignmentScopeTypeAllDevicesAndLicensedUsers = "allDevicesAndLicensedUsers"
+)
+
+// ResponseRoleAssignmentsList represents a list of Intune role assignments.
+type ResponseRoleAssignmentsList struct {
+	ODataContext string                   `json:"@odata.context"`
+	Value        []ResourceRoleAssignment `json:"value"`
+}
+
+// ResourceRoleAssignment represents the assignment of an Intune role to member groups, limited to the users and
+// devices in the scope groups (ResourceScopes) or to the scope described by ScopeType.
+//
+// RoleDefinitionID and RoleScopeTagIds are used when creating an assignment; the expanded RoleDefinition and
+// RoleScopeTags are populated when an assignment is read by ID.
+type ResourceRoleAssignment struct {
+	ODataType          string                  `json:"@odata.type,omitempty"`
+	ID                 string                  `json:"id,omitempty"`
+	DisplayName        string                  `json:"displayName,omitempty"`
+	Description        string                  `json:"description,omitempty"`
+	Members            []string                `json:"members"`
+	ResourceScopes     []string                `json:"resourceScopes,omitempty"`
+	ScopeMembers       []string                `json:"scopeMembers,omitempty"`
+	ScopeType          string                  `json:"scopeType,omitempty"`
+	RoleDefinitionBind string                  `json:"roleDefinition@odata.bind,omitempty"`
+	RoleDefinition     *ResourceRoleDefinition `json:"roleDefinition,omitempty"`
+	RoleScopeTags      []ResourceRoleScopeTag  `json:"roleScopeTags,omitempty"`
+	RoleDefinitionID   string                  `json:"-"`
+	RoleScopeTagIds    []string                `json:"-"`
+}
+
+// GetRoleAssignments retrieves a list of Intune role assignments.
+func (c *Client) GetRoleAssignments() (*ResponseRoleAssignmentsList, error) {
+	endpoint := uriBetaRoleAssignments
+
+	var roleAssignments ResponseRoleAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &roleAssignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role assignments", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &roleAssignments, nil
+}
+
+// GetRoleAssignmentByID retrieves an Intune role assignment, with its role and scope tags, by its ID.
+func (c *Client) GetRoleAssignmentByID(id string) (*ResourceRoleAssignment, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=roleDefinition,roleScopeTags", uriBetaRoleAssignments, id)
+
+	var roleAssignment ResourceRoleAssignment
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &roleAssignment)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "role assignment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if roleAssignment.RoleDefinition != nil {
+		roleAssignment.RoleDefinitionID = roleAssignment.RoleDefinition.ID
+	}
+	for _, scopeTag := range roleAssignment.RoleScopeTags {
+		roleAssignment.RoleScopeTagIds = append(roleAssignment.RoleScopeTagIds, scopeTag.ID)
+	}
+
+	return &roleAssignment, nil
+}
+
+// GetRoleAssignmentByDisplayName retrieves an Intune role assignment by its display name.
+func (c *Client) GetRoleAssignmentByDisplayName(displayName string) (*ResourceRoleAssignment, error) {
+	roleAssignments, err := c.GetRoleAssignments()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role assignments", err)
+	}
+
+	for _, roleAssignment := range roleAssignments.Value {
+		if roleAssignment.DisplayName == displayName {
+			return c.GetRoleAssignmentByID(roleAssignment.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "role assignment", displayName, "role assignment not found")
+}
+
+// CreateRoleAssignment assigns the role identified by RoleDefinitionID to the member groups and then adds the
+// scope tags in RoleScopeTagIds, which limit the resources the members can see. If a scope tag cannot be added,
+// the created assignment is returned together with the error, with RoleScopeTagIds listing the tags that were
+// added, so that the caller can retry or delete it.
+func (c *Client) CreateRoleAssignment(request *ResourceRoleAssignment) (*ResourceRoleAssignment, error) {
+	endpoint := uriBetaRoleAssignments
+
+	// Set graph metadata values
+	request.ODataType = odataTypeDeviceAndAppManagementRoleAssignment
+	if request.RoleDefinitionID != "" {
+		request.RoleDefinitionBind = c.graphResourceURL(fmt.Sprintf("%s('%s')", uriBetaRoleDefinitions, request.RoleDefinitionID))
+	}
+
+	payload := *request
+	payload.RoleDefinition = nil
+	payload.RoleScopeTags = nil
+
+	var createdRoleAssignment ResourceRoleAssignment
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &payload, &createdRoleAssignment)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "role assignment", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	createdRoleAssignment.RoleDefinitionID = request.RoleDefinitionID
+	for _, scopeTagID := range request.RoleScopeTagIds {
+		if err := c.AddRoleScopeTagToRoleAssignment(createdRoleAssignment.ID, scopeTagID); err != nil {
+			return &createdRoleAssignment, err
+		}
+		createdRoleAssignment.RoleScopeTagIds = append(createdRoleAssignment.RoleScopeTagIds, scopeTagID)
+	}
+
+	return &createdRoleAssignment, nil
+}
+
+// AddRoleScopeTagToRoleAssignment adds a scope tag to an Intune role assignment.
+func (c *Client) AddRoleScopeTagToRoleAssignment(id, scopeTagID string) error {
+	endpoint := fmt.Sprintf("%s/%s/roleScopeTags/$ref", uriBetaRoleAssignments, id)
+
+	requestBody := map[string]string{
+		"@odata.id": c.graphResourceURL(fmt.Sprintf("%s('%s')", uriBetaRoleScopeTags, scopeTagID)),
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, "role scope tag to role assignment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// RemoveRoleScopeTagFromRoleAssignment removes a scope tag from an Intune role assignment.
+func (c *Client) RemoveRoleScopeTagFromRoleAssignment(id, scopeTagID string) error {
+	endpoint := fmt.Sprintf("%s/%s/roleScopeTags/%s/$ref", uriBetaRoleAssignments, id, scopeTagID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "role scope tag from role assignment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateRoleAssignmentByID updates the members and scope of an Intune role assignment by its ID. The role
+// cannot be changed, and scope tags are managed with AddRoleScopeTagToRoleAssignment and
+// RemoveRoleScopeTagFromRoleAssignment.
+func (c *Client) UpdateRoleAssignmentByID(id string, request *ResourceRoleAssignment) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleAssignments, id)
+
+	// Set graph metadata values
+	request.ODataType = odataTypeDeviceAndAppManagementRoleAssignment
+
+	payload := *request
+	payload.ID = ""
+	payload.RoleDefinitionBind = ""
+	payload.RoleDefinition = nil
+	payload.RoleScopeTags = nil
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "role assignment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateRoleAssignmentByDisplayName updates an Intune role assignment by its display name.
+func (c *Client) UpdateRoleAssignmentByDisplayName(displayName string, request *ResourceRoleAssignment) error {
+	roleAssignment, err := c.GetRoleAssignmentByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "role assignment", displayName, err)
+	}
+
+	return c.UpdateRoleAssignmentByID(roleAssignment.ID, request)
+}
+
+// DeleteRoleAssignmentByID deletes an Intune role assignment by its ID.
+func (c *Client) DeleteRoleAssignmentByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleAssignments, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "role assignment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteRoleAssignmentByDisplayName deletes an Intune role assignment by its display name.
+func (c *Client) DeleteRoleAssignmentByDisplayName(displayName string) error {
+	roleAssignment, err := c.GetRoleAssignmentByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "role assignment", displayName, err)
+	}
+
+	return c.DeleteRoleAssignmentByID(roleAssignment.ID)
+}
@@ -0,0 +1,206 @@
+// graphbeta_device_management_role_definitions.go
+// Graph Beta Api - Intune: Roles
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/role-based-access-control
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/RolesLandingMenuBlade/~/roles
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-rbac-deviceandappmanagementroledefinition?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaRoleDefinitions                        = "/beta/deviceManagement/roleDefinitions"
+	odataTypeDeviceAndAppManagementRoleDefinition = "#microsoft.graph.deviceAndAppManagementRoleDefinition"
+)
+
+// ResponseRoleDefinitionsList represents a list of Intune role definitions.
+type ResponseRoleDefinitionsList struct {
+	ODataContext string                   `json:"@odata.context"`
+	Value        []ResourceRoleDefinition `json:"value"`
+}
+
+// ResourceRoleDefinition represents a built-in or custom Intune role and the resource actions it grants.
+type ResourceRoleDefinition struct {
+	ODataType       string                         `json:"@odata.type,omitempty"`
+	ID              string                         `json:"id,omitempty"`
+	DisplayName     string                         `json:"displayName"`
+	Description     string                         `json:"description,omitempty"`
+	IsBuiltIn       bool                           `json:"isBuiltIn,omitempty"`
+	RoleScopeTagIds []string                       `json:"roleScopeTagIds,omitempty"`
+	RolePermissions []RoleDefinitionRolePermission `json:"rolePermissions"`
+}
+
+// RoleDefinitionRolePermission represents a set of resource actions granted by a role.
+type RoleDefinitionRolePermission struct {
+	ResourceActions []RoleDefinitionResourceAction `json:"resourceActions"`
+}
+
+// RoleDefinitionResourceAction lists the actions allowed, and explicitly not allowed, on Intune resources,
+// e.g. Microsoft.Intune_DeviceConfigurations_Read.
+type RoleDefinitionResourceAction struct {
+	AllowedResourceActions    []string `json:"allowedResourceActions"`
+	NotAllowedResourceActions []string `json:"notAllowedResourceActions"`
+}
+
+// AllowedResourceActions returns every resource action the role allows.
+func (r *ResourceRoleDefinition) AllowedResourceActions() []string {
+	var actions []string
+	for _, permission := range r.RolePermissions {
+		for _, resourceAction := range permission.ResourceActions {
+			actions = append(actions, resourceAction.AllowedResourceActions...)
+		}
+	}
+	return actions
+}
+
+// GetRoleDefinitions retrieves a list of built-in and custom Intune roles.
+func (c *Client) GetRoleDefinitions() (*ResponseRoleDefinitionsList, error) {
+	endpoint := uriBetaRoleDefinitions
+
+	var roleDefinitions ResponseRoleDefinitionsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &roleDefinitions)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role definitions", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &roleDefinitions, nil
+}
+
+// GetRoleDefinitionByID retrieves an Intune role by its ID.
+func (c *Client) GetRoleDefinitionByID(id string) (*ResourceRoleDefinition, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleDefinitions, id)
+
+	var roleDefinition ResourceRoleDefinition
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &roleDefinition)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "role definition", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &roleDefinition, nil
+}
+
+// GetRoleDefinitionByDisplayName retrieves an Intune role by its display name.
+func (c *Client) GetRoleDefinitionByDisplayName(displayName string) (*ResourceRoleDefinition, error) {
+	roleDefinitions, err := c.GetRoleDefinitions()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role definitions", err)
+	}
+
+	for _, roleDefinition := range roleDefinitions.Value {
+		if roleDefinition.DisplayName == displayName {
+			return &roleDefinition, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "role definition", displayName, "role not found")
+}
+
+// GetRoleDefinitionAssignments retrieves the assignments of an Intune role.
+func (c *Client) GetRoleDefinitionAssignments(id string) (*ResponseRoleAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/roleAssignments", uriBetaRoleDefinitions, id)
+
+	var roleAssignments ResponseRoleAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &roleAssignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "role definition assignments", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &roleAssignments, nil
+}
+
+// CreateRoleDefinition creates a custom Intune role.
+func (c *Client) CreateRoleDefinition(request *ResourceRoleDefinition) (*ResourceRoleDefinition, error) {
+	endpoint := uriBetaRoleDefinitions
+
+	// Set graph metadata values
+	request.ODataType = odataTypeDeviceAndAppManagementRoleDefinition
+
+	var createdRoleDefinition ResourceRoleDefinition
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdRoleDefinition)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "role definition", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdRoleDefinition, nil
+}
+
+// UpdateRoleDefinitionByID updates a custom Intune role by its ID. Built-in roles cannot be changed.
+func (c *Client) UpdateRoleDefinitionByID(id string, request *ResourceRoleDefinition) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleDefinitions, id)
+
+	// Set graph metadata values
+	request.ODataType = odataTypeDeviceAndAppManagementRoleDefinition
+
+	payload := *request
+	payload.ID = ""
+	payload.IsBuiltIn = false
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "role definition", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateRoleDefinitionByDisplayName updates a custom Intune role by its display name.
+func (c *Client) UpdateRoleDefinitionByDisplayName(displayName string, request *ResourceRoleDefinition) error {
+	roleDefinition, err := c.GetRoleDefinitionByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "role definition", displayName, err)
+	}
+
+	return c.UpdateRoleDefinitionByID(roleDefinition.ID, request)
+}
+
+// DeleteRoleDefinitionByID deletes a custom Intune role by its ID.
+func (c *Client) DeleteRoleDefinitionByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleDefinitions, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "role definition", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteRoleDefinitionByDisplayName deletes a custom Intune role by its display name.
+func (c *Client) DeleteRoleDefinitionByDisplayName(displayName string) error {
+	roleDefinition, err := c.GetRoleDefinitionByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "role definition", displayName, err)
+	}
+
+	return c.DeleteRoleDefinitionByID(roleDefinition.ID)
+}
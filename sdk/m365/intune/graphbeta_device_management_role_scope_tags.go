@@ -0,0 +1,275 @@
+// graphbeta_device_management_role_scope_tags.go
+// Graph Beta Api - Intune: Scope Tags
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/scope-tags
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/RolesLandingMenuBlade/~/scopeTags
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-rbac-rolescopetag?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"strings"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaRoleScopeTags                = "/beta/deviceManagement/roleScopeTags"
+	odataTypeRoleScopeTag               = "#microsoft.graph.roleScopeTag"
+	odataTypeRoleScopeTagAutoAssignment = "#microsoft.graph.roleScopeTagAutoAssignment"
+
+	// RoleScopeTagIDDefault is the ID of the built-in Default scope tag applied to resources created without one.
+	RoleScopeTagIDDefault = "0"
+)
+
+// ResponseRoleScopeTagsList represents a list of scope tags.
+type ResponseRoleScopeTagsList struct {
+	ODataContext string                 `json:"@odata.context"`
+	Value        []ResourceRoleScopeTag `json:"value"`
+}
+
+// ResourceRoleScopeTag represents an Intune scope tag.
+type ResourceRoleScopeTag struct {
+	ODataType   string `json:"@odata.type,omitempty"`
+	ID          string `json:"id,omitempty"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+	IsBuiltIn   bool   `json:"isBuiltIn,omitempty"`
+}
+
+// ResponseRoleScopeTagAssignmentsList represents the groups a scope tag is automatically applied to.
+type ResponseRoleScopeTagAssignmentsList struct {
+	ODataContext string                       `json:"@odata.context"`
+	Value        []RoleScopeTagAutoAssignment `json:"value"`
+}
+
+// AssignmentRoleScopeTag represents the request body of the scope tag assign action. Devices in the targeted
+// groups automatically receive the scope tag.
+type AssignmentRoleScopeTag struct {
+	Assignments []RoleScopeTagAutoAssignment `json:"assignments"`
+}
+
+// RoleScopeTagAutoAssignment represents a group a scope tag is automatically applied to.
+type RoleScopeTagAutoAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// GetRoleScopeTags retrieves a list of scope tags.
+func (c *Client) GetRoleScopeTags() (*ResponseRoleScopeTagsList, error) {
+	endpoint := uriBetaRoleScopeTags
+
+	var scopeTags ResponseRoleScopeTagsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &scopeTags)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role scope tags", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &scopeTags, nil
+}
+
+// GetRoleScopeTagByID retrieves a scope tag by its ID.
+func (c *Client) GetRoleScopeTagByID(id string) (*ResourceRoleScopeTag, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleScopeTags, id)
+
+	var scopeTag ResourceRoleScopeTag
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &scopeTag)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "role scope tag", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &scopeTag, nil
+}
+
+// GetRoleScopeTagByDisplayName retrieves a scope tag by its display name.
+func (c *Client) GetRoleScopeTagByDisplayName(displayName string) (*ResourceRoleScopeTag, error) {
+	scopeTags, err := c.GetRoleScopeTags()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role scope tags", err)
+	}
+
+	for _, scopeTag := range scopeTags.Value {
+		if scopeTag.DisplayName == displayName {
+			return &scopeTag, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "role scope tag", displayName, "scope tag not found")
+}
+
+// ResolveRoleScopeTagIDs converts scope tag display names to the IDs expected by the RoleScopeTagIds field of
+// Intune resources. Names are matched case-insensitively and every unknown name is reported in the error.
+func (c *Client) ResolveRoleScopeTagIDs(displayNames []string) ([]string, error) {
+	scopeTags, err := c.GetRoleScopeTags()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "role scope tags", err)
+	}
+
+	idsByName := make(map[string]string, len(scopeTags.Value))
+	for _, scopeTag := range scopeTags.Value {
+		idsByName[strings.ToLower(scopeTag.DisplayName)] = scopeTag.ID
+	}
+
+	ids := make([]string, 0, len(displayNames))
+	var missing []string
+	for _, displayName := range displayNames {
+		id, ok := idsByName[strings.ToLower(displayName)]
+		if !ok {
+			missing = append(missing, displayName)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("unknown role scope tags: %s", strings.Join(missing, ", "))
+	}
+
+	return ids, nil
+}
+
+// CreateRoleScopeTag creates a scope tag.
+func (c *Client) CreateRoleScopeTag(request *ResourceRoleScopeTag) (*ResourceRoleScopeTag, error) {
+	endpoint := uriBetaRoleScopeTags
+
+	// Set graph metadata values
+	request.ODataType = odataTypeRoleScopeTag
+
+	var createdScopeTag ResourceRoleScopeTag
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdScopeTag)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "role scope tag", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdScopeTag, nil
+}
+
+// CreateRoleScopeTagAssignment sets the groups a scope tag is automatically applied to, replacing any
+// existing assignments.
+func (c *Client) CreateRoleScopeTagAssignment(id string, assignment *AssignmentRoleScopeTag) (*ResponseRoleScopeTagAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaRoleScopeTags, id)
+
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = odataTypeRoleScopeTagAutoAssignment
+	}
+
+	var assignments ResponseRoleScopeTagAssignmentsList
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedAssign, "role scope tag", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// CreateRoleScopeTagWithAssignment creates a scope tag and then applies it automatically to the given groups. If
+// the assignment fails, the created scope tag is returned together with the error so that the caller can retry or
+// delete it.
+func (c *Client) CreateRoleScopeTagWithAssignment(request *ResourceRoleScopeTag, assignment *AssignmentRoleScopeTag) (*ResourceRoleScopeTag, error) {
+	createdScopeTag, err := c.CreateRoleScopeTag(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.CreateRoleScopeTagAssignment(createdScopeTag.ID, assignment); err != nil {
+		return createdScopeTag, err
+	}
+
+	return createdScopeTag, nil
+}
+
+// GetRoleScopeTagAssignments retrieves the groups a scope tag is automatically applied to.
+func (c *Client) GetRoleScopeTagAssignments(id string) (*ResponseRoleScopeTagAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", uriBetaRoleScopeTags, id)
+
+	var assignments ResponseRoleScopeTagAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "role scope tag assignments", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// UpdateRoleScopeTagByID updates a scope tag by its ID.
+func (c *Client) UpdateRoleScopeTagByID(id string, request *ResourceRoleScopeTag) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleScopeTags, id)
+
+	// Set graph metadata values
+	request.ODataType = odataTypeRoleScopeTag
+
+	payload := *request
+	payload.ID = ""
+	payload.IsBuiltIn = false
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "role scope tag", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateRoleScopeTagByDisplayName updates a scope tag by its display name.
+func (c *Client) UpdateRoleScopeTagByDisplayName(displayName string, request *ResourceRoleScopeTag) error {
+	scopeTag, err := c.GetRoleScopeTagByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "role scope tag", displayName, err)
+	}
+
+	return c.UpdateRoleScopeTagByID(scopeTag.ID, request)
+}
+
+// DeleteRoleScopeTagByID deletes a scope tag by its ID. Built-in scope tags cannot be deleted.
+func (c *Client) DeleteRoleScopeTagByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaRoleScopeTags, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "role scope tag", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteRoleScopeTagByDisplayName deletes a scope tag by its display name.
+func (c *Client) DeleteRoleScopeTagByDisplayName(displayName string) error {
+	scopeTag, err := c.GetRoleScopeTagByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "role scope tag", displayName, err)
+	}
+
+	return c.DeleteRoleScopeTagByID(scopeTag.ID)
+}
@@ -956,15 +956,28 @@ func (c *Client) GetWindowsDeviceConfigurationProfileByID(id string) (*ResourceW
 	}
 
 	// Check and decrypt any encrypted OMA settings
+	var encryptedSettings []int
 	for i, setting := range responseDeviceConfigurationProfile.OmaSettings {
 		if setting.IsEncrypted {
-			decryptedValue, err := c.GetDecryptedOmaSetting(uriGraphBetaDeviceManagementWindowsDeviceConfiguration, id, setting.SecretReferenceValueId)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt OMA setting: %v", err)
-			}
-			responseDeviceConfigurationProfile.OmaSettings[i].Value = decryptedValue
+			encryptedSettings = append(encryptedSettings, i)
 		}
 	}
 
+	decryptedValues, err := shared.RunWorkerPool(c.maxConcurrentRequests(), len(encryptedSettings), func(i int) (string, error) {
+		setting := responseDeviceConfigurationProfile.OmaSettings[encryptedSettings[i]]
+		decryptedValue, err := c.GetDecryptedOmaSetting(uriGraphBetaDeviceManagementWindowsDeviceConfiguration, id, setting.SecretReferenceValueId)
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt OMA setting: %v", err)
+		}
+		return decryptedValue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, settingIndex := range encryptedSettings {
+		responseDeviceConfigurationProfile.OmaSettings[settingIndex].Value = decryptedValues[i]
+	}
+
 	return &responseDeviceConfigurationProfile, nil
 }
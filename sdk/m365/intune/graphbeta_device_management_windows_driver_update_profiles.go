@@ -0,0 +1,426 @@
+// graphbeta_device_management_windows_driver_update_profiles.go
+// Graph Beta Api - Intune: Windows driver update profiles
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/windows-driver-updates-policy
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/driverUpdateDeployments
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-softwareupdate-windowsdriverupdateprofile?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-softwareupdate-windowsdriverupdateinventory?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// A driver update profile builds an inventory of the driver updates applicable to its devices. With manual
+// approval each driver is approved, declined or suspended from the inventory; with automatic approval
+// recommended drivers are approved after the deployment deferral.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsDriverUpdateProfiles            = "/beta/deviceManagement/windowsDriverUpdateProfiles"
+	odataTypeWindowsDriverUpdateProfileAssignment = "#microsoft.graph.windowsDriverUpdateProfileAssignment"
+
+	DriverUpdateProfileApprovalTypeManual    = "manual"
+	DriverUpdateProfileApprovalTypeAutomatic = "automatic"
+
+	DriverApprovalActionApprove = "approve"
+	DriverApprovalActionDecline = "decline"
+	DriverApprovalActionSuspend = "suspend"
+
+	DriverApprovalStatusNeedsReview = "needsReview"
+	DriverApprovalStatusDeclined    = "declined"
+	DriverApprovalStatusApproved    = "approved"
+	DriverApprovalStatusSuspended   = "suspended"
+
+	DriverCategoryRecommended        = "recommended"
+	DriverCategoryPreviouslyApproved = "previouslyApproved"
+	DriverCategoryOther              = "other"
+
+	DriverInventorySyncStateSuccess = "success"
+	DriverInventorySyncStateFailure = "failure"
+)
+
+// ResponseWindowsDriverUpdateProfilesList represents a list of Windows driver update profiles.
+type ResponseWindowsDriverUpdateProfilesList struct {
+	ODataContext  string                               `json:"@odata.context"`
+	ODataNextLink string                               `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWindowsDriverUpdateProfile `json:"value"`
+}
+
+// ResourceWindowsDriverUpdateProfile represents a Windows driver update profile. It is used as both the
+// request and response structure; the device and new update counts and the inventory sync status are read only.
+type ResourceWindowsDriverUpdateProfile struct {
+	ODataContext             string                                         `json:"@odata.context,omitempty"`
+	ID                       string                                         `json:"id,omitempty"`
+	DisplayName              string                                         `json:"displayName"`
+	Description              string                                         `json:"description,omitempty"`
+	ApprovalType             string                                         `json:"approvalType"`
+	DeploymentDeferralInDays int                                            `json:"deploymentDeferralInDays"`
+	RoleScopeTagIds          []string                                       `json:"roleScopeTagIds,omitempty"`
+	DeviceReporting          int                                            `json:"deviceReporting,omitempty"`
+	NewUpdates               int                                            `json:"newUpdates,omitempty"`
+	InventorySyncStatus      *WindowsDriverUpdateProfileInventorySyncStatus `json:"inventorySyncStatus,omitempty"`
+	CreatedDateTime          *time.Time                                     `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime     *time.Time                                     `json:"lastModifiedDateTime,omitempty"`
+}
+
+// WindowsDriverUpdateProfileInventorySyncStatus represents the outcome of the last driver inventory sync.
+type WindowsDriverUpdateProfileInventorySyncStatus struct {
+	LastSuccessfulSyncDateTime *time.Time `json:"lastSuccessfulSyncDateTime,omitempty"`
+	DriverInventorySyncState   string     `json:"driverInventorySyncState"`
+}
+
+// ResponseWindowsDriverUpdateInventoriesList represents the driver inventory of a driver update profile.
+type ResponseWindowsDriverUpdateInventoriesList struct {
+	ODataContext  string                         `json:"@odata.context"`
+	ODataNextLink string                         `json:"@odata.nextLink,omitempty"`
+	Value         []WindowsDriverUpdateInventory `json:"value"`
+}
+
+// WindowsDriverUpdateInventory represents a driver update applicable to the devices of a driver update profile.
+type WindowsDriverUpdateInventory struct {
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	Version               string     `json:"version"`
+	Manufacturer          string     `json:"manufacturer"`
+	DriverClass           string     `json:"driverClass"`
+	ReleaseDateTime       *time.Time `json:"releaseDateTime,omitempty"`
+	ApplicableDeviceCount int        `json:"applicableDeviceCount"`
+	ApprovalStatus        string     `json:"approvalStatus"`
+	Category              string     `json:"category"`
+	DeployDateTime        *time.Time `json:"deployDateTime,omitempty"`
+}
+
+// ExecuteActionWindowsDriverUpdateProfile represents the request body of the driver update profile
+// executeAction action, which approves, declines or suspends drivers in bulk.
+type ExecuteActionWindowsDriverUpdateProfile struct {
+	ActionName     string     `json:"actionName"`
+	DriverIDs      []string   `json:"driverIds"`
+	DeploymentDate *time.Time `json:"deploymentDate,omitempty"`
+}
+
+// ResponseWindowsDriverBulkActionResult represents the outcome of a bulk driver approval action per driver.
+type ResponseWindowsDriverBulkActionResult struct {
+	ODataContext        string   `json:"@odata.context"`
+	SuccessfulDriverIDs []string `json:"successfulDriverIds"`
+	FailedDriverIDs     []string `json:"failedDriverIds"`
+	NotFoundDriverIDs   []string `json:"notFoundDriverIds"`
+}
+
+// validateWindowsDriverUpdateProfile checks the approval type and deployment deferral of a driver update profile.
+func validateWindowsDriverUpdateProfile(profile *ResourceWindowsDriverUpdateProfile) error {
+	switch profile.ApprovalType {
+	case DriverUpdateProfileApprovalTypeManual:
+		if profile.DeploymentDeferralInDays != 0 {
+			return fmt.Errorf("a deployment deferral is only supported with automatic approval")
+		}
+	case DriverUpdateProfileApprovalTypeAutomatic:
+		if profile.DeploymentDeferralInDays < 0 || profile.DeploymentDeferralInDays > 30 {
+			return fmt.Errorf("deployment deferral must be between 0 and 30 days, got %d", profile.DeploymentDeferralInDays)
+		}
+	default:
+		return fmt.Errorf("unsupported driver update approval type %q", profile.ApprovalType)
+	}
+
+	return nil
+}
+
+// GetWindowsDriverUpdateProfiles retrieves a list of all Windows driver update profiles.
+func (c *Client) GetWindowsDriverUpdateProfiles() (*ResponseWindowsDriverUpdateProfilesList, error) {
+	endpoint := uriBetaWindowsDriverUpdateProfiles
+
+	var profiles ResponseWindowsDriverUpdateProfilesList
+	for endpoint != "" {
+		var page ResponseWindowsDriverUpdateProfilesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows driver update profiles", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if profiles.ODataContext == "" {
+			profiles.ODataContext = page.ODataContext
+		}
+		profiles.Value = append(profiles.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows driver update profiles", err)
+			}
+		}
+	}
+
+	return &profiles, nil
+}
+
+// GetWindowsDriverUpdateProfileByID retrieves a Windows driver update profile by its ID.
+func (c *Client) GetWindowsDriverUpdateProfileByID(profileID string) (*ResourceWindowsDriverUpdateProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	var profile ResourceWindowsDriverUpdateProfile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows driver update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profile, nil
+}
+
+// GetWindowsDriverUpdateProfileByDisplayName retrieves a Windows driver update profile by its display name.
+func (c *Client) GetWindowsDriverUpdateProfileByDisplayName(displayName string) (*ResourceWindowsDriverUpdateProfile, error) {
+	profiles, err := c.GetWindowsDriverUpdateProfiles()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows driver update profiles", err)
+	}
+
+	var profileID string
+	for _, profile := range profiles.Value {
+		if profile.DisplayName == displayName {
+			profileID = profile.ID
+			break
+		}
+	}
+
+	if profileID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows driver update profile", displayName, "profile not found")
+	}
+
+	return c.GetWindowsDriverUpdateProfileByID(profileID)
+}
+
+// CreateWindowsDriverUpdateProfile creates a new Windows driver update profile.
+func (c *Client) CreateWindowsDriverUpdateProfile(request *ResourceWindowsDriverUpdateProfile) (*ResourceWindowsDriverUpdateProfile, error) {
+	if err := validateWindowsDriverUpdateProfile(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows driver update profile", err)
+	}
+
+	endpoint := uriBetaWindowsDriverUpdateProfiles
+
+	var createdProfile ResourceWindowsDriverUpdateProfile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows driver update profile", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdProfile, nil
+}
+
+// CreateWindowsDriverUpdateProfileWithAssignment creates a new Windows driver update profile and assigns it.
+func (c *Client) CreateWindowsDriverUpdateProfileWithAssignment(request *ResourceWindowsDriverUpdateProfile, assignment *AssignmentWindowsUpdateProfile) (*ResourceWindowsDriverUpdateProfile, error) {
+	createdProfile, err := c.CreateWindowsDriverUpdateProfile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateWindowsDriverUpdateProfileAssignment(createdProfile.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdProfile, nil
+}
+
+// UpdateWindowsDriverUpdateProfileByID updates a Windows driver update profile by its ID using the PATCH
+// method. The approval type of a profile cannot be changed after it is created.
+func (c *Client) UpdateWindowsDriverUpdateProfileByID(profileID string, request *ResourceWindowsDriverUpdateProfile) (*ResourceWindowsDriverUpdateProfile, error) {
+	if err := validateWindowsDriverUpdateProfile(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows driver update profile", profileID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	// Exclude read only properties from the request object
+	patch := *request
+	patch.DeviceReporting = 0
+	patch.NewUpdates = 0
+	patch.InventorySyncStatus = nil
+
+	var updatedProfile ResourceWindowsDriverUpdateProfile
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, &updatedProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows driver update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedProfile, nil
+}
+
+// UpdateWindowsDriverUpdateProfileByDisplayName updates a Windows driver update profile by its display name.
+func (c *Client) UpdateWindowsDriverUpdateProfileByDisplayName(displayName string, request *ResourceWindowsDriverUpdateProfile) (*ResourceWindowsDriverUpdateProfile, error) {
+	profile, err := c.GetWindowsDriverUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "windows driver update profile", displayName, err)
+	}
+
+	return c.UpdateWindowsDriverUpdateProfileByID(profile.ID, request)
+}
+
+// DeleteWindowsDriverUpdateProfileByID deletes a Windows driver update profile by its ID.
+func (c *Client) DeleteWindowsDriverUpdateProfileByID(profileID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows driver update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsDriverUpdateProfileByDisplayName deletes a Windows driver update profile by its display name.
+func (c *Client) DeleteWindowsDriverUpdateProfileByDisplayName(displayName string) error {
+	profile, err := c.GetWindowsDriverUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "windows driver update profile", displayName, err)
+	}
+
+	return c.DeleteWindowsDriverUpdateProfileByID(profile.ID)
+}
+
+// GetWindowsDriverUpdateInventories retrieves the driver updates applicable to the devices of a driver update
+// profile with their approval status.
+func (c *Client) GetWindowsDriverUpdateInventories(profileID string) (*ResponseWindowsDriverUpdateInventoriesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/driverInventories", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	var inventories ResponseWindowsDriverUpdateInventoriesList
+	for endpoint != "" {
+		var page ResponseWindowsDriverUpdateInventoriesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows driver update inventories", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if inventories.ODataContext == "" {
+			inventories.ODataContext = page.ODataContext
+		}
+		inventories.Value = append(inventories.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows driver update inventories", err)
+			}
+		}
+	}
+
+	return &inventories, nil
+}
+
+// SyncWindowsDriverUpdateInventory requests a refresh of the driver inventory of a driver update profile.
+func (c *Client) SyncWindowsDriverUpdateInventory(profileID string) error {
+	endpoint := fmt.Sprintf("%s/%s/syncInventory", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows driver update inventory", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// executeWindowsDriverUpdateAction approves, declines or suspends drivers of a driver update profile in bulk.
+func (c *Client) executeWindowsDriverUpdateAction(profileID string, request *ExecuteActionWindowsDriverUpdateProfile) (*ResponseWindowsDriverBulkActionResult, error) {
+	if len(request.DriverIDs) == 0 {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows driver update approvals", profileID, "no driver IDs supplied")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/executeAction", uriBetaWindowsDriverUpdateProfiles, profileID)
+
+	var result ResponseWindowsDriverBulkActionResult
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &result)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows driver update approvals", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &result, nil
+}
+
+// ApproveWindowsDriverUpdates approves drivers from the inventory of a driver update profile for deployment
+// from deploymentDate, or immediately when it is zero. The result lists the drivers the action failed for.
+func (c *Client) ApproveWindowsDriverUpdates(profileID string, driverIDs []string, deploymentDate time.Time) (*ResponseWindowsDriverBulkActionResult, error) {
+	request := &ExecuteActionWindowsDriverUpdateProfile{
+		ActionName: DriverApprovalActionApprove,
+		DriverIDs:  driverIDs,
+	}
+	if !deploymentDate.IsZero() {
+		request.DeploymentDate = &deploymentDate
+	}
+
+	return c.executeWindowsDriverUpdateAction(profileID, request)
+}
+
+// DeclineWindowsDriverUpdates declines drivers from the inventory of a driver update profile so they are
+// not offered to its devices.
+func (c *Client) DeclineWindowsDriverUpdates(profileID string, driverIDs []string) (*ResponseWindowsDriverBulkActionResult, error) {
+	return c.executeWindowsDriverUpdateAction(profileID, &ExecuteActionWindowsDriverUpdateProfile{
+		ActionName: DriverApprovalActionDecline,
+		DriverIDs:  driverIDs,
+	})
+}
+
+// SuspendWindowsDriverUpdates suspends previously approved drivers of a driver update profile, stopping
+// further deployment until they are approved again.
+func (c *Client) SuspendWindowsDriverUpdates(profileID string, driverIDs []string) (*ResponseWindowsDriverBulkActionResult, error) {
+	return c.executeWindowsDriverUpdateAction(profileID, &ExecuteActionWindowsDriverUpdateProfile{
+		ActionName: DriverApprovalActionSuspend,
+		DriverIDs:  driverIDs,
+	})
+}
+
+// GetWindowsDriverUpdateProfileAssignments retrieves the assignments of a Windows driver update profile.
+func (c *Client) GetWindowsDriverUpdateProfileAssignments(profileID string) (*ResponseWindowsUpdateProfileAssignmentsList, error) {
+	return c.getWindowsUpdateProfileAssignments(uriBetaWindowsDriverUpdateProfiles, "windows driver update profile", profileID)
+}
+
+// CreateWindowsDriverUpdateProfileAssignment assigns a Windows driver update profile. The supplied assignments
+// replace any existing assignments of the profile.
+func (c *Client) CreateWindowsDriverUpdateProfileAssignment(profileID string, assignment *AssignmentWindowsUpdateProfile) error {
+	return c.assignWindowsUpdateProfile(uriBetaWindowsDriverUpdateProfiles, "windows driver update profile", profileID, odataTypeWindowsDriverUpdateProfileAssignment, assignment)
+}
+
+// GetWindowsDriverUpdateProfileStatusSummary retrieves the deployment state of each driver of a driver update
+// profile from the driver update policy status summary report, as rows keyed by column name.
+func (c *Client) GetWindowsDriverUpdateProfileStatusSummary(profileID string, options *ReportExportJobOptions) ([]map[string]string, error) {
+	rows, err := c.ExportReportRows(&ResourceDeviceManagementExportJob{
+		ReportName: ReportNameDriverUpdatePolicyStatusSummary,
+		Filter:     fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(profileID)),
+	}, options)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows driver update profile status summary", profileID, err)
+	}
+
+	return rows, nil
+}
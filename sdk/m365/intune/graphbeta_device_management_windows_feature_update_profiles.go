@@ -0,0 +1,231 @@
+// graphbeta_device_management_windows_feature_update_profiles.go
+// Graph Beta Api - Intune: Windows feature update profiles
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/windows-10-feature-updates
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/featureUpdateDeployments
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-softwareupdate-windowsfeatureupdateprofile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsFeatureUpdateProfiles            = "/beta/deviceManagement/windowsFeatureUpdateProfiles"
+	odataTypeWindowsFeatureUpdateProfileAssignment = "#microsoft.graph.windowsFeatureUpdateProfileAssignment"
+)
+
+// ResponseWindowsFeatureUpdateProfilesList represents a list of Windows feature update profiles.
+type ResponseWindowsFeatureUpdateProfilesList struct {
+	ODataContext  string                                `json:"@odata.context"`
+	ODataNextLink string                                `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWindowsFeatureUpdateProfile `json:"value"`
+}
+
+// ResourceWindowsFeatureUpdateProfile represents a Windows feature update profile, which holds devices at a
+// feature update version. It is used as both the request and response structure. FeatureUpdateVersion is the
+// version of a feature update catalog item, e.g. "Windows 11, version 23H2".
+type ResourceWindowsFeatureUpdateProfile struct {
+	ODataContext                                      string                        `json:"@odata.context,omitempty"`
+	ID                                                string                        `json:"id,omitempty"`
+	DisplayName                                       string                        `json:"displayName"`
+	Description                                       string                        `json:"description,omitempty"`
+	FeatureUpdateVersion                              string                        `json:"featureUpdateVersion"`
+	RoleScopeTagIds                                   []string                      `json:"roleScopeTagIds,omitempty"`
+	RolloutSettings                                   *WindowsUpdateRolloutSettings `json:"rolloutSettings,omitempty"`
+	InstallLatestWindows10OnWindows11IneligibleDevice bool                          `json:"installLatestWindows10OnWindows11IneligibleDevice"`
+	InstallFeatureUpdatesOptional                     bool                          `json:"installFeatureUpdatesOptional"`
+	DeployableContentDisplayName                      string                        `json:"deployableContentDisplayName,omitempty"`
+	EndOfSupportDate                                  *time.Time                    `json:"endOfSupportDate,omitempty"`
+	CreatedDateTime                                   *time.Time                    `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime                              *time.Time                    `json:"lastModifiedDateTime,omitempty"`
+}
+
+// WindowsUpdateRolloutSettings represents a gradual rollout of a feature update, offered to groups of devices
+// every OfferIntervalInDays between the offer start and end dates.
+type WindowsUpdateRolloutSettings struct {
+	OfferStartDateTimeInUTC *time.Time `json:"offerStartDateTimeInUTC,omitempty"`
+	OfferEndDateTimeInUTC   *time.Time `json:"offerEndDateTimeInUTC,omitempty"`
+	OfferIntervalInDays     int        `json:"offerIntervalInDays,omitempty"`
+}
+
+// GetWindowsFeatureUpdateProfiles retrieves a list of all Windows feature update profiles.
+func (c *Client) GetWindowsFeatureUpdateProfiles() (*ResponseWindowsFeatureUpdateProfilesList, error) {
+	endpoint := uriBetaWindowsFeatureUpdateProfiles
+
+	var profiles ResponseWindowsFeatureUpdateProfilesList
+	for endpoint != "" {
+		var page ResponseWindowsFeatureUpdateProfilesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows feature update profiles", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if profiles.ODataContext == "" {
+			profiles.ODataContext = page.ODataContext
+		}
+		profiles.Value = append(profiles.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows feature update profiles", err)
+			}
+		}
+	}
+
+	return &profiles, nil
+}
+
+// GetWindowsFeatureUpdateProfileByID retrieves a Windows feature update profile by its ID.
+func (c *Client) GetWindowsFeatureUpdateProfileByID(profileID string) (*ResourceWindowsFeatureUpdateProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsFeatureUpdateProfiles, profileID)
+
+	var profile ResourceWindowsFeatureUpdateProfile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows feature update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profile, nil
+}
+
+// GetWindowsFeatureUpdateProfileByDisplayName retrieves a Windows feature update profile by its display name.
+func (c *Client) GetWindowsFeatureUpdateProfileByDisplayName(displayName string) (*ResourceWindowsFeatureUpdateProfile, error) {
+	profiles, err := c.GetWindowsFeatureUpdateProfiles()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows feature update profiles", err)
+	}
+
+	var profileID string
+	for _, profile := range profiles.Value {
+		if profile.DisplayName == displayName {
+			profileID = profile.ID
+			break
+		}
+	}
+
+	if profileID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows feature update profile", displayName, "profile not found")
+	}
+
+	return c.GetWindowsFeatureUpdateProfileByID(profileID)
+}
+
+// CreateWindowsFeatureUpdateProfile creates a new Windows feature update profile.
+func (c *Client) CreateWindowsFeatureUpdateProfile(request *ResourceWindowsFeatureUpdateProfile) (*ResourceWindowsFeatureUpdateProfile, error) {
+	if request.FeatureUpdateVersion == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows feature update profile", "a feature update version is required")
+	}
+
+	endpoint := uriBetaWindowsFeatureUpdateProfiles
+
+	var createdProfile ResourceWindowsFeatureUpdateProfile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows feature update profile", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdProfile, nil
+}
+
+// CreateWindowsFeatureUpdateProfileWithAssignment creates a new Windows feature update profile and assigns it.
+func (c *Client) CreateWindowsFeatureUpdateProfileWithAssignment(request *ResourceWindowsFeatureUpdateProfile, assignment *AssignmentWindowsUpdateProfile) (*ResourceWindowsFeatureUpdateProfile, error) {
+	createdProfile, err := c.CreateWindowsFeatureUpdateProfile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateWindowsFeatureUpdateProfileAssignment(createdProfile.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdProfile, nil
+}
+
+// UpdateWindowsFeatureUpdateProfileByID updates a Windows feature update profile by its ID using the PATCH method.
+func (c *Client) UpdateWindowsFeatureUpdateProfileByID(profileID string, request *ResourceWindowsFeatureUpdateProfile) (*ResourceWindowsFeatureUpdateProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsFeatureUpdateProfiles, profileID)
+
+	var updatedProfile ResourceWindowsFeatureUpdateProfile
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, request, &updatedProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows feature update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedProfile, nil
+}
+
+// UpdateWindowsFeatureUpdateProfileByDisplayName updates a Windows feature update profile by its display name.
+func (c *Client) UpdateWindowsFeatureUpdateProfileByDisplayName(displayName string, request *ResourceWindowsFeatureUpdateProfile) (*ResourceWindowsFeatureUpdateProfile, error) {
+	profile, err := c.GetWindowsFeatureUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "windows feature update profile", displayName, err)
+	}
+
+	return c.UpdateWindowsFeatureUpdateProfileByID(profile.ID, request)
+}
+
+// DeleteWindowsFeatureUpdateProfileByID deletes a Windows feature update profile by its ID.
+func (c *Client) DeleteWindowsFeatureUpdateProfileByID(profileID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsFeatureUpdateProfiles, profileID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows feature update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsFeatureUpdateProfileByDisplayName deletes a Windows feature update profile by its display name.
+func (c *Client) DeleteWindowsFeatureUpdateProfileByDisplayName(displayName string) error {
+	profile, err := c.GetWindowsFeatureUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "windows feature update profile", displayName, err)
+	}
+
+	return c.DeleteWindowsFeatureUpdateProfileByID(profile.ID)
+}
+
+// GetWindowsFeatureUpdateProfileAssignments retrieves the assignments of a Windows feature update profile.
+func (c *Client) GetWindowsFeatureUpdateProfileAssignments(profileID string) (*ResponseWindowsUpdateProfileAssignmentsList, error) {
+	return c.getWindowsUpdateProfileAssignments(uriBetaWindowsFeatureUpdateProfiles, "windows feature update profile", profileID)
+}
+
+// CreateWindowsFeatureUpdateProfileAssignment assigns a Windows feature update profile. The supplied
+// assignments replace any existing assignments of the profile.
+func (c *Client) CreateWindowsFeatureUpdateProfileAssignment(profileID string, assignment *AssignmentWindowsUpdateProfile) error {
+	return c.assignWindowsUpdateProfile(uriBetaWindowsFeatureUpdateProfiles, "windows feature update profile", profileID, odataTypeWindowsFeatureUpdateProfileAssignment, assignment)
+}
+
+// GetWindowsFeatureUpdateProfileDeviceStates retrieves the feature update state of each device targeted by a
+// Windows feature update profile from the feature update device state report.
+func (c *Client) GetWindowsFeatureUpdateProfileDeviceStates(profileID string, options *ReportExportJobOptions) ([]WindowsUpdateDeviceState, error) {
+	return c.getWindowsUpdateDeviceStates(ReportNameFeatureUpdateDeviceState, "windows feature update profile", profileID, options)
+}
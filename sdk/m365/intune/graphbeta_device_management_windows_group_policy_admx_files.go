@@ -0,0 +1,372 @@
+// graphbeta_device_management_windows_group_policy_admx_files.go
+// Graph Beta Api - Intune: Local parsing and validation of ADMX and ADML files prior to upload as imported administrative templates
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/configuration/administrative-templates-import-custom
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/configProfiles
+// ADMX schema reference: https://learn.microsoft.com/en-us/previous-versions/windows/desktop/policy/admx-schema
+
+package intune
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// groupPolicyBuiltInNamespaces lists namespaces that Intune provides without an uploaded ADMX file.
+var groupPolicyBuiltInNamespaces = []string{
+	"Microsoft.Policies.Windows",
+}
+
+var (
+	groupPolicyStringReferencePattern       = regexp.MustCompile(`\$\(string\.([^)]+)\)`)
+	groupPolicyPresentationReferencePattern = regexp.MustCompile(`\$\(presentation\.([^)]+)\)`)
+)
+
+// GroupPolicyADMX represents the parts of an ADMX file needed to validate it before upload.
+type GroupPolicyADMX struct {
+	XMLName       xml.Name                   `xml:"policyDefinitions"`
+	Revision      string                     `xml:"revision,attr"`
+	SchemaVersion string                     `xml:"schemaVersion,attr"`
+	Target        GroupPolicyADMXNamespace   `xml:"policyNamespaces>target"`
+	Using         []GroupPolicyADMXNamespace `xml:"policyNamespaces>using"`
+	Resources     struct {
+		MinRequiredRevision string `xml:"minRequiredRevision,attr"`
+		FallbackCulture     string `xml:"fallbackCulture,attr"`
+	} `xml:"resources"`
+	SupportedOn []GroupPolicyADMXSupportedOn `xml:"supportedOn>definitions>definition"`
+	Categories  []GroupPolicyADMXCategory    `xml:"categories>category"`
+	Policies    []GroupPolicyADMXPolicy      `xml:"policies>policy"`
+
+	// StringReferences and PresentationReferences are the $(string.x) and $(presentation.x) ids used in the file.
+	StringReferences       []string `xml:"-"`
+	PresentationReferences []string `xml:"-"`
+}
+
+// GroupPolicyADMXNamespace represents a target or using namespace declaration of an ADMX file.
+type GroupPolicyADMXNamespace struct {
+	Prefix    string `xml:"prefix,attr"`
+	Namespace string `xml:"namespace,attr"`
+}
+
+// GroupPolicyADMXSupportedOn represents a supportedOn definition of an ADMX file.
+type GroupPolicyADMXSupportedOn struct {
+	Name        string `xml:"name,attr"`
+	DisplayName string `xml:"displayName,attr"`
+}
+
+// GroupPolicyADMXReference represents a reference to a category or supportedOn definition, optionally namespace prefixed.
+type GroupPolicyADMXReference struct {
+	Ref string `xml:"ref,attr"`
+}
+
+// GroupPolicyADMXCategory represents a category declared by an ADMX file.
+type GroupPolicyADMXCategory struct {
+	Name           string                    `xml:"name,attr"`
+	DisplayName    string                    `xml:"displayName,attr"`
+	ExplainText    string                    `xml:"explainText,attr"`
+	ParentCategory *GroupPolicyADMXReference `xml:"parentCategory"`
+}
+
+// GroupPolicyADMXPolicy represents a policy declared by an ADMX file.
+type GroupPolicyADMXPolicy struct {
+	Name           string                    `xml:"name,attr"`
+	Class          string                    `xml:"class,attr"`
+	DisplayName    string                    `xml:"displayName,attr"`
+	ExplainText    string                    `xml:"explainText,attr"`
+	Presentation   string                    `xml:"presentation,attr"`
+	Key            string                    `xml:"key,attr"`
+	ValueName      string                    `xml:"valueName,attr"`
+	ParentCategory *GroupPolicyADMXReference `xml:"parentCategory"`
+	SupportedOn    *GroupPolicyADMXReference `xml:"supportedOn"`
+	Elements       GroupPolicyADMXElements   `xml:"elements"`
+}
+
+// GroupPolicyADMXElements represents the configurable elements of an ADMX policy.
+type GroupPolicyADMXElements struct {
+	Items []GroupPolicyADMXElement `xml:",any"`
+}
+
+// GroupPolicyADMXElement represents an element (text, decimal, boolean, enum, list or multiText) of an ADMX policy.
+type GroupPolicyADMXElement struct {
+	XMLName xml.Name
+	ID      string `xml:"id,attr"`
+}
+
+// GroupPolicyADML represents the parts of an ADML language file needed to validate it against its ADMX file.
+type GroupPolicyADML struct {
+	XMLName       xml.Name                      `xml:"policyDefinitionResources"`
+	Revision      string                        `xml:"revision,attr"`
+	SchemaVersion string                        `xml:"schemaVersion,attr"`
+	Strings       []GroupPolicyADMLString       `xml:"resources>stringTable>string"`
+	Presentations []GroupPolicyADMLPresentation `xml:"resources>presentationTable>presentation"`
+}
+
+// GroupPolicyADMLString represents a localised string of an ADML file.
+type GroupPolicyADMLString struct {
+	ID    string `xml:"id,attr"`
+	Value string `xml:",chardata"`
+}
+
+// GroupPolicyADMLPresentation represents a presentation of an ADML file and the ADMX elements its controls refer to.
+type GroupPolicyADMLPresentation struct {
+	ID       string                       `xml:"id,attr"`
+	Controls []GroupPolicyADMLPresControl `xml:",any"`
+}
+
+// GroupPolicyADMLPresControl represents a control of an ADML presentation, such as a textBox or dropdownList.
+type GroupPolicyADMLPresControl struct {
+	XMLName xml.Name
+	RefID   string `xml:"refId,attr"`
+}
+
+// GroupPolicyDefinitionFileReport summarises a validated ADMX file and its language files.
+type GroupPolicyDefinitionFileReport struct {
+	TargetPrefix         string
+	TargetNamespace      string
+	Revision             string
+	ReferencedNamespaces []GroupPolicyADMXNamespace
+	// RequiredNamespaces are referenced namespaces that are not built in to Intune and must be uploaded first.
+	RequiredNamespaces []string
+	LanguageCodes      []string
+	CategoryCount      int
+	PolicyCount        int
+}
+
+// ParseGroupPolicyADMX parses the content of an ADMX file.
+func ParseGroupPolicyADMX(data []byte) (*GroupPolicyADMX, error) {
+	var admx GroupPolicyADMX
+	if err := xml.Unmarshal(data, &admx); err != nil {
+		return nil, fmt.Errorf("failed to parse ADMX file, error: %v", err)
+	}
+
+	admx.StringReferences = uniqueGroupPolicyReferences(groupPolicyStringReferencePattern, data)
+	admx.PresentationReferences = uniqueGroupPolicyReferences(groupPolicyPresentationReferencePattern, data)
+
+	return &admx, nil
+}
+
+// ParseGroupPolicyADML parses the content of an ADML language file.
+func ParseGroupPolicyADML(data []byte) (*GroupPolicyADML, error) {
+	var adml GroupPolicyADML
+	if err := xml.Unmarshal(data, &adml); err != nil {
+		return nil, fmt.Errorf("failed to parse ADML file, error: %v", err)
+	}
+
+	return &adml, nil
+}
+
+// ParseGroupPolicyADMXFile reads and parses an ADMX file from disk.
+func ParseGroupPolicyADMXFile(path string) (*GroupPolicyADMX, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ADMX file %s, error: %v", path, err)
+	}
+
+	return ParseGroupPolicyADMX(data)
+}
+
+// ParseGroupPolicyADMLFile reads and parses an ADML language file from disk.
+func ParseGroupPolicyADMLFile(path string) (*GroupPolicyADML, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ADML file %s, error: %v", path, err)
+	}
+
+	return ParseGroupPolicyADML(data)
+}
+
+// Validate checks the structure of the ADMX file on its own: namespace declarations, categories, policies and
+// the namespace prefixes used by references. All problems found are returned together.
+func (admx *GroupPolicyADMX) Validate() error {
+	var errs []error
+
+	if admx.Target.Prefix == "" || admx.Target.Namespace == "" {
+		errs = append(errs, errors.New("ADMX file must declare a target prefix and namespace"))
+	}
+
+	prefixes := map[string]bool{admx.Target.Prefix: true}
+	for _, using := range admx.Using {
+		if using.Prefix == "" || using.Namespace == "" {
+			errs = append(errs, errors.New("ADMX using declarations must have a prefix and namespace"))
+			continue
+		}
+		prefixes[using.Prefix] = true
+	}
+
+	categories := make(map[string]bool, len(admx.Categories))
+	for _, category := range admx.Categories {
+		if category.Name == "" {
+			errs = append(errs, errors.New("ADMX category is missing a name"))
+			continue
+		}
+		if categories[category.Name] {
+			errs = append(errs, fmt.Errorf("ADMX category %q is declared more than once", category.Name))
+		}
+		categories[category.Name] = true
+	}
+
+	supportedOn := make(map[string]bool, len(admx.SupportedOn))
+	for _, definition := range admx.SupportedOn {
+		supportedOn[definition.Name] = true
+	}
+
+	checkReference := func(ownerKind, owner, kind, ref string, local map[string]bool) {
+		prefix, name, prefixed := strings.Cut(ref, ":")
+		if !prefixed {
+			name = prefix
+			prefix = admx.Target.Prefix
+		}
+		if !prefixes[prefix] {
+			errs = append(errs, fmt.Errorf("ADMX %s %q references %s %q with undeclared namespace prefix %q", ownerKind, owner, kind, ref, prefix))
+			return
+		}
+		if prefix == admx.Target.Prefix && !local[name] {
+			errs = append(errs, fmt.Errorf("ADMX %s %q references unknown %s %q", ownerKind, owner, kind, ref))
+		}
+	}
+
+	for _, category := range admx.Categories {
+		if category.ParentCategory != nil {
+			checkReference("category", category.Name, "category", category.ParentCategory.Ref, categories)
+		}
+	}
+
+	policies := make(map[string]bool, len(admx.Policies))
+	for _, policy := range admx.Policies {
+		if policy.Name == "" {
+			errs = append(errs, errors.New("ADMX policy is missing a name"))
+			continue
+		}
+		if policies[policy.Name] {
+			errs = append(errs, fmt.Errorf("ADMX policy %q is declared more than once", policy.Name))
+		}
+		policies[policy.Name] = true
+
+		switch policy.Class {
+		case "User", "Machine", "Both":
+		default:
+			errs = append(errs, fmt.Errorf("ADMX policy %q has invalid class %q, expected User, Machine or Both", policy.Name, policy.Class))
+		}
+		if policy.Key == "" {
+			errs = append(errs, fmt.Errorf("ADMX policy %q is missing a registry key", policy.Name))
+		}
+		if policy.ParentCategory == nil {
+			errs = append(errs, fmt.Errorf("ADMX policy %q is missing a parent category", policy.Name))
+		} else {
+			checkReference("policy", policy.Name, "category", policy.ParentCategory.Ref, categories)
+		}
+		if policy.SupportedOn != nil {
+			checkReference("policy", policy.Name, "supportedOn definition", policy.SupportedOn.Ref, supportedOn)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateAgainst checks that the ADML language file defines every string and presentation referenced by the
+// ADMX file, and that every presentation control refers to an element declared by the ADMX file.
+func (adml *GroupPolicyADML) ValidateAgainst(admx *GroupPolicyADMX) error {
+	var errs []error
+
+	strs := make(map[string]bool, len(adml.Strings))
+	for _, str := range adml.Strings {
+		strs[str.ID] = true
+	}
+	for _, id := range admx.StringReferences {
+		if !strs[id] {
+			errs = append(errs, fmt.Errorf("ADML file is missing string %q", id))
+		}
+	}
+
+	presentations := make(map[string]GroupPolicyADMLPresentation, len(adml.Presentations))
+	for _, presentation := range adml.Presentations {
+		presentations[presentation.ID] = presentation
+	}
+	for _, id := range admx.PresentationReferences {
+		if _, ok := presentations[id]; !ok {
+			errs = append(errs, fmt.Errorf("ADML file is missing presentation %q", id))
+		}
+	}
+
+	for _, policy := range admx.Policies {
+		id := groupPolicyPresentationReferencePattern.FindStringSubmatch(policy.Presentation)
+		if id == nil {
+			continue
+		}
+		presentation, ok := presentations[id[1]]
+		if !ok {
+			continue
+		}
+
+		elements := make(map[string]bool, len(policy.Elements.Items))
+		for _, element := range policy.Elements.Items {
+			elements[element.ID] = true
+		}
+		for _, control := range presentation.Controls {
+			if control.RefID != "" && !elements[control.RefID] {
+				errs = append(errs, fmt.Errorf("ADML presentation %q control %s refers to element %q not declared by policy %q", presentation.ID, control.XMLName.Local, control.RefID, policy.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGroupPolicyDefinitionFiles validates an ADMX file together with its language files, keyed by language
+// code such as "en-US", and reports the namespaces it declares and depends on.
+func ValidateGroupPolicyDefinitionFiles(admx *GroupPolicyADMX, admls map[string]*GroupPolicyADML) (*GroupPolicyDefinitionFileReport, error) {
+	var errs []error
+
+	if err := admx.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(admls) == 0 {
+		errs = append(errs, errors.New("at least one ADML language file is required"))
+	}
+
+	report := &GroupPolicyDefinitionFileReport{
+		TargetPrefix:         admx.Target.Prefix,
+		TargetNamespace:      admx.Target.Namespace,
+		Revision:             admx.Revision,
+		ReferencedNamespaces: admx.Using,
+		CategoryCount:        len(admx.Categories),
+		PolicyCount:          len(admx.Policies),
+	}
+
+	for languageCode, adml := range admls {
+		report.LanguageCodes = append(report.LanguageCodes, languageCode)
+		if err := adml.ValidateAgainst(admx); err != nil {
+			errs = append(errs, fmt.Errorf("language %s: %w", languageCode, err))
+		}
+	}
+	sort.Strings(report.LanguageCodes)
+
+	for _, using := range admx.Using {
+		if !containsString(groupPolicyBuiltInNamespaces, using.Namespace) && using.Namespace != admx.Target.Namespace {
+			report.RequiredNamespaces = append(report.RequiredNamespaces, using.Namespace)
+		}
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// uniqueGroupPolicyReferences returns the sorted, de-duplicated ids captured by pattern in data.
+func uniqueGroupPolicyReferences(pattern *regexp.Regexp, data []byte) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, match := range pattern.FindAllSubmatch(data, -1) {
+		id := string(match[1])
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids
+}
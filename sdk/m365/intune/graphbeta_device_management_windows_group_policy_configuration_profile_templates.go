@@ -17,7 +17,21 @@ import (
 )
 
 // Constant for the endpoint URL
-const uriBetaDeviceManagementGroupPolicyConfigurations = "/beta/deviceManagement/groupPolicyConfigurations"
+const (
+	uriBetaDeviceManagementGroupPolicyConfigurations = "/beta/deviceManagement/groupPolicyConfigurations"
+	odataTypeGroupPolicyConfigurationAssignment      = "#microsoft.graph.groupPolicyConfigurationAssignment"
+)
+
+// Presentation value types used when setting the value of a group policy definition presentation.
+// Dropdown list presentations use the text presentation value type with the value of the selected item.
+const (
+	ODataTypeGroupPolicyPresentationValueText        = "#microsoft.graph.groupPolicyPresentationValueText"
+	ODataTypeGroupPolicyPresentationValueDecimal     = "#microsoft.graph.groupPolicyPresentationValueDecimal"
+	ODataTypeGroupPolicyPresentationValueLongDecimal = "#microsoft.graph.groupPolicyPresentationValueLongDecimal"
+	ODataTypeGroupPolicyPresentationValueBoolean     = "#microsoft.graph.groupPolicyPresentationValueBoolean"
+	ODataTypeGroupPolicyPresentationValueList        = "#microsoft.graph.groupPolicyPresentationValueList"
+	ODataTypeGroupPolicyPresentationValueMultiText   = "#microsoft.graph.groupPolicyPresentationValueMultiText"
+)
 
 /* Struct hierarchy using embedded anonymous structs for reference
 
@@ -201,51 +215,78 @@ func (c *Client) GetDeviceManagementGroupPolicyConfigurations() (*ResponseDevice
 }
 
 // GetDeviceManagementGroupPolicyConfigurationByID retrieves a specific Group Policy Configuration by its ID with expanded details.
+// The configuration, its definition values and its assignments are fetched in parallel, followed by the presentation
+// values of every definition value, bounded by the client's MaxConcurrentRequests.
 func (c *Client) GetDeviceManagementGroupPolicyConfigurationByID(policyConfigurationId string) (*ResourceDeviceManagementGroupPolicyConfiguration, error) {
-	// Retrieve the base Group Policy Configuration
 	baseEndpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementGroupPolicyConfigurations, policyConfigurationId)
-	var baseConfig ResourceDeviceManagementGroupPolicyConfiguration
-	_, err := c.HTTP.DoRequest("GET", baseEndpoint, nil, &baseConfig)
-	if err != nil {
-		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy configuration", policyConfigurationId, err)
-	}
 
-	// Retrieve Definition Values and expand each definition
-	defValuesEndpoint := fmt.Sprintf("%s/definitionValues?$expand=definition", baseEndpoint)
+	var baseConfig ResourceDeviceManagementGroupPolicyConfiguration
 	var definitionValuesList ResponseGroupPolicyDefinitionValuesList
-	_, err = c.HTTP.DoRequest("GET", defValuesEndpoint, nil, &definitionValuesList)
+	var assignmentsList ResponseAssignmentsList
+
+	err := shared.RunConcurrently(c.maxConcurrentRequests(),
+		// Retrieve the base Group Policy Configuration
+		func() error {
+			if err := c.getGroupPolicyConfigurationPart(baseEndpoint, &baseConfig); err != nil {
+				return fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy configuration", policyConfigurationId, err)
+			}
+			return nil
+		},
+		// Retrieve Definition Values and expand each definition
+		func() error {
+			if err := c.getGroupPolicyConfigurationPart(baseEndpoint+"/definitionValues?$expand=definition", &definitionValuesList); err != nil {
+				return fmt.Errorf("failed to get definition values: %v", err)
+			}
+			return nil
+		},
+		// Retrieve Assignments
+		func() error {
+			if err := c.getGroupPolicyConfigurationPart(baseEndpoint+"/assignments", &assignmentsList); err != nil {
+				return fmt.Errorf("failed to get assignments: %v", err)
+			}
+			return nil
+		},
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get definition values: %v", err)
+		return nil, err
 	}
 
 	// For each Definition Value, retrieve and expand Presentation Values
-	for i, definitionValue := range definitionValuesList.Value {
-		presentationEndpoint := fmt.Sprintf("%s/definitionValues/%s/presentationValues?$expand=presentation", baseEndpoint, definitionValue.ID)
+	presentationValues, err := shared.RunWorkerPool(c.maxConcurrentRequests(), len(definitionValuesList.Value), func(i int) ([]GroupPolicyPresentationValue, error) {
+		definitionValueID := definitionValuesList.Value[i].ID
+		presentationEndpoint := fmt.Sprintf("%s/definitionValues/%s/presentationValues?$expand=presentation", baseEndpoint, definitionValueID)
+
 		var presentationList ResponsePresentationValuesList
-		_, err = c.HTTP.DoRequest("GET", presentationEndpoint, nil, &presentationList)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get presentation values: %v", err)
+		if err := c.getGroupPolicyConfigurationPart(presentationEndpoint, &presentationList); err != nil {
+			return nil, fmt.Errorf("failed to get presentation values of definition value %s: %v", definitionValueID, err)
 		}
-		definitionValuesList.Value[i].PresentationValues = presentationList.Value
+		return presentationList.Value, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Attach expanded Definition Values to the base configuration
-	baseConfig.DefinitionValues = definitionValuesList.Value
-
-	// Retrieve Assignments
-	assignmentsEndpoint := fmt.Sprintf("%s/assignments", baseEndpoint)
-	var assignmentsList ResponseAssignmentsList
-	_, err = c.HTTP.DoRequest("GET", assignmentsEndpoint, nil, &assignmentsList)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get assignments: %v", err)
+	for i := range definitionValuesList.Value {
+		definitionValuesList.Value[i].PresentationValues = presentationValues[i]
 	}
 
-	// Attach Assignments to the base configuration
+	// Attach expanded Definition Values and Assignments to the base configuration
+	baseConfig.DefinitionValues = definitionValuesList.Value
 	baseConfig.Assignments = assignmentsList.Value
 
 	return &baseConfig, nil
 }
 
+// getGroupPolicyConfigurationPart performs a GET request for part of a Group Policy Configuration and closes the response body.
+func (c *Client) getGroupPolicyConfigurationPart(endpoint string, out interface{}) error {
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
+
 // GetDeviceManagementGroupPolicyConfigurationByName retrieves a specific Group Policy Configuration by its name.
 func (c *Client) GetDeviceManagementGroupPolicyConfigurationByName(policyConfigurationName string) (*ResourceDeviceManagementGroupPolicyConfiguration, error) {
 	response, err := c.GetDeviceManagementGroupPolicyConfigurations()
@@ -269,3 +310,348 @@ func (c *Client) GetDeviceManagementGroupPolicyConfigurationByName(policyConfigu
 	// Use the found ID to get the full details of the configuration
 	return c.GetDeviceManagementGroupPolicyConfigurationByID(matchedConfigID)
 }
+
+// groupPolicyConfigurationPayload is the writable subset of a Group Policy Configuration sent on create and update.
+type groupPolicyConfigurationPayload struct {
+	DisplayName     string   `json:"displayName,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	RoleScopeTagIds []string `json:"roleScopeTagIds,omitempty"`
+}
+
+// AssignmentDeviceManagementGroupPolicyConfiguration represents the request body of the assign action of a Group Policy Configuration.
+type AssignmentDeviceManagementGroupPolicyConfiguration struct {
+	Assignments []GroupPolicyConfigurationAssignment `json:"assignments"`
+}
+
+// GroupPolicyConfigurationAssignment represents a single assignment of a Group Policy Configuration.
+type GroupPolicyConfigurationAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// GroupPolicyDefinitionValuesUpdate represents the request body of the updateDefinitionValues action, which adds,
+// updates and removes definition values of a Group Policy Configuration in a single call.
+type GroupPolicyDefinitionValuesUpdate struct {
+	Added      []ResourceGroupPolicyDefinitionValue `json:"added"`
+	Updated    []ResourceGroupPolicyDefinitionValue `json:"updated"`
+	DeletedIds []string                             `json:"deletedIds"`
+}
+
+// ResourceGroupPolicyDefinitionValue represents a definition value to add to or update on a Group Policy Configuration.
+// New definition values are bound to their definition with DefinitionBind, existing ones are identified by ID.
+// Binds may be absolute URLs or Graph paths such as /beta/deviceManagement/groupPolicyDefinitions('id').
+type ResourceGroupPolicyDefinitionValue struct {
+	ID                 string                                 `json:"id,omitempty"`
+	Enabled            bool                                   `json:"enabled"`
+	DefinitionBind     string                                 `json:"definition@odata.bind,omitempty"`
+	PresentationValues []ResourceGroupPolicyPresentationValue `json:"presentationValues,omitempty"`
+}
+
+// ResourceGroupPolicyPresentationValue represents the value of a single presentation of a group policy definition.
+// Value holds scalar values (text, decimal, boolean, dropdown) and Values holds collections (list, multi-text).
+type ResourceGroupPolicyPresentationValue struct {
+	ODataType        string      `json:"@odata.type"`
+	ID               string      `json:"id,omitempty"`
+	Value            interface{} `json:"value,omitempty"`
+	Values           interface{} `json:"values,omitempty"`
+	PresentationBind string      `json:"presentation@odata.bind,omitempty"`
+}
+
+// GroupPolicyPresentationKeyValuePair represents an entry of a list presentation value.
+type GroupPolicyPresentationKeyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// groupPolicyDefinitionBind returns the odata.bind reference to a group policy definition. The reference is a
+// path; it is made absolute against the configured Graph endpoint when the definition value is sent.
+func groupPolicyDefinitionBind(definitionID string) string {
+	return fmt.Sprintf("%s('%s')", uriBetaDeviceManagementGroupPolicyDefinitions, definitionID)
+}
+
+// groupPolicyPresentationBind returns the odata.bind reference to a presentation of a group policy definition.
+func groupPolicyPresentationBind(definitionID, presentationID string) string {
+	return fmt.Sprintf("%s/presentations('%s')", groupPolicyDefinitionBind(definitionID), presentationID)
+}
+
+// NewGroupPolicyDefinitionValue returns a definition value that enables or disables the given group policy definition
+// with the supplied presentation values, ready to be added to a Group Policy Configuration.
+func NewGroupPolicyDefinitionValue(definitionID string, enabled bool, presentationValues ...ResourceGroupPolicyPresentationValue) ResourceGroupPolicyDefinitionValue {
+	return ResourceGroupPolicyDefinitionValue{
+		Enabled:            enabled,
+		DefinitionBind:     groupPolicyDefinitionBind(definitionID),
+		PresentationValues: presentationValues,
+	}
+}
+
+// NewGroupPolicyPresentationValueText returns a text box presentation value.
+func NewGroupPolicyPresentationValueText(definitionID, presentationID, value string) ResourceGroupPolicyPresentationValue {
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueText,
+		Value:            value,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueDecimal returns a decimal text box or spin box presentation value.
+func NewGroupPolicyPresentationValueDecimal(definitionID, presentationID string, value int64) ResourceGroupPolicyPresentationValue {
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueDecimal,
+		Value:            value,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueLongDecimal returns a long decimal text box presentation value.
+func NewGroupPolicyPresentationValueLongDecimal(definitionID, presentationID string, value int64) ResourceGroupPolicyPresentationValue {
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueLongDecimal,
+		Value:            value,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueBoolean returns a check box presentation value.
+func NewGroupPolicyPresentationValueBoolean(definitionID, presentationID string, value bool) ResourceGroupPolicyPresentationValue {
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueBoolean,
+		Value:            value,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueList returns a list box presentation value of name and value pairs.
+func NewGroupPolicyPresentationValueList(definitionID, presentationID string, values []GroupPolicyPresentationKeyValuePair) ResourceGroupPolicyPresentationValue {
+	if values == nil {
+		values = []GroupPolicyPresentationKeyValuePair{}
+	}
+
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueList,
+		Values:           values,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueMultiText returns a multi-line text box presentation value.
+func NewGroupPolicyPresentationValueMultiText(definitionID, presentationID string, values []string) ResourceGroupPolicyPresentationValue {
+	if values == nil {
+		values = []string{}
+	}
+
+	return ResourceGroupPolicyPresentationValue{
+		ODataType:        ODataTypeGroupPolicyPresentationValueMultiText,
+		Values:           values,
+		PresentationBind: groupPolicyPresentationBind(definitionID, presentationID),
+	}
+}
+
+// NewGroupPolicyPresentationValueDropdown returns a dropdown list presentation value selecting the item with the given value.
+func NewGroupPolicyPresentationValueDropdown(definitionID, presentationID, itemValue string) ResourceGroupPolicyPresentationValue {
+	return NewGroupPolicyPresentationValueText(definitionID, presentationID, itemValue)
+}
+
+// CreateDeviceManagementGroupPolicyConfiguration creates a new Group Policy Configuration. Definition values
+// are not created with the configuration; set them afterwards with UpdateDeviceManagementGroupPolicyConfigurationDefinitionValues.
+func (c *Client) CreateDeviceManagementGroupPolicyConfiguration(request *ResourceDeviceManagementGroupPolicyConfiguration) (*ResourceDeviceManagementGroupPolicyConfiguration, error) {
+	endpoint := uriBetaDeviceManagementGroupPolicyConfigurations
+
+	payload := groupPolicyConfigurationPayload{
+		DisplayName:     request.DisplayName,
+		Description:     request.Description,
+		RoleScopeTagIds: request.RoleScopeTagIds,
+	}
+
+	var createdConfig ResourceDeviceManagementGroupPolicyConfiguration
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &createdConfig)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "group policy configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdConfig, nil
+}
+
+// CreateDeviceManagementGroupPolicyConfigurationWithDefinitionValues creates a new Group Policy Configuration,
+// adds the supplied definition values and assigns it, returning the fully expanded configuration. If adding the
+// definition values or the assignment fails, the created configuration is returned together with the error so
+// that the caller can retry or delete it.
+func (c *Client) CreateDeviceManagementGroupPolicyConfigurationWithDefinitionValues(request *ResourceDeviceManagementGroupPolicyConfiguration, definitionValues []ResourceGroupPolicyDefinitionValue, assignment *AssignmentDeviceManagementGroupPolicyConfiguration) (*ResourceDeviceManagementGroupPolicyConfiguration, error) {
+	createdConfig, err := c.CreateDeviceManagementGroupPolicyConfiguration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definitionValues) > 0 {
+		update := &GroupPolicyDefinitionValuesUpdate{Added: definitionValues}
+		if err := c.UpdateDeviceManagementGroupPolicyConfigurationDefinitionValues(createdConfig.ID, update); err != nil {
+			return createdConfig, err
+		}
+	}
+
+	if assignment != nil && len(assignment.Assignments) > 0 {
+		if _, err := c.CreateDeviceManagementGroupPolicyConfigurationAssignment(createdConfig.ID, assignment); err != nil {
+			return createdConfig, err
+		}
+	}
+
+	return c.GetDeviceManagementGroupPolicyConfigurationByID(createdConfig.ID)
+}
+
+// UpdateDeviceManagementGroupPolicyConfigurationByID updates the display name, description and scope tags of a
+// Group Policy Configuration by its ID.
+func (c *Client) UpdateDeviceManagementGroupPolicyConfigurationByID(policyConfigurationId string, request *ResourceDeviceManagementGroupPolicyConfiguration) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementGroupPolicyConfigurations, policyConfigurationId)
+
+	payload := groupPolicyConfigurationPayload{
+		DisplayName:     request.DisplayName,
+		Description:     request.Description,
+		RoleScopeTagIds: request.RoleScopeTagIds,
+	}
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "group policy configuration", policyConfigurationId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// UpdateDeviceManagementGroupPolicyConfigurationByName updates a Group Policy Configuration by its display name.
+func (c *Client) UpdateDeviceManagementGroupPolicyConfigurationByName(policyConfigurationName string, request *ResourceDeviceManagementGroupPolicyConfiguration) error {
+	policyConfigurationId, err := c.getDeviceManagementGroupPolicyConfigurationIDByName(policyConfigurationName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "group policy configuration", policyConfigurationName, err)
+	}
+
+	return c.UpdateDeviceManagementGroupPolicyConfigurationByID(policyConfigurationId, request)
+}
+
+// UpdateDeviceManagementGroupPolicyConfigurationDefinitionValues adds, updates and removes definition values of a
+// Group Policy Configuration in a single updateDefinitionValues call. Presentation values of updated definition
+// values that carry an ID are sent without their presentation binding, as Graph identifies them by ID.
+func (c *Client) UpdateDeviceManagementGroupPolicyConfigurationDefinitionValues(policyConfigurationId string, request *GroupPolicyDefinitionValuesUpdate) error {
+	endpoint := fmt.Sprintf("%s/%s/updateDefinitionValues", uriBetaDeviceManagementGroupPolicyConfigurations, policyConfigurationId)
+
+	payload := GroupPolicyDefinitionValuesUpdate{
+		Added:      []ResourceGroupPolicyDefinitionValue{},
+		Updated:    []ResourceGroupPolicyDefinitionValue{},
+		DeletedIds: []string{},
+	}
+	payload.DeletedIds = append(payload.DeletedIds, request.DeletedIds...)
+
+	for _, definitionValue := range request.Added {
+		definitionValue.DefinitionBind = c.graphResourceURL(definitionValue.DefinitionBind)
+
+		presentationValues := make([]ResourceGroupPolicyPresentationValue, len(definitionValue.PresentationValues))
+		for i, presentationValue := range definitionValue.PresentationValues {
+			presentationValue.PresentationBind = c.graphResourceURL(presentationValue.PresentationBind)
+			presentationValues[i] = presentationValue
+		}
+		definitionValue.PresentationValues = presentationValues
+
+		payload.Added = append(payload.Added, definitionValue)
+	}
+
+	for _, definitionValue := range request.Updated {
+		if definitionValue.ID == "" {
+			return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "group policy definition values", policyConfigurationId, "updated definition values require an id")
+		}
+		definitionValue.DefinitionBind = ""
+
+		presentationValues := make([]ResourceGroupPolicyPresentationValue, len(definitionValue.PresentationValues))
+		for i, presentationValue := range definitionValue.PresentationValues {
+			if presentationValue.ID != "" {
+				presentationValue.PresentationBind = ""
+			}
+			presentationValue.PresentationBind = c.graphResourceURL(presentationValue.PresentationBind)
+			presentationValues[i] = presentationValue
+		}
+		definitionValue.PresentationValues = presentationValues
+
+		payload.Updated = append(payload.Updated, definitionValue)
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "group policy definition values", policyConfigurationId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// CreateDeviceManagementGroupPolicyConfigurationAssignment replaces the assignments of a Group Policy Configuration.
+func (c *Client) CreateDeviceManagementGroupPolicyConfigurationAssignment(policyConfigurationId string, assignment *AssignmentDeviceManagementGroupPolicyConfiguration) (*ResponseAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assign", uriBetaDeviceManagementGroupPolicyConfigurations, policyConfigurationId)
+
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = odataTypeGroupPolicyConfigurationAssignment
+	}
+
+	var assignments ResponseAssignmentsList
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedAssign, "group policy configuration", policyConfigurationId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// DeleteDeviceManagementGroupPolicyConfigurationByID deletes a Group Policy Configuration by its ID.
+func (c *Client) DeleteDeviceManagementGroupPolicyConfigurationByID(policyConfigurationId string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementGroupPolicyConfigurations, policyConfigurationId)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "group policy configuration", policyConfigurationId, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDeviceManagementGroupPolicyConfigurationByName deletes a Group Policy Configuration by its display name.
+func (c *Client) DeleteDeviceManagementGroupPolicyConfigurationByName(policyConfigurationName string) error {
+	policyConfigurationId, err := c.getDeviceManagementGroupPolicyConfigurationIDByName(policyConfigurationName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "group policy configuration", policyConfigurationName, err)
+	}
+
+	return c.DeleteDeviceManagementGroupPolicyConfigurationByID(policyConfigurationId)
+}
+
+// getDeviceManagementGroupPolicyConfigurationIDByName resolves the ID of a Group Policy Configuration from its display name.
+func (c *Client) getDeviceManagementGroupPolicyConfigurationIDByName(policyConfigurationName string) (string, error) {
+	response, err := c.GetDeviceManagementGroupPolicyConfigurations()
+	if err != nil {
+		return "", err
+	}
+
+	for _, config := range response.Value {
+		if config.DisplayName == policyConfigurationName {
+			return config.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("policy not found")
+}
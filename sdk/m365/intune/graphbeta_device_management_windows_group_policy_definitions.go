@@ -0,0 +1,463 @@
+// graphbeta_device_management_windows_group_policy_definitions.go
+// Graph Beta Api - Intune: Group Policy (Administrative templates) Definitions, Categories and Presentations
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/configuration/administrative-templates-windows
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/configProfiles
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-grouppolicy-grouppolicydefinition?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-grouppolicy-grouppolicycategory?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-grouppolicy-grouppolicypresentation?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceManagementGroupPolicyDefinitions = "/beta/deviceManagement/groupPolicyDefinitions"
+	uriBetaDeviceManagementGroupPolicyCategories  = "/beta/deviceManagement/groupPolicyCategories"
+
+	GroupPolicyDefinitionClassTypeUser    = "user"
+	GroupPolicyDefinitionClassTypeMachine = "machine"
+
+	GroupPolicyDefinitionPolicyTypeAdmxBacked   = "admxBacked"
+	GroupPolicyDefinitionPolicyTypeAdmxIngested = "admxIngested"
+)
+
+// Presentation types of a group policy definition, describing the control shown for each configurable element.
+const (
+	ODataTypeGroupPolicyPresentationText               = "#microsoft.graph.groupPolicyPresentationText"
+	ODataTypeGroupPolicyPresentationTextBox            = "#microsoft.graph.groupPolicyPresentationTextBox"
+	ODataTypeGroupPolicyPresentationDecimalTextBox     = "#microsoft.graph.groupPolicyPresentationDecimalTextBox"
+	ODataTypeGroupPolicyPresentationLongDecimalTextBox = "#microsoft.graph.groupPolicyPresentationLongDecimalTextBox"
+	ODataTypeGroupPolicyPresentationCheckBox           = "#microsoft.graph.groupPolicyPresentationCheckBox"
+	ODataTypeGroupPolicyPresentationComboBox           = "#microsoft.graph.groupPolicyPresentationComboBox"
+	ODataTypeGroupPolicyPresentationDropdownList       = "#microsoft.graph.groupPolicyPresentationDropdownList"
+	ODataTypeGroupPolicyPresentationListBox            = "#microsoft.graph.groupPolicyPresentationListBox"
+	ODataTypeGroupPolicyPresentationMultiTextBox       = "#microsoft.graph.groupPolicyPresentationMultiTextBox"
+)
+
+// ResponseGroupPolicyDefinitionsList is used to parse a page of Group Policy Definitions from Microsoft Graph API.
+type ResponseGroupPolicyDefinitionsList struct {
+	ODataContext  string                          `json:"@odata.context"`
+	ODataNextLink string                          `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceGroupPolicyDefinition `json:"value"`
+}
+
+// ResourceGroupPolicyDefinition represents a Group Policy Definition from the administrative templates catalog.
+type ResourceGroupPolicyDefinition struct {
+	ID                    string    `json:"id"`
+	ClassType             string    `json:"classType"`
+	DisplayName           string    `json:"displayName"`
+	ExplainText           string    `json:"explainText"`
+	CategoryPath          string    `json:"categoryPath"`
+	SupportedOn           string    `json:"supportedOn"`
+	PolicyType            string    `json:"policyType"`
+	GroupPolicyCategoryID string    `json:"groupPolicyCategoryId"`
+	HasRelatedDefinitions bool      `json:"hasRelatedDefinitions"`
+	Version               string    `json:"version"`
+	MinDeviceCspVersion   string    `json:"minDeviceCspVersion"`
+	MinUserCspVersion     string    `json:"minUserCspVersion"`
+	LastModifiedDateTime  time.Time `json:"lastModifiedDateTime"`
+}
+
+// ResponseGroupPolicyCategoriesList is used to parse a list of Group Policy Categories from Microsoft Graph API.
+type ResponseGroupPolicyCategoriesList struct {
+	ODataContext  string                        `json:"@odata.context"`
+	ODataNextLink string                        `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceGroupPolicyCategory `json:"value"`
+}
+
+// ResourceGroupPolicyCategory represents a node of the administrative templates category tree.
+type ResourceGroupPolicyCategory struct {
+	ID                   string                        `json:"id"`
+	DisplayName          string                        `json:"displayName"`
+	IsRoot               bool                          `json:"isRoot"`
+	IngestionSource      string                        `json:"ingestionSource"`
+	LastModifiedDateTime time.Time                     `json:"lastModifiedDateTime"`
+	Parent               *ResourceGroupPolicyCategory  `json:"parent,omitempty"`
+	Children             []ResourceGroupPolicyCategory `json:"children,omitempty"`
+}
+
+// ResponseGroupPolicyDefinitionPresentationsList is used to parse the presentations of a Group Policy Definition.
+type ResponseGroupPolicyDefinitionPresentationsList struct {
+	ODataContext string                                      `json:"@odata.context"`
+	Value        []ResourceGroupPolicyDefinitionPresentation `json:"value"`
+}
+
+// ResourceGroupPolicyDefinitionPresentation represents a configurable element of a Group Policy Definition along
+// with the values it allows. Only the fields of the presentation's @odata.type are populated.
+type ResourceGroupPolicyDefinitionPresentation struct {
+	ODataType            string    `json:"@odata.type"`
+	ID                   string    `json:"id"`
+	Label                string    `json:"label"`
+	LastModifiedDateTime time.Time `json:"lastModifiedDateTime"`
+
+	// Fields shared by the input presentations
+	Required     bool          `json:"required,omitempty"`
+	DefaultValue *DynamicValue `json:"defaultValue,omitempty"`
+	MaxLength    int64         `json:"maxLength,omitempty"`
+
+	// Fields for decimal and long decimal text boxes
+	MaxValue int64 `json:"maxValue,omitempty"`
+	MinValue int64 `json:"minValue,omitempty"`
+	Spin     bool  `json:"spin,omitempty"`
+	SpinStep int64 `json:"spinStep,omitempty"`
+
+	// Fields for check boxes
+	DefaultChecked bool `json:"defaultChecked,omitempty"`
+
+	// Fields for combo boxes
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// Fields for dropdown lists
+	DefaultItem *PresentationItem  `json:"defaultItem,omitempty"`
+	Items       []PresentationItem `json:"items,omitempty"`
+
+	// Fields for list boxes
+	ExplicitValue bool   `json:"explicitValue,omitempty"`
+	ValuePrefix   string `json:"valuePrefix,omitempty"`
+
+	// Fields for multi-line text boxes
+	MaxStrings int64 `json:"maxStrings,omitempty"`
+}
+
+// PresentationValueODataType returns the presentation value type used to set a value for this presentation in
+// an updateDefinitionValues request, or an empty string for read-only text presentations.
+func (p *ResourceGroupPolicyDefinitionPresentation) PresentationValueODataType() string {
+	switch p.ODataType {
+	case ODataTypeGroupPolicyPresentationTextBox, ODataTypeGroupPolicyPresentationComboBox, ODataTypeGroupPolicyPresentationDropdownList:
+		return ODataTypeGroupPolicyPresentationValueText
+	case ODataTypeGroupPolicyPresentationDecimalTextBox:
+		return ODataTypeGroupPolicyPresentationValueDecimal
+	case ODataTypeGroupPolicyPresentationLongDecimalTextBox:
+		return ODataTypeGroupPolicyPresentationValueLongDecimal
+	case ODataTypeGroupPolicyPresentationCheckBox:
+		return ODataTypeGroupPolicyPresentationValueBoolean
+	case ODataTypeGroupPolicyPresentationListBox:
+		return ODataTypeGroupPolicyPresentationValueList
+	case ODataTypeGroupPolicyPresentationMultiTextBox:
+		return ODataTypeGroupPolicyPresentationValueMultiText
+	default:
+		return ""
+	}
+}
+
+// GroupPolicyDefinitionSearch holds the criteria used to search the Group Policy Definition catalog. Empty fields are ignored.
+// CategoryPath matches the category and all categories below it, and DisplayName is a case-insensitive substring match.
+type GroupPolicyDefinitionSearch struct {
+	CategoryPath string
+	ClassType    string
+	DisplayName  string
+	PolicyType   string
+}
+
+// GetGroupPolicyDefinitions retrieves every Group Policy Definition in the catalog, following pagination.
+func (c *Client) GetGroupPolicyDefinitions() (*ResponseGroupPolicyDefinitionsList, error) {
+	return c.getGroupPolicyDefinitions(uriBetaDeviceManagementGroupPolicyDefinitions)
+}
+
+// SearchGroupPolicyDefinitions retrieves the Group Policy Definitions matching the search criteria. Class type and
+// policy type are filtered by Microsoft Graph, category path and display name are matched locally.
+func (c *Client) SearchGroupPolicyDefinitions(search GroupPolicyDefinitionSearch) (*ResponseGroupPolicyDefinitionsList, error) {
+	var filters []string
+	if search.ClassType != "" {
+		filters = append(filters, fmt.Sprintf("classType eq '%s'", odataEscapeString(search.ClassType)))
+	}
+	if search.PolicyType != "" {
+		filters = append(filters, fmt.Sprintf("policyType eq '%s'", odataEscapeString(search.PolicyType)))
+	}
+
+	endpoint := uriBetaDeviceManagementGroupPolicyDefinitions
+	if len(filters) > 0 {
+		endpoint += "?$filter=" + url.QueryEscape(strings.Join(filters, " and "))
+	}
+
+	definitions, err := c.getGroupPolicyDefinitions(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := definitions.Value[:0]
+	for _, definition := range definitions.Value {
+		if search.CategoryPath != "" && !groupPolicyCategoryPathContains(search.CategoryPath, definition.CategoryPath) {
+			continue
+		}
+		if search.DisplayName != "" && !strings.Contains(strings.ToLower(definition.DisplayName), strings.ToLower(search.DisplayName)) {
+			continue
+		}
+		matched = append(matched, definition)
+	}
+	definitions.Value = matched
+
+	return definitions, nil
+}
+
+// getGroupPolicyDefinitions retrieves all pages of Group Policy Definitions starting from the given endpoint.
+func (c *Client) getGroupPolicyDefinitions(endpoint string) (*ResponseGroupPolicyDefinitionsList, error) {
+	var definitions ResponseGroupPolicyDefinitionsList
+
+	for endpoint != "" {
+		var page ResponseGroupPolicyDefinitionsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "group policy definitions", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if definitions.ODataContext == "" {
+			definitions.ODataContext = page.ODataContext
+		}
+		definitions.Value = append(definitions.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "group policy definitions", err)
+			}
+		}
+	}
+
+	return &definitions, nil
+}
+
+// GetGroupPolicyDefinitionByID retrieves a Group Policy Definition by its ID.
+func (c *Client) GetGroupPolicyDefinitionByID(definitionID string) (*ResourceGroupPolicyDefinition, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementGroupPolicyDefinitions, definitionID)
+
+	var definition ResourceGroupPolicyDefinition
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &definition)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy definition", definitionID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &definition, nil
+}
+
+// GetGroupPolicyDefinitionPresentations retrieves the presentations of a Group Policy Definition, including the
+// allowed values of each presentation.
+func (c *Client) GetGroupPolicyDefinitionPresentations(definitionID string) (*ResponseGroupPolicyDefinitionPresentationsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/presentations", uriBetaDeviceManagementGroupPolicyDefinitions, definitionID)
+
+	var presentations ResponseGroupPolicyDefinitionPresentationsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &presentations)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy definition presentations", definitionID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &presentations, nil
+}
+
+// GetGroupPolicyDefinitionPresentationByLabel retrieves the presentation of a Group Policy Definition with the given label.
+func (c *Client) GetGroupPolicyDefinitionPresentationByLabel(definitionID, label string) (*ResourceGroupPolicyDefinitionPresentation, error) {
+	presentations, err := c.GetGroupPolicyDefinitionPresentations(definitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, presentation := range presentations.Value {
+		if strings.EqualFold(strings.TrimSpace(presentation.Label), strings.TrimSpace(label)) {
+			return &presentation, nil
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "group policy definition presentation", label, "presentation not found")
+}
+
+// GetGroupPolicyCategories retrieves every Group Policy Category with its parent and children expanded.
+func (c *Client) GetGroupPolicyCategories() (*ResponseGroupPolicyCategoriesList, error) {
+	return c.getGroupPolicyCategories(uriBetaDeviceManagementGroupPolicyCategories + "?$expand=parent,children")
+}
+
+// GetGroupPolicyRootCategories retrieves the root Group Policy Categories with their children expanded.
+func (c *Client) GetGroupPolicyRootCategories() (*ResponseGroupPolicyCategoriesList, error) {
+	return c.getGroupPolicyCategories(uriBetaDeviceManagementGroupPolicyCategories + "?$expand=children&$filter=" + url.QueryEscape("isRoot eq true"))
+}
+
+// getGroupPolicyCategories retrieves all pages of Group Policy Categories starting from the given endpoint.
+func (c *Client) getGroupPolicyCategories(endpoint string) (*ResponseGroupPolicyCategoriesList, error) {
+	var categories ResponseGroupPolicyCategoriesList
+
+	for endpoint != "" {
+		var page ResponseGroupPolicyCategoriesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "group policy categories", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if categories.ODataContext == "" {
+			categories.ODataContext = page.ODataContext
+		}
+		categories.Value = append(categories.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "group policy categories", err)
+			}
+		}
+	}
+
+	return &categories, nil
+}
+
+// GetGroupPolicyCategoryByID retrieves a Group Policy Category by its ID with its parent and children expanded.
+func (c *Client) GetGroupPolicyCategoryByID(categoryID string) (*ResourceGroupPolicyCategory, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=parent,children", uriBetaDeviceManagementGroupPolicyCategories, categoryID)
+
+	var category ResourceGroupPolicyCategory
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &category)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy category", categoryID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &category, nil
+}
+
+// GetGroupPolicyCategoryDefinitions retrieves the Group Policy Definitions directly within a Group Policy Category.
+func (c *Client) GetGroupPolicyCategoryDefinitions(categoryID string) (*ResponseGroupPolicyDefinitionsList, error) {
+	return c.getGroupPolicyDefinitions(fmt.Sprintf("%s/%s/definitions", uriBetaDeviceManagementGroupPolicyCategories, categoryID))
+}
+
+// GroupPolicyDefinitionIndex is an in-memory index of the Group Policy Definition catalog for resolving definitions
+// by name without a round trip to Microsoft Graph. An index is read-only once built and safe for concurrent use.
+type GroupPolicyDefinitionIndex struct {
+	byID          map[string]ResourceGroupPolicyDefinition
+	byDisplayName map[string][]ResourceGroupPolicyDefinition
+}
+
+// GetGroupPolicyDefinitionIndex retrieves the full Group Policy Definition catalog and indexes it.
+func (c *Client) GetGroupPolicyDefinitionIndex() (*GroupPolicyDefinitionIndex, error) {
+	definitions, err := c.GetGroupPolicyDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGroupPolicyDefinitionIndex(definitions.Value), nil
+}
+
+// NewGroupPolicyDefinitionIndex builds an index over the given Group Policy Definitions.
+func NewGroupPolicyDefinitionIndex(definitions []ResourceGroupPolicyDefinition) *GroupPolicyDefinitionIndex {
+	index := &GroupPolicyDefinitionIndex{
+		byID:          make(map[string]ResourceGroupPolicyDefinition, len(definitions)),
+		byDisplayName: make(map[string][]ResourceGroupPolicyDefinition),
+	}
+
+	for _, definition := range definitions {
+		index.byID[definition.ID] = definition
+		key := groupPolicyIndexKey(definition.DisplayName)
+		index.byDisplayName[key] = append(index.byDisplayName[key], definition)
+	}
+
+	for _, matches := range index.byDisplayName {
+		sort.Slice(matches, func(i, j int) bool {
+			if matches[i].CategoryPath != matches[j].CategoryPath {
+				return matches[i].CategoryPath < matches[j].CategoryPath
+			}
+			return matches[i].ClassType < matches[j].ClassType
+		})
+	}
+
+	return index
+}
+
+// Len returns the number of indexed definitions.
+func (idx *GroupPolicyDefinitionIndex) Len() int {
+	return len(idx.byID)
+}
+
+// ByID returns the indexed definition with the given ID.
+func (idx *GroupPolicyDefinitionIndex) ByID(definitionID string) (ResourceGroupPolicyDefinition, bool) {
+	definition, ok := idx.byID[definitionID]
+	return definition, ok
+}
+
+// ByDisplayName returns every indexed definition with the given display name, ignoring case. The same display
+// name is commonly shared by the user and machine variants of a policy and by policies in different categories.
+func (idx *GroupPolicyDefinitionIndex) ByDisplayName(displayName string) []ResourceGroupPolicyDefinition {
+	return idx.byDisplayName[groupPolicyIndexKey(displayName)]
+}
+
+// Lookup returns the single definition matching the display name, category path and class type. Category path and
+// class type may be left empty when the display name alone is unambiguous.
+func (idx *GroupPolicyDefinitionIndex) Lookup(displayName, categoryPath, classType string) (ResourceGroupPolicyDefinition, error) {
+	var matches []ResourceGroupPolicyDefinition
+	for _, definition := range idx.ByDisplayName(displayName) {
+		if categoryPath != "" && !strings.EqualFold(normaliseGroupPolicyCategoryPath(definition.CategoryPath), normaliseGroupPolicyCategoryPath(categoryPath)) {
+			continue
+		}
+		if classType != "" && !strings.EqualFold(definition.ClassType, classType) {
+			continue
+		}
+		matches = append(matches, definition)
+	}
+
+	switch len(matches) {
+	case 0:
+		return ResourceGroupPolicyDefinition{}, fmt.Errorf("no group policy definition found with display name %q", displayName)
+	case 1:
+		return matches[0], nil
+	default:
+		return ResourceGroupPolicyDefinition{}, fmt.Errorf("%d group policy definitions found with display name %q, specify the category path and class type", len(matches), displayName)
+	}
+}
+
+// LookupID returns the ID of the single definition matching the display name, category path and class type.
+func (idx *GroupPolicyDefinitionIndex) LookupID(displayName, categoryPath, classType string) (string, error) {
+	definition, err := idx.Lookup(displayName, categoryPath, classType)
+	if err != nil {
+		return "", err
+	}
+
+	return definition.ID, nil
+}
+
+// groupPolicyIndexKey normalises a display name for case-insensitive index lookups.
+func groupPolicyIndexKey(displayName string) string {
+	return strings.ToLower(strings.TrimSpace(displayName))
+}
+
+// normaliseGroupPolicyCategoryPath trims surrounding whitespace and backslashes from a category path so that
+// "\Microsoft Edge\Startup" and "Microsoft Edge\Startup\" compare equal.
+func normaliseGroupPolicyCategoryPath(categoryPath string) string {
+	return strings.Trim(strings.TrimSpace(categoryPath), `\`)
+}
+
+// groupPolicyCategoryPathContains reports whether categoryPath is the same as, or nested below, parentPath.
+func groupPolicyCategoryPathContains(parentPath, categoryPath string) bool {
+	parent := strings.ToLower(normaliseGroupPolicyCategoryPath(parentPath))
+	category := strings.ToLower(normaliseGroupPolicyCategoryPath(categoryPath))
+
+	return category == parent || strings.HasPrefix(category, parent+`\`)
+}
+
+// odataEscapeString escapes single quotes in a string literal used in an OData $filter expression.
+func odataEscapeString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,338 @@
+// graphbeta_device_management_windows_group_policy_uploaded_definition_files.go
+// Graph Beta Api - Intune: Imported Administrative Templates (uploaded ADMX and ADML files)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/configuration/administrative-templates-import-custom
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/configProfiles
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-grouppolicy-grouppolicyuploadeddefinitionfile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles = "/beta/deviceManagement/groupPolicyUploadedDefinitionFiles"
+	odataTypeGroupPolicyUploadedDefinitionFile                = "#microsoft.graph.groupPolicyUploadedDefinitionFile"
+
+	GroupPolicyUploadedDefinitionFileStatusNone              = "none"
+	GroupPolicyUploadedDefinitionFileStatusUploadInProgress  = "uploadInProgress"
+	GroupPolicyUploadedDefinitionFileStatusAvailable         = "available"
+	GroupPolicyUploadedDefinitionFileStatusAssigned          = "assigned"
+	GroupPolicyUploadedDefinitionFileStatusRemovalInProgress = "removalInProgress"
+	GroupPolicyUploadedDefinitionFileStatusUploadFailed      = "uploadFailed"
+	GroupPolicyUploadedDefinitionFileStatusRemovalFailed     = "removalFailed"
+
+	GroupPolicyOperationStatusUnknown    = "unknown"
+	GroupPolicyOperationStatusInProgress = "inProgress"
+	GroupPolicyOperationStatusSuccess    = "success"
+	GroupPolicyOperationStatusFailed     = "failed"
+)
+
+// ResponseGroupPolicyUploadedDefinitionFilesList is used to parse the list of uploaded ADMX files.
+type ResponseGroupPolicyUploadedDefinitionFilesList struct {
+	ODataContext string                                      `json:"@odata.context"`
+	Value        []ResourceGroupPolicyUploadedDefinitionFile `json:"value"`
+}
+
+// ResourceGroupPolicyUploadedDefinitionFile represents an uploaded ADMX file and its language files. Content holds
+// the base64 encoded ADMX file and is only sent on upload.
+type ResourceGroupPolicyUploadedDefinitionFile struct {
+	ODataType                        string                            `json:"@odata.type,omitempty"`
+	ID                               string                            `json:"id,omitempty"`
+	DisplayName                      string                            `json:"displayName,omitempty"`
+	Description                      string                            `json:"description,omitempty"`
+	FileName                         string                            `json:"fileName,omitempty"`
+	LanguageCodes                    []string                          `json:"languageCodes,omitempty"`
+	TargetPrefix                     string                            `json:"targetPrefix,omitempty"`
+	TargetNamespace                  string                            `json:"targetNamespace,omitempty"`
+	PolicyType                       string                            `json:"policyType,omitempty"`
+	Revision                         string                            `json:"revision,omitempty"`
+	Status                           string                            `json:"status,omitempty"`
+	Content                          string                            `json:"content,omitempty"`
+	DefaultLanguageCode              string                            `json:"defaultLanguageCode,omitempty"`
+	UploadDateTime                   *time.Time                        `json:"uploadDateTime,omitempty"`
+	LastModifiedDateTime             *time.Time                        `json:"lastModifiedDateTime,omitempty"`
+	GroupPolicyUploadedLanguageFiles []GroupPolicyUploadedLanguageFile `json:"groupPolicyUploadedLanguageFiles,omitempty"`
+	GroupPolicyOperations            []ResourceGroupPolicyOperation    `json:"groupPolicyOperations,omitempty"`
+}
+
+// GroupPolicyUploadedLanguageFile represents an ADML language file uploaded with an ADMX file.
+type GroupPolicyUploadedLanguageFile struct {
+	FileName             string     `json:"fileName,omitempty"`
+	LanguageCode         string     `json:"languageCode,omitempty"`
+	Content              string     `json:"content,omitempty"`
+	LastModifiedDateTime *time.Time `json:"lastModifiedDateTime,omitempty"`
+}
+
+// ResponseGroupPolicyOperationsList is used to parse the operations performed on an uploaded ADMX file.
+type ResponseGroupPolicyOperationsList struct {
+	ODataContext string                         `json:"@odata.context"`
+	Value        []ResourceGroupPolicyOperation `json:"value"`
+}
+
+// ResourceGroupPolicyOperation represents an upload or removal operation on an uploaded ADMX file. StatusDetails
+// holds the ingestion error reported by Intune when the operation fails.
+type ResourceGroupPolicyOperation struct {
+	ID                   string    `json:"id"`
+	OperationType        string    `json:"operationType"`
+	OperationStatus      string    `json:"operationStatus"`
+	StatusDetails        string    `json:"statusDetails"`
+	LastModifiedDateTime time.Time `json:"lastModifiedDateTime"`
+}
+
+// NewGroupPolicyUploadedDefinitionFileFromFiles reads, parses and validates an ADMX file and its ADML language
+// files, keyed by language code, and returns the upload request along with the validation report. The request is
+// not returned when validation fails, but the report is, so the referenced namespaces can still be inspected.
+func NewGroupPolicyUploadedDefinitionFileFromFiles(admxPath string, admlPaths map[string]string, defaultLanguageCode string) (*ResourceGroupPolicyUploadedDefinitionFile, *GroupPolicyDefinitionFileReport, error) {
+	admxContent, err := os.ReadFile(admxPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read ADMX file %s, error: %v", admxPath, err)
+	}
+
+	admx, err := ParseGroupPolicyADMX(admxContent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := admlPaths[defaultLanguageCode]; !ok {
+		return nil, nil, fmt.Errorf("no ADML file provided for default language %s", defaultLanguageCode)
+	}
+
+	admls := make(map[string]*GroupPolicyADML, len(admlPaths))
+	var languageFiles []GroupPolicyUploadedLanguageFile
+	for languageCode, admlPath := range admlPaths {
+		admlContent, err := os.ReadFile(admlPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read ADML file %s, error: %v", admlPath, err)
+		}
+
+		if admls[languageCode], err = ParseGroupPolicyADML(admlContent); err != nil {
+			return nil, nil, fmt.Errorf("language %s: %w", languageCode, err)
+		}
+
+		languageFiles = append(languageFiles, GroupPolicyUploadedLanguageFile{
+			FileName:     filepath.Base(admlPath),
+			LanguageCode: languageCode,
+			Content:      base64.StdEncoding.EncodeToString(admlContent),
+		})
+	}
+
+	report, err := ValidateGroupPolicyDefinitionFiles(admx, admls)
+	if err != nil {
+		return nil, report, err
+	}
+
+	request := &ResourceGroupPolicyUploadedDefinitionFile{
+		FileName:                         filepath.Base(admxPath),
+		Content:                          base64.StdEncoding.EncodeToString(admxContent),
+		DefaultLanguageCode:              defaultLanguageCode,
+		TargetPrefix:                     admx.Target.Prefix,
+		TargetNamespace:                  admx.Target.Namespace,
+		Revision:                         admx.Revision,
+		GroupPolicyUploadedLanguageFiles: languageFiles,
+	}
+
+	return request, report, nil
+}
+
+// GetGroupPolicyUploadedDefinitionFiles retrieves all uploaded ADMX files.
+func (c *Client) GetGroupPolicyUploadedDefinitionFiles() (*ResponseGroupPolicyUploadedDefinitionFilesList, error) {
+	endpoint := uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles
+
+	var files ResponseGroupPolicyUploadedDefinitionFilesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &files)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "group policy uploaded definition files", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &files, nil
+}
+
+// GetGroupPolicyUploadedDefinitionFileByID retrieves an uploaded ADMX file by its ID with its language files and
+// operations expanded.
+func (c *Client) GetGroupPolicyUploadedDefinitionFileByID(fileID string) (*ResourceGroupPolicyUploadedDefinitionFile, error) {
+	endpoint := fmt.Sprintf("%s/%s?$expand=groupPolicyOperations", uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles, fileID)
+
+	var file ResourceGroupPolicyUploadedDefinitionFile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &file)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy uploaded definition file", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &file, nil
+}
+
+// GetGroupPolicyUploadedDefinitionFileByFileName retrieves an uploaded ADMX file by its file name, ignoring case.
+func (c *Client) GetGroupPolicyUploadedDefinitionFileByFileName(fileName string) (*ResourceGroupPolicyUploadedDefinitionFile, error) {
+	files, err := c.GetGroupPolicyUploadedDefinitionFiles()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "group policy uploaded definition files", err)
+	}
+
+	for _, file := range files.Value {
+		if strings.EqualFold(file.FileName, fileName) {
+			return c.GetGroupPolicyUploadedDefinitionFileByID(file.ID)
+		}
+	}
+
+	return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "group policy uploaded definition file", fileName, "file not found")
+}
+
+// CreateGroupPolicyUploadedDefinitionFile uploads an ADMX file with its language files. Ingestion happens
+// asynchronously; use WaitForGroupPolicyUploadedDefinitionFile to wait for the outcome.
+func (c *Client) CreateGroupPolicyUploadedDefinitionFile(request *ResourceGroupPolicyUploadedDefinitionFile) (*ResourceGroupPolicyUploadedDefinitionFile, error) {
+	endpoint := uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles
+
+	request.ODataType = odataTypeGroupPolicyUploadedDefinitionFile
+
+	var createdFile ResourceGroupPolicyUploadedDefinitionFile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdFile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "group policy uploaded definition file", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdFile, nil
+}
+
+// GetGroupPolicyUploadedDefinitionFileOperations retrieves the operations performed on an uploaded ADMX file,
+// including the ingestion errors of failed uploads.
+func (c *Client) GetGroupPolicyUploadedDefinitionFileOperations(fileID string) (*ResponseGroupPolicyOperationsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/groupPolicyOperations", uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles, fileID)
+
+	var operations ResponseGroupPolicyOperationsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &operations)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "group policy uploaded definition file operations", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &operations, nil
+}
+
+// WaitForGroupPolicyUploadedDefinitionFile polls an uploaded ADMX file until ingestion has finished or the timeout
+// elapses. When ingestion fails the returned error carries the status details of the failed operations.
+func (c *Client) WaitForGroupPolicyUploadedDefinitionFile(fileID string, pollInterval, timeout time.Duration) (*ResourceGroupPolicyUploadedDefinitionFile, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		file, err := c.GetGroupPolicyUploadedDefinitionFileByID(fileID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch file.Status {
+		case GroupPolicyUploadedDefinitionFileStatusAvailable, GroupPolicyUploadedDefinitionFileStatusAssigned:
+			return file, nil
+		case GroupPolicyUploadedDefinitionFileStatusUploadFailed:
+			return file, fmt.Errorf("upload of group policy definition file %s failed: %s", file.FileName, groupPolicyOperationFailures(file.GroupPolicyOperations))
+		}
+
+		if time.Now().After(deadline) {
+			return file, fmt.Errorf("timed out after %s waiting for group policy definition file %s, last status: %s", timeout, file.FileName, file.Status)
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// RemoveGroupPolicyUploadedDefinitionFileByID removes an uploaded ADMX file and the definitions ingested from it.
+// Removal fails while settings from the file are still used by a configuration profile.
+func (c *Client) RemoveGroupPolicyUploadedDefinitionFileByID(fileID string) error {
+	endpoint := fmt.Sprintf("%s/%s/remove", uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles, fileID)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "group policy uploaded definition file", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteGroupPolicyUploadedDefinitionFileByID deletes an uploaded ADMX file record by its ID.
+func (c *Client) DeleteGroupPolicyUploadedDefinitionFileByID(fileID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaDeviceManagementGroupPolicyUploadedDefinitionFiles, fileID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "group policy uploaded definition file", fileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// ValidateGroupPolicyDefinitionFileDependencies checks that every namespace required by a validated ADMX file has
+// already been uploaded and ingested, returning the namespaces that are still missing.
+func (c *Client) ValidateGroupPolicyDefinitionFileDependencies(report *GroupPolicyDefinitionFileReport) ([]string, error) {
+	if len(report.RequiredNamespaces) == 0 {
+		return nil, nil
+	}
+
+	files, err := c.GetGroupPolicyUploadedDefinitionFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var available []string
+	for _, file := range files.Value {
+		if file.Status == GroupPolicyUploadedDefinitionFileStatusAvailable || file.Status == GroupPolicyUploadedDefinitionFileStatusAssigned {
+			available = append(available, file.TargetNamespace)
+		}
+	}
+
+	var missing []string
+	for _, namespace := range report.RequiredNamespaces {
+		if !containsString(available, namespace) {
+			missing = append(missing, namespace)
+		}
+	}
+
+	return missing, nil
+}
+
+// groupPolicyOperationFailures joins the status details of failed group policy operations.
+func groupPolicyOperationFailures(operations []ResourceGroupPolicyOperation) string {
+	var details []string
+	for _, operation := range operations {
+		if operation.OperationStatus == GroupPolicyOperationStatusFailed && operation.StatusDetails != "" {
+			details = append(details, operation.StatusDetails)
+		}
+	}
+
+	if len(details) == 0 {
+		return "no status details reported"
+	}
+
+	return strings.Join(details, "; ")
+}
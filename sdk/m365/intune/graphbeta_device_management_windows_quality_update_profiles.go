@@ -0,0 +1,264 @@
+// graphbeta_device_management_windows_quality_update_profiles.go
+// Graph Beta Api - Intune: Windows quality update profiles (expedited quality updates)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/windows-10-expedite-updates
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/qualityUpdateDeployments
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-softwareupdate-windowsqualityupdateprofile?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// A quality update profile expedites a quality update release to devices that are behind it, ahead of the
+// deferral periods of their update rings.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsQualityUpdateProfiles            = "/beta/deviceManagement/windowsQualityUpdateProfiles"
+	odataTypeWindowsQualityUpdateProfileAssignment = "#microsoft.graph.windowsQualityUpdateProfileAssignment"
+)
+
+// ResponseWindowsQualityUpdateProfilesList represents a list of Windows quality update profiles.
+type ResponseWindowsQualityUpdateProfilesList struct {
+	ODataContext  string                                `json:"@odata.context"`
+	ODataNextLink string                                `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWindowsQualityUpdateProfile `json:"value"`
+}
+
+// ResourceWindowsQualityUpdateProfile represents a Windows quality update profile. It is used as both the
+// request and response structure.
+type ResourceWindowsQualityUpdateProfile struct {
+	ODataContext                 string                                 `json:"@odata.context,omitempty"`
+	ID                           string                                 `json:"id,omitempty"`
+	DisplayName                  string                                 `json:"displayName"`
+	Description                  string                                 `json:"description,omitempty"`
+	RoleScopeTagIds              []string                               `json:"roleScopeTagIds,omitempty"`
+	ExpeditedUpdateSettings      *ExpeditedWindowsQualityUpdateSettings `json:"expeditedUpdateSettings"`
+	ReleaseDateDisplayName       string                                 `json:"releaseDateDisplayName,omitempty"`
+	DeployableContentDisplayName string                                 `json:"deployableContentDisplayName,omitempty"`
+	CreatedDateTime              *time.Time                             `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime         *time.Time                             `json:"lastModifiedDateTime,omitempty"`
+}
+
+// ExpeditedWindowsQualityUpdateSettings represents the quality update release to expedite and how long users
+// can postpone the restart that installs it. QualityUpdateRelease is the release date of an expeditable
+// quality update catalog item in the form 2024-01-09T00:00:00Z.
+type ExpeditedWindowsQualityUpdateSettings struct {
+	QualityUpdateRelease  string `json:"qualityUpdateRelease"`
+	DaysUntilForcedReboot int    `json:"daysUntilForcedReboot"`
+}
+
+// NewExpeditedWindowsQualityUpdateSettings returns settings that expedite the quality update catalog item,
+// which must be expeditable.
+func NewExpeditedWindowsQualityUpdateSettings(item WindowsUpdateCatalogItem, daysUntilForcedReboot int) (*ExpeditedWindowsQualityUpdateSettings, error) {
+	if !item.IsExpeditable || item.ReleaseDateTime == nil {
+		return nil, fmt.Errorf("quality update %s cannot be expedited", item.DisplayName)
+	}
+
+	return &ExpeditedWindowsQualityUpdateSettings{
+		QualityUpdateRelease:  item.ReleaseDateTime.UTC().Format(time.RFC3339),
+		DaysUntilForcedReboot: daysUntilForcedReboot,
+	}, nil
+}
+
+// validateWindowsQualityUpdateProfile checks that a quality update profile names a release to expedite and a
+// restart deadline that Windows supports.
+func validateWindowsQualityUpdateProfile(profile *ResourceWindowsQualityUpdateProfile) error {
+	settings := profile.ExpeditedUpdateSettings
+	if settings == nil || settings.QualityUpdateRelease == "" {
+		return fmt.Errorf("a quality update release to expedite is required")
+	}
+	if _, err := time.Parse(time.RFC3339, settings.QualityUpdateRelease); err != nil {
+		return fmt.Errorf("quality update release %q is not a release date: %v", settings.QualityUpdateRelease, err)
+	}
+	if settings.DaysUntilForcedReboot < 0 || settings.DaysUntilForcedReboot > 2 {
+		return fmt.Errorf("days until forced reboot must be between 0 and 2, got %d", settings.DaysUntilForcedReboot)
+	}
+
+	return nil
+}
+
+// GetWindowsQualityUpdateProfiles retrieves a list of all Windows quality update profiles.
+func (c *Client) GetWindowsQualityUpdateProfiles() (*ResponseWindowsQualityUpdateProfilesList, error) {
+	endpoint := uriBetaWindowsQualityUpdateProfiles
+
+	var profiles ResponseWindowsQualityUpdateProfilesList
+	for endpoint != "" {
+		var page ResponseWindowsQualityUpdateProfilesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows quality update profiles", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if profiles.ODataContext == "" {
+			profiles.ODataContext = page.ODataContext
+		}
+		profiles.Value = append(profiles.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows quality update profiles", err)
+			}
+		}
+	}
+
+	return &profiles, nil
+}
+
+// GetWindowsQualityUpdateProfileByID retrieves a Windows quality update profile by its ID.
+func (c *Client) GetWindowsQualityUpdateProfileByID(profileID string) (*ResourceWindowsQualityUpdateProfile, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsQualityUpdateProfiles, profileID)
+
+	var profile ResourceWindowsQualityUpdateProfile
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows quality update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &profile, nil
+}
+
+// GetWindowsQualityUpdateProfileByDisplayName retrieves a Windows quality update profile by its display name.
+func (c *Client) GetWindowsQualityUpdateProfileByDisplayName(displayName string) (*ResourceWindowsQualityUpdateProfile, error) {
+	profiles, err := c.GetWindowsQualityUpdateProfiles()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows quality update profiles", err)
+	}
+
+	var profileID string
+	for _, profile := range profiles.Value {
+		if profile.DisplayName == displayName {
+			profileID = profile.ID
+			break
+		}
+	}
+
+	if profileID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows quality update profile", displayName, "profile not found")
+	}
+
+	return c.GetWindowsQualityUpdateProfileByID(profileID)
+}
+
+// CreateWindowsQualityUpdateProfile creates a new Windows quality update profile that expedites a quality
+// update release.
+func (c *Client) CreateWindowsQualityUpdateProfile(request *ResourceWindowsQualityUpdateProfile) (*ResourceWindowsQualityUpdateProfile, error) {
+	if err := validateWindowsQualityUpdateProfile(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows quality update profile", err)
+	}
+
+	endpoint := uriBetaWindowsQualityUpdateProfiles
+
+	var createdProfile ResourceWindowsQualityUpdateProfile
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &createdProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows quality update profile", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdProfile, nil
+}
+
+// CreateWindowsQualityUpdateProfileWithAssignment creates a new Windows quality update profile and assigns it.
+func (c *Client) CreateWindowsQualityUpdateProfileWithAssignment(request *ResourceWindowsQualityUpdateProfile, assignment *AssignmentWindowsUpdateProfile) (*ResourceWindowsQualityUpdateProfile, error) {
+	createdProfile, err := c.CreateWindowsQualityUpdateProfile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateWindowsQualityUpdateProfileAssignment(createdProfile.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdProfile, nil
+}
+
+// UpdateWindowsQualityUpdateProfileByID updates a Windows quality update profile by its ID using the PATCH method.
+func (c *Client) UpdateWindowsQualityUpdateProfileByID(profileID string, request *ResourceWindowsQualityUpdateProfile) (*ResourceWindowsQualityUpdateProfile, error) {
+	if err := validateWindowsQualityUpdateProfile(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows quality update profile", profileID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsQualityUpdateProfiles, profileID)
+
+	var updatedProfile ResourceWindowsQualityUpdateProfile
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, request, &updatedProfile)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows quality update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedProfile, nil
+}
+
+// UpdateWindowsQualityUpdateProfileByDisplayName updates a Windows quality update profile by its display name.
+func (c *Client) UpdateWindowsQualityUpdateProfileByDisplayName(displayName string, request *ResourceWindowsQualityUpdateProfile) (*ResourceWindowsQualityUpdateProfile, error) {
+	profile, err := c.GetWindowsQualityUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "windows quality update profile", displayName, err)
+	}
+
+	return c.UpdateWindowsQualityUpdateProfileByID(profile.ID, request)
+}
+
+// DeleteWindowsQualityUpdateProfileByID deletes a Windows quality update profile by its ID.
+func (c *Client) DeleteWindowsQualityUpdateProfileByID(profileID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsQualityUpdateProfiles, profileID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows quality update profile", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsQualityUpdateProfileByDisplayName deletes a Windows quality update profile by its display name.
+func (c *Client) DeleteWindowsQualityUpdateProfileByDisplayName(displayName string) error {
+	profile, err := c.GetWindowsQualityUpdateProfileByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "windows quality update profile", displayName, err)
+	}
+
+	return c.DeleteWindowsQualityUpdateProfileByID(profile.ID)
+}
+
+// GetWindowsQualityUpdateProfileAssignments retrieves the assignments of a Windows quality update profile.
+func (c *Client) GetWindowsQualityUpdateProfileAssignments(profileID string) (*ResponseWindowsUpdateProfileAssignmentsList, error) {
+	return c.getWindowsUpdateProfileAssignments(uriBetaWindowsQualityUpdateProfiles, "windows quality update profile", profileID)
+}
+
+// CreateWindowsQualityUpdateProfileAssignment assigns a Windows quality update profile. The supplied
+// assignments replace any existing assignments of the profile.
+func (c *Client) CreateWindowsQualityUpdateProfileAssignment(profileID string, assignment *AssignmentWindowsUpdateProfile) error {
+	return c.assignWindowsUpdateProfile(uriBetaWindowsQualityUpdateProfiles, "windows quality update profile", profileID, odataTypeWindowsQualityUpdateProfileAssignment, assignment)
+}
+
+// GetWindowsQualityUpdateProfileDeviceStates retrieves the expedited update state of each device targeted by
+// a Windows quality update profile from the quality update device status report.
+func (c *Client) GetWindowsQualityUpdateProfileDeviceStates(profileID string, options *ReportExportJobOptions) ([]WindowsUpdateDeviceState, error) {
+	return c.getWindowsUpdateDeviceStates(ReportNameQualityUpdateDeviceStatusByPolicy, "windows quality update profile", profileID, options)
+}
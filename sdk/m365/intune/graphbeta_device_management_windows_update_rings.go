@@ -0,0 +1,498 @@
+// graphbeta_device_management_windows_update_rings.go
+// Graph Beta Api - Intune: Windows update rings (Windows Update for Business)
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/windows-10-update-rings
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/windows10UpdateRings
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-deviceconfig-windowsupdateforbusinessconfiguration?view=graph-rest-beta
+// ODATA query options reference: https://learn.microsoft.com/en-us/graph/query-parameters?tabs=http
+// Microsoft Graph requires the structs to support a JSON data structure.
+// Update rings are device configurations of type windowsUpdateForBusinessConfiguration. Pausing, resuming and
+// uninstalling (rolling back) quality or feature updates are done by patching the ring, as in the Intune
+// portal; extending a pause uses the ring's extend actions.
+
+package intune
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsUpdateRings = "/beta/deviceManagement/deviceConfigurations"
+
+	ODataTypeWindowsUpdateForBusinessConfiguration = "#microsoft.graph.windowsUpdateForBusinessConfiguration"
+	ODataTypeWindowsUpdateScheduledInstall         = "#microsoft.graph.windowsUpdateScheduledInstall"
+	ODataTypeWindowsUpdateActiveHoursInstall       = "#microsoft.graph.windowsUpdateActiveHoursInstall"
+	odataTypeDeviceConfigurationAssignment         = "#microsoft.graph.deviceConfigurationAssignment"
+	windowsUpdateRingExpandAssignments             = "$expand=assignments"
+
+	WindowsUpdateRingUpdateTypeQuality = "quality"
+	WindowsUpdateRingUpdateTypeFeature = "feature"
+
+	AutomaticUpdateModeUserDefined                               = "userDefined"
+	AutomaticUpdateModeNotifyDownload                            = "notifyDownload"
+	AutomaticUpdateModeAutoInstallAtMaintenanceTime              = "autoInstallAtMaintenanceTime"
+	AutomaticUpdateModeAutoInstallAndRebootAtMaintenanceTime     = "autoInstallAndRebootAtMaintenanceTime"
+	AutomaticUpdateModeAutoInstallAndRebootAtScheduledTime       = "autoInstallAndRebootAtScheduledTime"
+	AutomaticUpdateModeAutoInstallAndRebootWithoutEndUserControl = "autoInstallAndRebootWithoutEndUserControl"
+	AutomaticUpdateModeWindowsDefault                            = "windowsDefault"
+
+	WindowsUpdateChannelUserDefined                = "userDefined"
+	WindowsUpdateChannelAll                        = "all"
+	WindowsUpdateChannelBusinessReadyOnly          = "businessReadyOnly"
+	WindowsUpdateChannelWindowsInsiderBuildFast    = "windowsInsiderBuildFast"
+	WindowsUpdateChannelWindowsInsiderBuildSlow    = "windowsInsiderBuildSlow"
+	WindowsUpdateChannelWindowsInsiderBuildRelease = "windowsInsiderBuildRelease"
+
+	WindowsUpdateNotificationLevelNotConfigured           = "notConfigured"
+	WindowsUpdateNotificationLevelDefaultNotifications    = "defaultNotifications"
+	WindowsUpdateNotificationLevelRestartWarningsOnly     = "restartWarningsOnly"
+	WindowsUpdateNotificationLevelDisableAllNotifications = "disableAllNotifications"
+
+	WindowsUpdateEnablementNotConfigured = "notConfigured"
+	WindowsUpdateEnablementEnabled       = "enabled"
+	WindowsUpdateEnablementDisabled      = "disabled"
+)
+
+// ResponseWindowsUpdateRingsList represents a list of Windows update rings.
+type ResponseWindowsUpdateRingsList struct {
+	ODataContext  string                      `json:"@odata.context"`
+	ODataNextLink string                      `json:"@odata.nextLink,omitempty"`
+	Value         []ResourceWindowsUpdateRing `json:"value"`
+}
+
+// ResourceWindowsUpdateRing represents a Windows update ring. It is used as both the request and response
+// structure. Pause, rollback and deadline settings that are not set are left to the service defaults.
+type ResourceWindowsUpdateRing struct {
+	ODataType            string     `json:"@odata.type"`
+	ID                   string     `json:"id,omitempty"`
+	DisplayName          string     `json:"displayName"`
+	Description          string     `json:"description,omitempty"`
+	RoleScopeTagIds      []string   `json:"roleScopeTagIds,omitempty"`
+	CreatedDateTime      *time.Time `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime *time.Time `json:"lastModifiedDateTime,omitempty"`
+	Version              int        `json:"version,omitempty"`
+
+	// Update settings
+	MicrosoftUpdateServiceAllowed      bool   `json:"microsoftUpdateServiceAllowed"`
+	DriversExcluded                    bool   `json:"driversExcluded"`
+	QualityUpdatesDeferralPeriodInDays int    `json:"qualityUpdatesDeferralPeriodInDays"`
+	FeatureUpdatesDeferralPeriodInDays int    `json:"featureUpdatesDeferralPeriodInDays"`
+	FeatureUpdatesRollbackWindowInDays int    `json:"featureUpdatesRollbackWindowInDays,omitempty"`
+	BusinessReadyUpdatesOnly           string `json:"businessReadyUpdatesOnly,omitempty"`
+	AllowWindows11Upgrade              bool   `json:"allowWindows11Upgrade"`
+	SkipChecksBeforeRestart            bool   `json:"skipChecksBeforeRestart"`
+
+	// User experience settings
+	AutomaticUpdateMode                     string                            `json:"automaticUpdateMode,omitempty"`
+	InstallationSchedule                    *WindowsUpdateInstallScheduleType `json:"installationSchedule,omitempty"`
+	UpdateWeeks                             string                            `json:"updateWeeks,omitempty"`
+	AutoRestartNotificationDismissal        string                            `json:"autoRestartNotificationDismissal,omitempty"`
+	ScheduleRestartWarningInHours           int                               `json:"scheduleRestartWarningInHours,omitempty"`
+	ScheduleImminentRestartWarningInMinutes int                               `json:"scheduleImminentRestartWarningInMinutes,omitempty"`
+	UserPauseAccess                         string                            `json:"userPauseAccess,omitempty"`
+	UserWindowsUpdateScanAccess             string                            `json:"userWindowsUpdateScanAccess,omitempty"`
+	UpdateNotificationLevel                 string                            `json:"updateNotificationLevel,omitempty"`
+	DeadlineForFeatureUpdatesInDays         *int                              `json:"deadlineForFeatureUpdatesInDays,omitempty"`
+	DeadlineForQualityUpdatesInDays         *int                              `json:"deadlineForQualityUpdatesInDays,omitempty"`
+	DeadlineGracePeriodInDays               *int                              `json:"deadlineGracePeriodInDays,omitempty"`
+	PostponeRebootUntilAfterDeadline        *bool                             `json:"postponeRebootUntilAfterDeadline,omitempty"`
+	EngagedRestartDeadlineInDays            *int                              `json:"engagedRestartDeadlineInDays,omitempty"`
+	EngagedRestartSnoozeScheduleInDays      *int                              `json:"engagedRestartSnoozeScheduleInDays,omitempty"`
+	EngagedRestartTransitionScheduleInDays  *int                              `json:"engagedRestartTransitionScheduleInDays,omitempty"`
+
+	// Pause and rollback state
+	QualityUpdatesPaused                *bool      `json:"qualityUpdatesPaused,omitempty"`
+	FeatureUpdatesPaused                *bool      `json:"featureUpdatesPaused,omitempty"`
+	QualityUpdatesPauseExpiryDateTime   *time.Time `json:"qualityUpdatesPauseExpiryDateTime,omitempty"`
+	FeatureUpdatesPauseExpiryDateTime   *time.Time `json:"featureUpdatesPauseExpiryDateTime,omitempty"`
+	QualityUpdatesPauseStartDate        string     `json:"qualityUpdatesPauseStartDate,omitempty"`
+	FeatureUpdatesPauseStartDate        string     `json:"featureUpdatesPauseStartDate,omitempty"`
+	QualityUpdatesWillBeRolledBack      *bool      `json:"qualityUpdatesWillBeRolledBack,omitempty"`
+	FeatureUpdatesWillBeRolledBack      *bool      `json:"featureUpdatesWillBeRolledBack,omitempty"`
+	QualityUpdatesRollbackStartDateTime *time.Time `json:"qualityUpdatesRollbackStartDateTime,omitempty"`
+	FeatureUpdatesRollbackStartDateTime *time.Time `json:"featureUpdatesRollbackStartDateTime,omitempty"`
+
+	Assignments []WindowsUpdateProfileAssignment `json:"assignments,omitempty"`
+}
+
+// WindowsUpdateInstallScheduleType represents when updates are installed, either on a schedule or outside of
+// active hours, depending on its ODataType.
+type WindowsUpdateInstallScheduleType struct {
+	ODataType string `json:"@odata.type"`
+
+	// Fields for windowsUpdateScheduledInstall
+	ScheduledInstallDay  string `json:"scheduledInstallDay,omitempty"`
+	ScheduledInstallTime string `json:"scheduledInstallTime,omitempty"`
+
+	// Fields for windowsUpdateActiveHoursInstall
+	ActiveHoursStart string `json:"activeHoursStart,omitempty"`
+	ActiveHoursEnd   string `json:"activeHoursEnd,omitempty"`
+}
+
+// ResponseWindowsUpdateRingStatusOverview represents the device or user status overview of a Windows update ring.
+type ResponseWindowsUpdateRingStatusOverview struct {
+	ODataContext               string    `json:"@odata.context"`
+	ID                         string    `json:"id"`
+	PendingCount               int       `json:"pendingCount"`
+	NotApplicableCount         int       `json:"notApplicableCount"`
+	NotApplicablePlatformCount int       `json:"notApplicablePlatformCount"`
+	SuccessCount               int       `json:"successCount"`
+	ErrorCount                 int       `json:"errorCount"`
+	FailedCount                int       `json:"failedCount"`
+	ConflictCount              int       `json:"conflictCount"`
+	LastUpdateDateTime         time.Time `json:"lastUpdateDateTime"`
+	ConfigurationVersion       int       `json:"configurationVersion"`
+}
+
+// ResponseWindowsUpdateRingDeviceStatusesList represents the per device statuses of a Windows update ring.
+type ResponseWindowsUpdateRingDeviceStatusesList struct {
+	ODataContext  string                              `json:"@odata.context"`
+	ODataNextLink string                              `json:"@odata.nextLink,omitempty"`
+	Value         []WindowsUpdateRingDeviceStatusItem `json:"value"`
+}
+
+// WindowsUpdateRingDeviceStatusItem represents the status of a Windows update ring on a single device.
+type WindowsUpdateRingDeviceStatusItem struct {
+	ID                                      string    `json:"id"`
+	DeviceDisplayName                       string    `json:"deviceDisplayName"`
+	UserName                                string    `json:"userName"`
+	UserPrincipalName                       string    `json:"userPrincipalName"`
+	DeviceModel                             string    `json:"deviceModel"`
+	Platform                                int       `json:"platform"`
+	ComplianceGracePeriodExpirationDateTime time.Time `json:"complianceGracePeriodExpirationDateTime"`
+	Status                                  string    `json:"status"`
+	LastReportedDateTime                    time.Time `json:"lastReportedDateTime"`
+}
+
+// validateWindowsUpdateRing checks the deferral periods of an update ring against the limits Windows enforces.
+func validateWindowsUpdateRing(ring *ResourceWindowsUpdateRing) error {
+	if ring.QualityUpdatesDeferralPeriodInDays < 0 || ring.QualityUpdatesDeferralPeriodInDays > 30 {
+		return fmt.Errorf("quality update deferral period must be between 0 and 30 days, got %d", ring.QualityUpdatesDeferralPeriodInDays)
+	}
+	if ring.FeatureUpdatesDeferralPeriodInDays < 0 || ring.FeatureUpdatesDeferralPeriodInDays > 365 {
+		return fmt.Errorf("feature update deferral period must be between 0 and 365 days, got %d", ring.FeatureUpdatesDeferralPeriodInDays)
+	}
+	if days := ring.FeatureUpdatesRollbackWindowInDays; days != 0 && (days < 2 || days > 60) {
+		return fmt.Errorf("feature update uninstall period must be between 2 and 60 days, got %d", days)
+	}
+
+	return nil
+}
+
+// GetWindowsUpdateRings retrieves a list of all Windows update rings with their assignments.
+func (c *Client) GetWindowsUpdateRings() (*ResponseWindowsUpdateRingsList, error) {
+	endpoint := fmt.Sprintf("%s?$filter=isof('%s')&%s", uriBetaWindowsUpdateRings, strings.TrimPrefix(ODataTypeWindowsUpdateForBusinessConfiguration, "#"), windowsUpdateRingExpandAssignments)
+
+	var rings ResponseWindowsUpdateRingsList
+	for endpoint != "" {
+		var page ResponseWindowsUpdateRingsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update rings", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if rings.ODataContext == "" {
+			rings.ODataContext = page.ODataContext
+		}
+		rings.Value = append(rings.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update rings", err)
+			}
+		}
+	}
+
+	return &rings, nil
+}
+
+// GetWindowsUpdateRingByID retrieves a Windows update ring by its ID with its assignments.
+func (c *Client) GetWindowsUpdateRingByID(ringID string) (*ResourceWindowsUpdateRing, error) {
+	endpoint := fmt.Sprintf("%s/%s?%s", uriBetaWindowsUpdateRings, ringID, windowsUpdateRingExpandAssignments)
+
+	var ring ResourceWindowsUpdateRing
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ring)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows update ring", ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if ring.ODataType != ODataTypeWindowsUpdateForBusinessConfiguration {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows update ring", ringID, fmt.Sprintf("device configuration is a %s", ring.ODataType))
+	}
+
+	return &ring, nil
+}
+
+// GetWindowsUpdateRingByDisplayName retrieves a Windows update ring by its display name.
+func (c *Client) GetWindowsUpdateRingByDisplayName(displayName string) (*ResourceWindowsUpdateRing, error) {
+	rings, err := c.GetWindowsUpdateRings()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "windows update rings", err)
+	}
+
+	var ringID string
+	for _, ring := range rings.Value {
+		if ring.DisplayName == displayName {
+			ringID = ring.ID
+			break
+		}
+	}
+
+	if ringID == "" {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByName, "windows update ring", displayName, "ring not found")
+	}
+
+	return c.GetWindowsUpdateRingByID(ringID)
+}
+
+// CreateWindowsUpdateRing creates a new Windows update ring.
+func (c *Client) CreateWindowsUpdateRing(request *ResourceWindowsUpdateRing) (*ResourceWindowsUpdateRing, error) {
+	if err := validateWindowsUpdateRing(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows update ring", err)
+	}
+
+	// Set graph metadata values
+	request.ODataType = ODataTypeWindowsUpdateForBusinessConfiguration
+
+	endpoint := uriBetaWindowsUpdateRings
+
+	// Exclude navigation properties from the request object
+	create := *request
+	create.Assignments = nil
+
+	var createdRing ResourceWindowsUpdateRing
+	resp, err := c.HTTP.DoRequest("POST", endpoint, &create, &createdRing)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedCreate, "windows update ring", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &createdRing, nil
+}
+
+// CreateWindowsUpdateRingWithAssignment creates a new Windows update ring and assigns it.
+func (c *Client) CreateWindowsUpdateRingWithAssignment(request *ResourceWindowsUpdateRing, assignment *AssignmentWindowsUpdateProfile) (*ResourceWindowsUpdateRing, error) {
+	createdRing, err := c.CreateWindowsUpdateRing(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CreateWindowsUpdateRingAssignment(createdRing.ID, assignment); err != nil {
+		return nil, err
+	}
+
+	return createdRing, nil
+}
+
+// UpdateWindowsUpdateRingByID updates a Windows update ring by its ID using the PATCH method.
+func (c *Client) UpdateWindowsUpdateRingByID(ringID string, request *ResourceWindowsUpdateRing) (*ResourceWindowsUpdateRing, error) {
+	if err := validateWindowsUpdateRing(request); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring", ringID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsUpdateRings, ringID)
+
+	// Exclude navigation properties from the request object
+	patch := *request
+	patch.ODataType = ODataTypeWindowsUpdateForBusinessConfiguration
+	patch.Assignments = nil
+
+	var updatedRing ResourceWindowsUpdateRing
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, &patch, &updatedRing)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring", ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &updatedRing, nil
+}
+
+// UpdateWindowsUpdateRingByDisplayName updates a Windows update ring by its display name.
+func (c *Client) UpdateWindowsUpdateRingByDisplayName(displayName string, request *ResourceWindowsUpdateRing) (*ResourceWindowsUpdateRing, error) {
+	ring, err := c.GetWindowsUpdateRingByDisplayName(displayName)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedUpdateByName, "windows update ring", displayName, err)
+	}
+
+	return c.UpdateWindowsUpdateRingByID(ring.ID, request)
+}
+
+// DeleteWindowsUpdateRingByID deletes a Windows update ring by its ID.
+func (c *Client) DeleteWindowsUpdateRingByID(ringID string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsUpdateRings, ringID)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByID, "windows update ring", ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteWindowsUpdateRingByDisplayName deletes a Windows update ring by its display name.
+func (c *Client) DeleteWindowsUpdateRingByDisplayName(displayName string) error {
+	ring, err := c.GetWindowsUpdateRingByDisplayName(displayName)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedDeleteByName, "windows update ring", displayName, err)
+	}
+
+	return c.DeleteWindowsUpdateRingByID(ring.ID)
+}
+
+// patchWindowsUpdateRingState patches only the given pause or rollback properties of an update ring, leaving
+// its other settings untouched.
+func (c *Client) patchWindowsUpdateRingState(ringID, updateType, action string, properties map[string]interface{}) error {
+	if updateType != WindowsUpdateRingUpdateTypeQuality && updateType != WindowsUpdateRingUpdateTypeFeature {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring "+action, ringID, fmt.Sprintf("unsupported update type %q", updateType))
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", uriBetaWindowsUpdateRings, ringID)
+
+	// Set graph metadata values
+	properties["@odata.type"] = ODataTypeWindowsUpdateForBusinessConfiguration
+
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, properties, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring "+action, ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// PauseWindowsUpdateRingByID pauses quality or feature updates on the devices of an update ring for 35 days.
+// updateType is WindowsUpdateRingUpdateTypeQuality or WindowsUpdateRingUpdateTypeFeature.
+func (c *Client) PauseWindowsUpdateRingByID(ringID, updateType string) error {
+	return c.patchWindowsUpdateRingState(ringID, updateType, "pause", map[string]interface{}{
+		updateType + "UpdatesPaused": true,
+	})
+}
+
+// ResumeWindowsUpdateRingByID resumes quality or feature updates that were paused on an update ring.
+func (c *Client) ResumeWindowsUpdateRingByID(ringID, updateType string) error {
+	return c.patchWindowsUpdateRingState(ringID, updateType, "resume", map[string]interface{}{
+		updateType + "UpdatesPaused": false,
+	})
+}
+
+// RollbackWindowsUpdateRingByID uninstalls the latest quality or feature update from the devices of an update
+// ring, as the Uninstall action in the Intune portal does. Feature updates can only be uninstalled within the
+// uninstall period of the ring, and updates stay paused until resumed.
+func (c *Client) RollbackWindowsUpdateRingByID(ringID, updateType string) error {
+	return c.patchWindowsUpdateRingState(ringID, updateType, "rollback", map[string]interface{}{
+		updateType + "UpdatesWillBeRolledBack":      true,
+		updateType + "UpdatesRollbackStartDateTime": time.Now().UTC(),
+	})
+}
+
+// ExtendWindowsUpdateRingPauseByID extends a quality or feature update pause on an update ring by a further
+// 35 days.
+func (c *Client) ExtendWindowsUpdateRingPauseByID(ringID, updateType string) error {
+	var action string
+	switch updateType {
+	case WindowsUpdateRingUpdateTypeQuality:
+		action = "extendQualityUpdatesPause"
+	case WindowsUpdateRingUpdateTypeFeature:
+		action = "extendFeatureUpdatesPause"
+	default:
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring pause", ringID, fmt.Sprintf("unsupported update type %q", updateType))
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", uriBetaWindowsUpdateRings, ringID, strings.TrimPrefix(ODataTypeWindowsUpdateForBusinessConfiguration, "#"), action)
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, "windows update ring pause", ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetWindowsUpdateRingAssignments retrieves the assignments of a Windows update ring.
+func (c *Client) GetWindowsUpdateRingAssignments(ringID string) (*ResponseWindowsUpdateProfileAssignmentsList, error) {
+	return c.getWindowsUpdateProfileAssignments(uriBetaWindowsUpdateRings, "windows update ring", ringID)
+}
+
+// CreateWindowsUpdateRingAssignment assigns a Windows update ring. The supplied assignments replace any
+// existing assignments of the ring.
+func (c *Client) CreateWindowsUpdateRingAssignment(ringID string, assignment *AssignmentWindowsUpdateProfile) error {
+	return c.assignWindowsUpdateProfile(uriBetaWindowsUpdateRings, "windows update ring", ringID, odataTypeDeviceConfigurationAssignment, assignment)
+}
+
+// GetWindowsUpdateRingDeviceStatusOverview retrieves the device status overview of a Windows update ring.
+func (c *Client) GetWindowsUpdateRingDeviceStatusOverview(ringID string) (*ResponseWindowsUpdateRingStatusOverview, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatusOverview", uriBetaWindowsUpdateRings, ringID)
+
+	var overview ResponseWindowsUpdateRingStatusOverview
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &overview)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "windows update ring device status overview", ringID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &overview, nil
+}
+
+// GetWindowsUpdateRingDeviceStatuses retrieves the status of a Windows update ring on each device.
+func (c *Client) GetWindowsUpdateRingDeviceStatuses(ringID string) (*ResponseWindowsUpdateRingDeviceStatusesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceStatuses", uriBetaWindowsUpdateRings, ringID)
+
+	var statuses ResponseWindowsUpdateRingDeviceStatusesList
+	for endpoint != "" {
+		var page ResponseWindowsUpdateRingDeviceStatusesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update ring device statuses", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if statuses.ODataContext == "" {
+			statuses.ODataContext = page.ODataContext
+		}
+		statuses.Value = append(statuses.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update ring device statuses", err)
+			}
+		}
+	}
+
+	return &statuses, nil
+}
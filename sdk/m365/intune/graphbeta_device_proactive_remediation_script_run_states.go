@@ -0,0 +1,364 @@
+// graphbeta_device_proactive_remediation_script_run_states.go
+// Graph Beta Api - Intune: Proactive Remediations run states, run summary and on-demand remediation
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/remediations#monitor-your-scripts
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_Enrollment/UXAnalyticsMenu/~/proactiveRemediations
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-devices-devicehealthscriptdevicestate?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-devices-devicehealthscriptrunsummary?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/intune-devices-manageddevice-initiateondemandproactiveremediation?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaManagedDevices                         = "/beta/deviceManagement/managedDevices"
+	deviceHealthScriptDeviceRunStatesExpandDevice = "$expand=managedDevice($select=id,deviceName,operatingSystem,osVersion,userPrincipalName)"
+)
+
+// Detection states reported by a proactive remediation detection script run.
+const (
+	DeviceHealthScriptDetectionStateUnknown       = "unknown"
+	DeviceHealthScriptDetectionStateSuccess       = "success"
+	DeviceHealthScriptDetectionStateFail          = "fail"
+	DeviceHealthScriptDetectionStateScriptError   = "scriptError"
+	DeviceHealthScriptDetectionStatePending       = "pending"
+	DeviceHealthScriptDetectionStateNotApplicable = "notApplicable"
+)
+
+// Remediation states reported by a proactive remediation script run.
+const (
+	DeviceHealthScriptRemediationStateUnknown           = "unknown"
+	DeviceHealthScriptRemediationStateSkipped           = "skipped"
+	DeviceHealthScriptRemediationStateSuccess           = "success"
+	DeviceHealthScriptRemediationStateRemediationFailed = "remediationFailed"
+	DeviceHealthScriptRemediationStateScriptError       = "scriptError"
+)
+
+// ResponseDeviceHealthScriptDeviceRunStatesList represents the per device run states of a proactive remediation script.
+type ResponseDeviceHealthScriptDeviceRunStatesList struct {
+	ODataContext  string                             `json:"@odata.context"`
+	ODataNextLink string                             `json:"@odata.nextLink,omitempty"`
+	Value         []DeviceHealthScriptDeviceRunState `json:"value"`
+}
+
+// DeviceHealthScriptDeviceRunState represents the last detection and remediation result of a
+// proactive remediation script on a single device, including the script output and errors.
+type DeviceHealthScriptDeviceRunState struct {
+	ID                                   string                           `json:"id"`
+	DetectionState                       string                           `json:"detectionState"`
+	LastStateUpdateDateTime              time.Time                        `json:"lastStateUpdateDateTime"`
+	ExpectedStateUpdateDateTime          *time.Time                       `json:"expectedStateUpdateDateTime"`
+	LastSyncDateTime                     time.Time                        `json:"lastSyncDateTime"`
+	PreRemediationDetectionScriptOutput  string                           `json:"preRemediationDetectionScriptOutput"`
+	PreRemediationDetectionScriptError   string                           `json:"preRemediationDetectionScriptError"`
+	RemediationScriptError               string                           `json:"remediationScriptError"`
+	PostRemediationDetectionScriptOutput string                           `json:"postRemediationDetectionScriptOutput"`
+	PostRemediationDetectionScriptError  string                           `json:"postRemediationDetectionScriptError"`
+	RemediationState                     string                           `json:"remediationState"`
+	AssignmentFilterIds                  []string                         `json:"assignmentFilterIds"`
+	ManagedDevice                        *DeviceHealthScriptManagedDevice `json:"managedDevice,omitempty"`
+}
+
+// DeviceHealthScriptManagedDevice represents the subset of managed device properties expanded on a run state.
+type DeviceHealthScriptManagedDevice struct {
+	ID                string `json:"id"`
+	DeviceName        string `json:"deviceName"`
+	OperatingSystem   string `json:"operatingSystem"`
+	OsVersion         string `json:"osVersion"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+// ResponseDeviceHealthScriptRunSummary represents the run summary of a proactive remediation script
+// as calculated by Intune across all targeted devices.
+type ResponseDeviceHealthScriptRunSummary struct {
+	ODataContext                            string     `json:"@odata.context"`
+	ID                                      string     `json:"id"`
+	NoIssueDetectedDeviceCount              int        `json:"noIssueDetectedDeviceCount"`
+	IssueDetectedDeviceCount                int        `json:"issueDetectedDeviceCount"`
+	DetectionScriptErrorDeviceCount         int        `json:"detectionScriptErrorDeviceCount"`
+	DetectionScriptPendingDeviceCount       int        `json:"detectionScriptPendingDeviceCount"`
+	DetectionScriptNotApplicableDeviceCount int        `json:"detectionScriptNotApplicableDeviceCount"`
+	IssueRemediatedDeviceCount              int        `json:"issueRemediatedDeviceCount"`
+	RemediationSkippedDeviceCount           int        `json:"remediationSkippedDeviceCount"`
+	IssueReoccurredDeviceCount              int        `json:"issueReoccurredDeviceCount"`
+	RemediationScriptErrorDeviceCount       int        `json:"remediationScriptErrorDeviceCount"`
+	LastScriptRunDateTime                   *time.Time `json:"lastScriptRunDateTime"`
+	IssueRemediatedCumulativeDeviceCount    int        `json:"issueRemediatedCumulativeDeviceCount"`
+}
+
+// ResponseDeviceHealthScriptRemediationHistory represents the daily remediation history of a proactive remediation script.
+type ResponseDeviceHealthScriptRemediationHistory struct {
+	ODataContext         string                                     `json:"@odata.context"`
+	LastModifiedDateTime time.Time                                  `json:"lastModifiedDateTime"`
+	HistoryData          []DeviceHealthScriptRemediationHistoryItem `json:"historyData"`
+}
+
+// DeviceHealthScriptRemediationHistoryItem represents the remediation counts for a single day.
+type DeviceHealthScriptRemediationHistoryItem struct {
+	Date                    string `json:"date"`
+	RemediatedDeviceCount   int    `json:"remediatedDeviceCount"`
+	NoIssueDeviceCount      int    `json:"noIssueDeviceCount"`
+	DetectFailedDeviceCount int    `json:"detectFailedDeviceCount"`
+}
+
+// ResponseManagedDeviceHealthScriptStatesList represents the proactive remediation states of a single managed device.
+type ResponseManagedDeviceHealthScriptStatesList struct {
+	ODataContext  string                           `json:"@odata.context"`
+	ODataNextLink string                           `json:"@odata.nextLink,omitempty"`
+	Value         []ManagedDeviceHealthScriptState `json:"value"`
+}
+
+// ManagedDeviceHealthScriptState represents the last result of one proactive remediation script on a managed device.
+type ManagedDeviceHealthScriptState struct {
+	ID                                   string     `json:"id"`
+	DeviceId                             string     `json:"deviceId"`
+	PolicyId                             string     `json:"policyId"`
+	PolicyName                           string     `json:"policyName"`
+	UserId                               string     `json:"userId"`
+	UserName                             string     `json:"userName"`
+	DeviceName                           string     `json:"deviceName"`
+	OsVersion                            string     `json:"osVersion"`
+	DetectionState                       string     `json:"detectionState"`
+	LastStateUpdateDateTime              time.Time  `json:"lastStateUpdateDateTime"`
+	ExpectedStateUpdateDateTime          *time.Time `json:"expectedStateUpdateDateTime"`
+	LastSyncDateTime                     time.Time  `json:"lastSyncDateTime"`
+	PreRemediationDetectionScriptOutput  string     `json:"preRemediationDetectionScriptOutput"`
+	PreRemediationDetectionScriptError   string     `json:"preRemediationDetectionScriptError"`
+	RemediationScriptError               string     `json:"remediationScriptError"`
+	PostRemediationDetectionScriptOutput string     `json:"postRemediationDetectionScriptOutput"`
+	PostRemediationDetectionScriptError  string     `json:"postRemediationDetectionScriptError"`
+	RemediationState                     string     `json:"remediationState"`
+	AssignmentFilterIds                  []string   `json:"assignmentFilterIds"`
+}
+
+// ResourceInitiateOnDemandProactiveRemediation represents the request body of the
+// initiateOnDemandProactiveRemediation managed device action.
+type ResourceInitiateOnDemandProactiveRemediation struct {
+	ScriptPolicyId string `json:"scriptPolicyId"`
+}
+
+// ProactiveRemediationRunStateSummary represents the outcome of a proactive remediation script
+// across the fleet, aggregated locally from its device run states.
+type ProactiveRemediationRunStateSummary struct {
+	ScriptID                   string         `json:"scriptId"`
+	DisplayName                string         `json:"displayName"`
+	TotalDevices               int            `json:"totalDevices"`
+	NoIssueDetected            int            `json:"noIssueDetected"`
+	IssueRemediated            int            `json:"issueRemediated"`
+	IssueDetectedNotRemediated int            `json:"issueDetectedNotRemediated"`
+	RemediationFailed          int            `json:"remediationFailed"`
+	DetectionScriptError       int            `json:"detectionScriptError"`
+	Pending                    int            `json:"pending"`
+	NotApplicable              int            `json:"notApplicable"`
+	DetectionStateCounts       map[string]int `json:"detectionStateCounts"`
+	RemediationStateCounts     map[string]int `json:"remediationStateCounts"`
+}
+
+// Succeeded returns the number of devices with no issue detected or with the issue remediated.
+func (s *ProactiveRemediationRunStateSummary) Succeeded() int {
+	return s.NoIssueDetected + s.IssueRemediated
+}
+
+// Failed returns the number of devices where detection errored, remediation failed, or the issue
+// was detected and remains unremediated.
+func (s *ProactiveRemediationRunStateSummary) Failed() int {
+	return s.RemediationFailed + s.DetectionScriptError + s.IssueDetectedNotRemediated
+}
+
+// SummariseProactiveRemediationRunStates aggregates the device run states of a single proactive
+// remediation script into success and failure counts.
+func SummariseProactiveRemediationRunStates(scriptID, displayName string, states []DeviceHealthScriptDeviceRunState) ProactiveRemediationRunStateSummary {
+	summary := ProactiveRemediationRunStateSummary{
+		ScriptID:               scriptID,
+		DisplayName:            displayName,
+		TotalDevices:           len(states),
+		DetectionStateCounts:   make(map[string]int),
+		RemediationStateCounts: make(map[string]int),
+	}
+
+	for _, state := range states {
+		summary.DetectionStateCounts[state.DetectionState]++
+		summary.RemediationStateCounts[state.RemediationState]++
+
+		switch {
+		case state.RemediationState == DeviceHealthScriptRemediationStateSuccess:
+			summary.IssueRemediated++
+		case state.RemediationState == DeviceHealthScriptRemediationStateRemediationFailed,
+			state.RemediationState == DeviceHealthScriptRemediationStateScriptError:
+			summary.RemediationFailed++
+		case state.DetectionState == DeviceHealthScriptDetectionStateSuccess:
+			summary.NoIssueDetected++
+		case state.DetectionState == DeviceHealthScriptDetectionStateFail:
+			summary.IssueDetectedNotRemediated++
+		case state.DetectionState == DeviceHealthScriptDetectionStateScriptError:
+			summary.DetectionScriptError++
+		case state.DetectionState == DeviceHealthScriptDetectionStatePending:
+			summary.Pending++
+		case state.DetectionState == DeviceHealthScriptDetectionStateNotApplicable:
+			summary.NotApplicable++
+		}
+	}
+
+	return summary
+}
+
+// GetProactiveRemediationScriptDeviceRunStates retrieves the run state of a proactive remediation script
+// on every device it has been targeted to, following paging until all run states are returned.
+func (c *Client) GetProactiveRemediationScriptDeviceRunStates(scriptID string) (*ResponseDeviceHealthScriptDeviceRunStatesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceRunStates?%s", uriBetaProactiveRemediations, scriptID, deviceHealthScriptDeviceRunStatesExpandDevice)
+
+	var runStates ResponseDeviceHealthScriptDeviceRunStatesList
+	for endpoint != "" {
+		var page ResponseDeviceHealthScriptDeviceRunStatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "proactive remediation device run states", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		runStates.ODataContext = page.ODataContext
+		runStates.Value = append(runStates.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "proactive remediation device run states", err)
+			}
+		}
+	}
+
+	return &runStates, nil
+}
+
+// GetProactiveRemediationScriptRunSummary retrieves the run summary of a proactive remediation script.
+func (c *Client) GetProactiveRemediationScriptRunSummary(scriptID string) (*ResponseDeviceHealthScriptRunSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/runSummary", uriBetaProactiveRemediations, scriptID)
+
+	var runSummary ResponseDeviceHealthScriptRunSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &runSummary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "proactive remediation run summary", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &runSummary, nil
+}
+
+// GetProactiveRemediationScriptRemediationHistory retrieves the daily remediation history of a proactive remediation script.
+func (c *Client) GetProactiveRemediationScriptRemediationHistory(scriptID string) (*ResponseDeviceHealthScriptRemediationHistory, error) {
+	endpoint := fmt.Sprintf("%s/%s/getRemediationHistory()", uriBetaProactiveRemediations, scriptID)
+
+	var history ResponseDeviceHealthScriptRemediationHistory
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &history)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "proactive remediation history", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &history, nil
+}
+
+// GetManagedDeviceHealthScriptStates retrieves the proactive remediation history of a single managed device,
+// i.e. the last detection and remediation result of every proactive remediation script that ran on it.
+func (c *Client) GetManagedDeviceHealthScriptStates(managedDeviceID string) (*ResponseManagedDeviceHealthScriptStatesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/deviceHealthScriptStates", uriBetaManagedDevices, managedDeviceID)
+
+	var states ResponseManagedDeviceHealthScriptStatesList
+	for endpoint != "" {
+		var page ResponseManagedDeviceHealthScriptStatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "managed device health script states", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if states.ODataContext == "" {
+			states.ODataContext = page.ODataContext
+		}
+		states.Value = append(states.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "managed device health script states", err)
+			}
+		}
+	}
+
+	return &states, nil
+}
+
+// InitiateOnDemandProactiveRemediation runs a proactive remediation script on a managed device immediately,
+// outside of its assignment schedule.
+func (c *Client) InitiateOnDemandProactiveRemediation(managedDeviceID, scriptID string) error {
+	endpoint := fmt.Sprintf("%s/%s/initiateOnDemandProactiveRemediation", uriBetaManagedDevices, managedDeviceID)
+
+	request := ResourceInitiateOnDemandProactiveRemediation{
+		ScriptPolicyId: scriptID,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, nil)
+	if err != nil {
+		return fmt.Errorf("failed to initiate on demand proactive remediation %s on managed device %s, error: %v", scriptID, managedDeviceID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetProactiveRemediationScriptRunStateSummary retrieves the device run states of a proactive remediation
+// script and aggregates them into success and failure counts.
+func (c *Client) GetProactiveRemediationScriptRunStateSummary(scriptID string) (*ProactiveRemediationRunStateSummary, error) {
+	script, err := c.GetDeviceProactiveRemediationScriptByID(scriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	runStates, err := c.GetProactiveRemediationScriptDeviceRunStates(scriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := SummariseProactiveRemediationRunStates(script.ID, script.DisplayName, runStates.Value)
+	return &summary, nil
+}
+
+// GetProactiveRemediationFleetSummary aggregates the device run states of every proactive remediation
+// script into per script success and failure counts. Scripts are fetched in parallel, bounded by the
+// client's MaxConcurrentRequests, and summaries are returned in the order the scripts are listed.
+func (c *Client) GetProactiveRemediationFleetSummary() ([]ProactiveRemediationRunStateSummary, error) {
+	scripts, err := c.GetDeviceProactiveRemediationScripts()
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, "proactive remediations", err)
+	}
+
+	return shared.RunWorkerPool(c.maxConcurrentRequests(), len(scripts.Value), func(i int) (ProactiveRemediationRunStateSummary, error) {
+		script := scripts.Value[i]
+		runStates, err := c.GetProactiveRemediationScriptDeviceRunStates(script.ID)
+		if err != nil {
+			return ProactiveRemediationRunStateSummary{}, err
+		}
+		return SummariseProactiveRemediationRunStates(script.ID, script.DisplayName, runStates.Value), nil
+	})
+}
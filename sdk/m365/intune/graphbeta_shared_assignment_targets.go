@@ -0,0 +1,29 @@
+// graphbeta_shared_assignment_targets.go
+// Graph Beta Api - Assignment targets shared by policies, profiles and apps that use the /assign action.
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/configuration/device-profile-assign
+// Intune location:
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-shared-deviceandappmanagementassignmenttarget?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+const (
+	ODataTypeAssignmentTargetGroup            = "#microsoft.graph.groupAssignmentTarget"
+	ODataTypeAssignmentTargetExclusionGroup   = "#microsoft.graph.exclusionGroupAssignmentTarget"
+	ODataTypeAssignmentTargetAllDevices       = "#microsoft.graph.allDevicesAssignmentTarget"
+	ODataTypeAssignmentTargetAllLicensedUsers = "#microsoft.graph.allLicensedUsersAssignmentTarget"
+
+	AssignmentFilterTypeNone    = "none"
+	AssignmentFilterTypeInclude = "include"
+	AssignmentFilterTypeExclude = "exclude"
+)
+
+// DeviceAndAppManagementAssignmentTarget represents the target of an assignment, such as a group,
+// an exclusion group, all devices or all licensed users, with an optional assignment filter.
+type DeviceAndAppManagementAssignmentTarget struct {
+	ODataType                                  string `json:"@odata.type"`
+	DeviceAndAppManagementAssignmentFilterID   string `json:"deviceAndAppManagementAssignmentFilterId,omitempty"`
+	DeviceAndAppManagementAssignmentFilterType string `json:"deviceAndAppManagementAssignmentFilterType,omitempty"`
+	GroupID                                    string `json:"groupId,omitempty"`
+	CollectionID                               string `json:"collectionId,omitempty"`
+}
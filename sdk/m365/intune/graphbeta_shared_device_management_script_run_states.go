@@ -0,0 +1,315 @@
+// graphbeta_shared_device_management_script_run_states.go
+// Graph Beta Api - Execution results for device_management_scripts (PowerShell) and device_shell_scripts (macOS shell).
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/intune-management-extension#monitor-run-status
+// Intune location:
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-shared-devicemanagementscriptdevicestate?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-shared-devicemanagementscriptuserstate?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-shared-devicemanagementscriptrunsummary?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+// ScriptRunState is the result of a script run on a device as reported by the Intune management extension.
+type ScriptRunState string
+
+// Run states reported for PowerShell and shell script executions.
+const (
+	ScriptRunStateUnknown       ScriptRunState = "unknown"
+	ScriptRunStateSuccess       ScriptRunState = "success"
+	ScriptRunStateFail          ScriptRunState = "fail"
+	ScriptRunStateScriptError   ScriptRunState = "scriptError"
+	ScriptRunStatePending       ScriptRunState = "pending"
+	ScriptRunStateNotApplicable ScriptRunState = "notApplicable"
+)
+
+// IsFailure reports whether the run state represents a failed script execution.
+func (s ScriptRunState) IsFailure() bool {
+	return s == ScriptRunStateFail || s == ScriptRunStateScriptError
+}
+
+// ResponseDeviceManagementScriptDeviceRunStatesList represents a page of per device run states of a script.
+type ResponseDeviceManagementScriptDeviceRunStatesList struct {
+	ODataContext  string                                 `json:"@odata.context"`
+	ODataNextLink string                                 `json:"@odata.nextLink,omitempty"`
+	Value         []DeviceManagementScriptDeviceRunState `json:"value"`
+}
+
+// DeviceManagementScriptDeviceRunState represents the result of a script run on a single device.
+// ResultMessage holds the raw output written by the script.
+type DeviceManagementScriptDeviceRunState struct {
+	ID                      string                           `json:"id"`
+	RunState                ScriptRunState                   `json:"runState"`
+	ResultMessage           string                           `json:"resultMessage"`
+	LastStateUpdateDateTime time.Time                        `json:"lastStateUpdateDateTime"`
+	ErrorCode               int                              `json:"errorCode"`
+	ErrorDescription        string                           `json:"errorDescription"`
+	ManagedDevice           *DeviceHealthScriptManagedDevice `json:"managedDevice,omitempty"`
+}
+
+// ResponseDeviceManagementScriptUserRunStatesList represents the per user run states of a script.
+type ResponseDeviceManagementScriptUserRunStatesList struct {
+	ODataContext  string                               `json:"@odata.context"`
+	ODataNextLink string                               `json:"@odata.nextLink,omitempty"`
+	Value         []DeviceManagementScriptUserRunState `json:"value"`
+}
+
+// DeviceManagementScriptUserRunState represents the result of a script run for a single user across their devices.
+type DeviceManagementScriptUserRunState struct {
+	ID                      string    `json:"id"`
+	UserPrincipalName       string    `json:"userPrincipalName"`
+	SuccessDeviceCount      int       `json:"successDeviceCount"`
+	ErrorDeviceCount        int       `json:"errorDeviceCount"`
+	LastStateUpdateDateTime time.Time `json:"lastStateUpdateDateTime"`
+}
+
+// ResponseDeviceManagementScriptRunSummary represents the run summary of a PowerShell or shell script.
+type ResponseDeviceManagementScriptRunSummary struct {
+	ODataContext       string `json:"@odata.context"`
+	ID                 string `json:"id"`
+	SuccessDeviceCount int    `json:"successDeviceCount"`
+	ErrorDeviceCount   int    `json:"errorDeviceCount"`
+	SuccessUserCount   int    `json:"successUserCount"`
+	ErrorUserCount     int    `json:"errorUserCount"`
+}
+
+// DeviceManagementScriptDeviceRunStateIterator streams the device run states of a script one at a time,
+// requesting the next page from Microsoft Graph only when the current page has been consumed.
+//
+//	iterator := client.NewDeviceManagementScriptDeviceRunStateIterator(scriptID)
+//	for iterator.Next() {
+//		state := iterator.RunState()
+//	}
+//	if err := iterator.Err(); err != nil {
+//	}
+type DeviceManagementScriptDeviceRunStateIterator struct {
+	client   *Client
+	endpoint string
+	page     []DeviceManagementScriptDeviceRunState
+	index    int
+	current  DeviceManagementScriptDeviceRunState
+	err      error
+}
+
+// NewDeviceManagementScriptDeviceRunStateIterator returns an iterator over the device run states of a
+// PowerShell device management script.
+func (c *Client) NewDeviceManagementScriptDeviceRunStateIterator(scriptID string) *DeviceManagementScriptDeviceRunStateIterator {
+	return &DeviceManagementScriptDeviceRunStateIterator{
+		client:   c,
+		endpoint: fmt.Sprintf("%s/%s/deviceRunStates?%s", uriBetaDeviceManagementScripts, scriptID, deviceHealthScriptDeviceRunStatesExpandDevice),
+	}
+}
+
+// NewDeviceShellScriptDeviceRunStateIterator returns an iterator over the device run states of a macOS shell script.
+func (c *Client) NewDeviceShellScriptDeviceRunStateIterator(scriptID string) *DeviceManagementScriptDeviceRunStateIterator {
+	return &DeviceManagementScriptDeviceRunStateIterator{
+		client:   c,
+		endpoint: fmt.Sprintf("%s/%s/deviceRunStates?%s", uriBetaDeviceShellScripts, scriptID, deviceHealthScriptDeviceRunStatesExpandDevice),
+	}
+}
+
+// Next advances the iterator to the next run state, fetching the next page when required.
+// It returns false when there are no more run states or an error occurred.
+func (it *DeviceManagementScriptDeviceRunStateIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.index >= len(it.page) {
+		if it.endpoint == "" {
+			return false
+		}
+		if !it.fetchPage() {
+			return false
+		}
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// RunState returns the run state the iterator is positioned on.
+func (it *DeviceManagementScriptDeviceRunStateIterator) RunState() DeviceManagementScriptDeviceRunState {
+	return it.current
+}
+
+// Err returns the first error encountered while fetching run states, if any.
+func (it *DeviceManagementScriptDeviceRunStateIterator) Err() error {
+	return it.err
+}
+
+// fetchPage requests the page at the iterator's endpoint and queues the link to the following page.
+func (it *DeviceManagementScriptDeviceRunStateIterator) fetchPage() bool {
+	var page ResponseDeviceManagementScriptDeviceRunStatesList
+	resp, err := it.client.HTTP.DoRequest("GET", it.endpoint, nil, &page)
+	if err != nil {
+		it.err = fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "script device run states", err)
+		return false
+	}
+
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+
+	it.page = page.Value
+	it.index = 0
+	it.endpoint = ""
+	if page.ODataNextLink != "" {
+		if it.endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+			it.err = fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "script device run states", err)
+			return false
+		}
+	}
+
+	return true
+}
+
+// collectDeviceManagementScriptDeviceRunStates drains an iterator into a list response.
+func collectDeviceManagementScriptDeviceRunStates(iterator *DeviceManagementScriptDeviceRunStateIterator) (*ResponseDeviceManagementScriptDeviceRunStatesList, error) {
+	var runStates ResponseDeviceManagementScriptDeviceRunStatesList
+	for iterator.Next() {
+		runStates.Value = append(runStates.Value, iterator.RunState())
+	}
+
+	if err := iterator.Err(); err != nil {
+		return nil, err
+	}
+
+	return &runStates, nil
+}
+
+// GetDeviceManagementScriptDeviceRunStates retrieves every device run state of a PowerShell device management script.
+func (c *Client) GetDeviceManagementScriptDeviceRunStates(scriptID string) (*ResponseDeviceManagementScriptDeviceRunStatesList, error) {
+	return collectDeviceManagementScriptDeviceRunStates(c.NewDeviceManagementScriptDeviceRunStateIterator(scriptID))
+}
+
+// GetDeviceShellScriptDeviceRunStates retrieves every device run state of a macOS shell script.
+func (c *Client) GetDeviceShellScriptDeviceRunStates(scriptID string) (*ResponseDeviceManagementScriptDeviceRunStatesList, error) {
+	return collectDeviceManagementScriptDeviceRunStates(c.NewDeviceShellScriptDeviceRunStateIterator(scriptID))
+}
+
+// GetDeviceManagementScriptUserRunStates retrieves every per user run states of a PowerShell device management script.
+func (c *Client) GetDeviceManagementScriptUserRunStates(scriptID string) (*ResponseDeviceManagementScriptUserRunStatesList, error) {
+	return c.getScriptUserRunStates(uriBetaDeviceManagementScripts, scriptID)
+}
+
+// GetDeviceShellScriptUserRunStates retrieves every per user run states of a macOS shell script.
+func (c *Client) GetDeviceShellScriptUserRunStates(scriptID string) (*ResponseDeviceManagementScriptUserRunStatesList, error) {
+	return c.getScriptUserRunStates(uriBetaDeviceShellScripts, scriptID)
+}
+
+// getScriptUserRunStates retrieves every per user run state of a script of the given resource type.
+func (c *Client) getScriptUserRunStates(resourceTypeURI, scriptID string) (*ResponseDeviceManagementScriptUserRunStatesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/userRunStates", resourceTypeURI, scriptID)
+
+	var userRunStates ResponseDeviceManagementScriptUserRunStatesList
+	for endpoint != "" {
+		var page ResponseDeviceManagementScriptUserRunStatesList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "script user run states", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if userRunStates.ODataContext == "" {
+			userRunStates.ODataContext = page.ODataContext
+		}
+		userRunStates.Value = append(userRunStates.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "script user run states", err)
+			}
+		}
+	}
+
+	return &userRunStates, nil
+}
+
+// GetDeviceManagementScriptRunSummary retrieves the run summary of a PowerShell device management script.
+func (c *Client) GetDeviceManagementScriptRunSummary(scriptID string) (*ResponseDeviceManagementScriptRunSummary, error) {
+	return c.getScriptRunSummary(uriBetaDeviceManagementScripts, scriptID)
+}
+
+// GetDeviceShellScriptRunSummary retrieves the run summary of a macOS shell script.
+func (c *Client) GetDeviceShellScriptRunSummary(scriptID string) (*ResponseDeviceManagementScriptRunSummary, error) {
+	return c.getScriptRunSummary(uriBetaDeviceShellScripts, scriptID)
+}
+
+// getScriptRunSummary retrieves the run summary of a script of the given resource type.
+func (c *Client) getScriptRunSummary(resourceTypeURI, scriptID string) (*ResponseDeviceManagementScriptRunSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/runSummary", resourceTypeURI, scriptID)
+
+	var runSummary ResponseDeviceManagementScriptRunSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &runSummary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, "script run summary", scriptID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &runSummary, nil
+}
+
+// ExportDeviceManagementScriptRunStatesCSV streams run states from the iterator to w as CSV, including
+// the raw resultMessage written by the script, for troubleshooting outside of the Intune portal.
+// When failuresOnly is set, only runs with a fail or scriptError state are written.
+func ExportDeviceManagementScriptRunStatesCSV(w io.Writer, iterator *DeviceManagementScriptDeviceRunStateIterator, failuresOnly bool) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"deviceId", "deviceName", "userPrincipalName", "runState", "errorCode", "errorDescription", "lastStateUpdateDateTime", "resultMessage"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write script run states, error: %v", err)
+	}
+
+	for iterator.Next() {
+		state := iterator.RunState()
+		if failuresOnly && !state.RunState.IsFailure() {
+			continue
+		}
+
+		var deviceID, deviceName, userPrincipalName string
+		if state.ManagedDevice != nil {
+			deviceID = state.ManagedDevice.ID
+			deviceName = state.ManagedDevice.DeviceName
+			userPrincipalName = state.ManagedDevice.UserPrincipalName
+		}
+
+		record := []string{
+			deviceID,
+			deviceName,
+			userPrincipalName,
+			string(state.RunState),
+			strconv.Itoa(state.ErrorCode),
+			state.ErrorDescription,
+			state.LastStateUpdateDateTime.Format(time.RFC3339),
+			state.ResultMessage,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write script run states, error: %v", err)
+		}
+	}
+
+	if err := iterator.Err(); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
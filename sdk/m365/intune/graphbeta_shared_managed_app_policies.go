@@ -0,0 +1,219 @@
+// graphbeta_shared_managed_app_policies.go
+// Graph Beta Api - Targeted apps, assignments and deployment summary shared by app protection policies and app configuration policies for managed apps.
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/apps/app-protection-policy
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/AppsMenu/~/protection
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-mam-targetedmanagedappprotection?view=graph-rest-beta
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-mam-managedapppolicydeploymentsummary?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+
+package intune
+
+import (
+	"fmt"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	managedAppPolicyExpand = "$expand=apps,assignments"
+
+	odataTypeManagedMobileApp                   = "#microsoft.graph.managedMobileApp"
+	odataTypeTargetedManagedAppPolicyAssignment = "#microsoft.graph.targetedManagedAppPolicyAssignment"
+
+	ODataTypeIOSMobileAppIdentifier     = "#microsoft.graph.iosMobileAppIdentifier"
+	ODataTypeAndroidMobileAppIdentifier = "#microsoft.graph.androidMobileAppIdentifier"
+
+	TargetedManagedAppGroupTypeSelectedPublicApps   = "selectedPublicApps"
+	TargetedManagedAppGroupTypeAllCoreMicrosoftApps = "allCoreMicrosoftApps"
+	TargetedManagedAppGroupTypeAllMicrosoftApps     = "allMicrosoftApps"
+	TargetedManagedAppGroupTypeAllApps              = "allApps"
+
+	AppManagementLevelUnspecified                    = "unspecified"
+	AppManagementLevelUnmanaged                      = "unmanaged"
+	AppManagementLevelMdm                            = "mdm"
+	AppManagementLevelAndroidEnterprise              = "androidEnterprise"
+	AppManagementLevelAndroidEnterpriseDedicated     = "androidEnterpriseDedicatedDevicesWithAzureAdSharedMode"
+	AppManagementLevelAndroidOpenSourceProject       = "androidOpenSourceProjectUserAssociated"
+	AppManagementLevelAndroidOpenSourceProjectNoUser = "androidOpenSourceProjectUserless"
+)
+
+// KeyValuePair represents a name and value pair, such as an app configuration setting or an exempted app.
+type KeyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ManagedMobileApp represents an app targeted by an app protection or app configuration policy.
+type ManagedMobileApp struct {
+	ODataType           string              `json:"@odata.type,omitempty"`
+	ID                  string              `json:"id,omitempty"`
+	MobileAppIdentifier MobileAppIdentifier `json:"mobileAppIdentifier"`
+	Version             string              `json:"version,omitempty"`
+}
+
+// MobileAppIdentifier identifies an app by its iOS bundle ID or Android package ID.
+type MobileAppIdentifier struct {
+	ODataType string `json:"@odata.type"`
+	BundleID  string `json:"bundleId,omitempty"`
+	PackageID string `json:"packageId,omitempty"`
+}
+
+// ResponseTargetedManagedAppPolicyAssignmentsList represents the assignments of a managed app policy.
+type ResponseTargetedManagedAppPolicyAssignmentsList struct {
+	ODataContext string                               `json:"@odata.context"`
+	Value        []TargetedManagedAppPolicyAssignment `json:"value"`
+}
+
+// AssignmentTargetedManagedAppPolicy represents the request body of the managed app policy assign action.
+type AssignmentTargetedManagedAppPolicy struct {
+	Assignments []TargetedManagedAppPolicyAssignment `json:"assignments"`
+}
+
+// TargetedManagedAppPolicyAssignment represents the assignment of a managed app policy to a group. Managed app
+// policies are assigned to users, so all devices targets are not supported.
+type TargetedManagedAppPolicyAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Source    string                                 `json:"source,omitempty"`
+	SourceID  string                                 `json:"sourceId,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// ResponseManagedAppPolicyDeploymentSummary represents the number of users a managed app policy has been
+// applied to, in total and per targeted app.
+type ResponseManagedAppPolicyDeploymentSummary struct {
+	ODataContext                         string                                    `json:"@odata.context"`
+	ID                                   string                                    `json:"id"`
+	DisplayName                          string                                    `json:"displayName"`
+	ConfigurationDeployedUserCount       int                                       `json:"configurationDeployedUserCount"`
+	LastRefreshTime                      time.Time                                 `json:"lastRefreshTime"`
+	ConfigurationDeploymentSummaryPerApp []ManagedAppPolicyDeploymentSummaryPerApp `json:"configurationDeploymentSummaryPerApp"`
+	Version                              string                                    `json:"version"`
+}
+
+// ManagedAppPolicyDeploymentSummaryPerApp represents the number of users a managed app policy has been applied
+// to for a single app.
+type ManagedAppPolicyDeploymentSummaryPerApp struct {
+	MobileAppIdentifier           MobileAppIdentifier `json:"mobileAppIdentifier"`
+	ConfigurationAppliedUserCount int                 `json:"configurationAppliedUserCount"`
+}
+
+// NewIOSManagedMobileApp returns an iOS app to target by its bundle ID, e.g. com.microsoft.Office.Outlook.
+func NewIOSManagedMobileApp(bundleID string) ManagedMobileApp {
+	return ManagedMobileApp{
+		ODataType: odataTypeManagedMobileApp,
+		MobileAppIdentifier: MobileAppIdentifier{
+			ODataType: ODataTypeIOSMobileAppIdentifier,
+			BundleID:  bundleID,
+		},
+	}
+}
+
+// NewAndroidManagedMobileApp returns an Android app to target by its package ID, e.g. com.microsoft.office.outlook.
+func NewAndroidManagedMobileApp(packageID string) ManagedMobileApp {
+	return ManagedMobileApp{
+		ODataType: odataTypeManagedMobileApp,
+		MobileAppIdentifier: MobileAppIdentifier{
+			ODataType: ODataTypeAndroidMobileAppIdentifier,
+			PackageID: packageID,
+		},
+	}
+}
+
+// targetManagedAppPolicyApps replaces the apps targeted by a managed app policy using the targetApps action.
+func (c *Client) targetManagedAppPolicyApps(resourceTypeURI, resourceName, policyID, appGroupType string, apps []ManagedMobileApp) error {
+	endpoint := fmt.Sprintf("%s/%s/targetApps", resourceTypeURI, policyID)
+
+	// Set graph metadata values
+	for i := range apps {
+		apps[i].ODataType = odataTypeManagedMobileApp
+	}
+	if apps == nil {
+		apps = []ManagedMobileApp{}
+	}
+
+	requestBody := struct {
+		Apps         []ManagedMobileApp `json:"apps"`
+		AppGroupType string             `json:"appGroupType,omitempty"`
+	}{
+		Apps:         apps,
+		AppGroupType: appGroupType,
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedUpdateByID, resourceName+" apps", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// getTargetedManagedAppPolicyAssignments retrieves the assignments of a managed app policy.
+func (c *Client) getTargetedManagedAppPolicyAssignments(resourceTypeURI, resourceName, policyID string) (*ResponseTargetedManagedAppPolicyAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", resourceTypeURI, policyID)
+
+	var assignments ResponseTargetedManagedAppPolicyAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" assignments", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// assignTargetedManagedAppPolicy assigns a managed app policy using the assign action. The supplied assignments
+// replace any existing assignments of the policy.
+func (c *Client) assignTargetedManagedAppPolicy(resourceTypeURI, resourceName, policyID string, assignment *AssignmentTargetedManagedAppPolicy) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", resourceTypeURI, policyID)
+
+	for _, policyAssignment := range assignment.Assignments {
+		if policyAssignment.Target.ODataType == ODataTypeAssignmentTargetAllDevices {
+			return fmt.Errorf(shared.ErrorMsgFailedAssign, resourceName, policyID, "managed app policies are assigned to users and cannot target all devices")
+		}
+	}
+
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = odataTypeTargetedManagedAppPolicyAssignment
+	}
+	if assignment.Assignments == nil {
+		assignment.Assignments = []TargetedManagedAppPolicyAssignment{}
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, resourceName, policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// getManagedAppPolicyDeploymentSummary retrieves the deployment summary of a managed app policy.
+func (c *Client) getManagedAppPolicyDeploymentSummary(resourceTypeURI, resourceName, policyID string) (*ResponseManagedAppPolicyDeploymentSummary, error) {
+	endpoint := fmt.Sprintf("%s/%s/deploymentSummary", resourceTypeURI, policyID)
+
+	var summary ResponseManagedAppPolicyDeploymentSummary
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &summary)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" deployment summary", policyID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &summary, nil
+}
@@ -0,0 +1,337 @@
+// graphbeta_shared_reports.go
+// Graph Beta Api - Intune: Shared report stream handling
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/fundamentals/reports-export-graph-apis
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-reporting-devicemanagementreports?view=graph-rest-beta
+// Report actions such as evaluateAssignmentFilter return a JSON document describing the columns (Schema)
+// and the rows (Values) rather than a list of resources, and are served as application/octet-stream.
+// Rows of a report stream, or of an exported CSV report, can be decoded into structs with report column tags.
+
+package intune
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+// ResponseReportStream represents a tabular report returned by an Intune report action.
+type ResponseReportStream struct {
+	TotalRowCount int                  `json:"TotalRowCount"`
+	Schema        []ReportSchemaColumn `json:"Schema"`
+	Values        [][]interface{}      `json:"Values"`
+	SessionId     string               `json:"SessionId,omitempty"`
+}
+
+// ReportSchemaColumn describes a column of a report stream.
+type ReportSchemaColumn struct {
+	Column       string `json:"Column"`
+	PropertyType string `json:"PropertyType"`
+}
+
+// Rows returns the report rows as maps keyed by column name.
+func (r *ResponseReportStream) Rows() []map[string]interface{} {
+	rows := make([]map[string]interface{}, len(r.Values))
+	for i, values := range r.Values {
+		row := make(map[string]interface{}, len(r.Schema))
+		for j, column := range r.Schema {
+			if j < len(values) {
+				row[column.Column] = values[j]
+			}
+		}
+		rows[i] = row
+	}
+
+	return rows
+}
+
+// DecodeRows decodes the report rows into the slice of structs, or of pointers to structs, v points to. Each
+// row is decoded with DecodeReportRow, so fields are matched to columns by their report tags or names.
+func (r *ResponseReportStream) DecodeRows(v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("report rows must be decoded into a pointer to a slice, got %T", v)
+	}
+
+	slice := target.Elem()
+	elementType := slice.Type().Elem()
+	structType := elementType
+	if elementType.Kind() == reflect.Ptr {
+		structType = elementType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("report rows must be decoded into a slice of structs, got %T", v)
+	}
+
+	rows := r.Rows()
+	decoded := reflect.MakeSlice(slice.Type(), 0, len(rows))
+	for i, row := range rows {
+		element := reflect.New(structType)
+		if err := DecodeReportRow(row, element.Interface()); err != nil {
+			return fmt.Errorf("failed to decode report row %d: %v", i, err)
+		}
+
+		if elementType.Kind() == reflect.Ptr {
+			decoded = reflect.Append(decoded, element)
+		} else {
+			decoded = reflect.Append(decoded, element.Elem())
+		}
+	}
+	slice.Set(decoded)
+
+	return nil
+}
+
+// reportStreamBody captures a report response body whether the service labels it application/json,
+// in which case it is handed over through UnmarshalJSON, or application/octet-stream, in which case it
+// is written through the embedded buffer.
+type reportStreamBody struct {
+	bytes.Buffer
+}
+
+func (b *reportStreamBody) UnmarshalJSON(data []byte) error {
+	b.Reset()
+	_, err := b.Write(data)
+	return err
+}
+
+// postReportStream posts a report request and decodes the report stream it returns.
+func (c *Client) postReportStream(endpoint string, request interface{}, reportName string) (*ResponseReportStream, error) {
+	var body reportStreamBody
+	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &body)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, reportName, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	var report ResponseReportStream
+	if err := json.Unmarshal(body.Bytes(), &report); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGet, reportName, err)
+	}
+
+	return &report, nil
+}
+
+// postReportStreamAllPages posts a report request page by page, advancing skip by the rows returned until
+// TotalRowCount rows or an empty page have been returned, and merges the pages into a single report stream.
+// The request is not modified.
+func (c *Client) postReportStreamAllPages(endpoint string, request *ReportRequest, reportName string) (*ResponseReportStream, error) {
+	page := *request
+	if page.Top <= 0 {
+		page.Top = reportStreamPageSize
+	}
+
+	var report ResponseReportStream
+	for {
+		pageReport, err := c.postReportStream(endpoint, &page, reportName)
+		if err != nil {
+			return nil, err
+		}
+
+		if report.Schema == nil {
+			report.Schema = pageReport.Schema
+			report.SessionId = pageReport.SessionId
+		}
+		report.TotalRowCount = pageReport.TotalRowCount
+		report.Values = append(report.Values, pageReport.Values...)
+
+		// The service may cap pages below Top, so a short page only marks the end when TotalRowCount is unset
+		rows := len(pageReport.Values)
+		if rows == 0 {
+			return &report, nil
+		}
+		if pageReport.TotalRowCount > 0 {
+			if page.Skip+rows >= pageReport.TotalRowCount {
+				return &report, nil
+			}
+		} else if rows < page.Top {
+			return &report, nil
+		}
+		page.Skip += rows
+	}
+}
+
+// reportTimeLayouts are the timestamp formats used in report rows. Report timestamps are UTC and are often
+// written without a zone designator.
+var reportTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.9999999",
+	"2006-01-02 15:04:05.9999999",
+	"1/2/2006 3:04:05 PM",
+}
+
+// DecodeReportRow copies the values of a report row into the struct v points to. A column is matched to the
+// field tagged with its name, e.g. `report:"DeviceName"`, or otherwise to the field of the same name ignoring
+// case; fields tagged `report:"-"` are skipped. Values may be JSON decoded report stream values or CSV strings
+// and are converted to string, bool, integer, float and time.Time fields or pointers to them. Empty values
+// leave the field unchanged.
+func DecodeReportRow(row map[string]interface{}, v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("report rows must be decoded into a pointer to a struct, got %T", v)
+	}
+	target = target.Elem()
+
+	fields := reportStructFields(target.Type())
+	for column, value := range row {
+		index, ok := fields[strings.ToLower(column)]
+		if !ok {
+			continue
+		}
+		if err := setReportValue(target.Field(index), value); err != nil {
+			return fmt.Errorf("failed to decode report column %s: %v", column, err)
+		}
+	}
+
+	return nil
+}
+
+// reportStructFields maps lower case column names to the index of the struct field they are decoded into.
+// Tagged fields take precedence over fields matched by name.
+func reportStructFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	tagged := make(map[string]bool, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get("report")
+		switch {
+		case tag == "-":
+		case tag != "":
+			fields[strings.ToLower(tag)] = i
+			tagged[strings.ToLower(tag)] = true
+		case !tagged[strings.ToLower(field.Name)]:
+			fields[strings.ToLower(field.Name)] = i
+		}
+	}
+
+	return fields
+}
+
+// setReportValue converts a report value to the type of a struct field and sets it.
+func setReportValue(field reflect.Value, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	if text, ok := value.(string); ok && text == "" {
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		element := reflect.New(field.Type().Elem())
+		if err := setReportValue(element.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(element)
+		return nil
+	}
+
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		text := reportValueString(value)
+		for _, layout := range reportTimeLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				field.Set(reflect.ValueOf(parsed))
+				return nil
+			}
+		}
+		return fmt.Errorf("unrecognised timestamp %q", text)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(reportValueString(value))
+	case reflect.Bool:
+		switch typed := value.(type) {
+		case bool:
+			field.SetBool(typed)
+		case float64:
+			field.SetBool(typed != 0)
+		default:
+			parsed, err := strconv.ParseBool(reportValueString(value))
+			if err != nil {
+				return err
+			}
+			field.SetBool(parsed)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := reportValueFloat(value)
+		if err != nil {
+			return err
+		}
+		if field.OverflowInt(int64(number)) {
+			return fmt.Errorf("%v overflows %s", value, field.Type())
+		}
+		field.SetInt(int64(number))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, err := reportValueFloat(value)
+		if err != nil {
+			return err
+		}
+		if number < 0 || field.OverflowUint(uint64(number)) {
+			return fmt.Errorf("%v overflows %s", value, field.Type())
+		}
+		field.SetUint(uint64(number))
+	case reflect.Float32, reflect.Float64:
+		number, err := reportValueFloat(value)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(number)
+	case reflect.Interface:
+		field.Set(reflect.ValueOf(value))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+// reportValueString returns a report value as a string. Numbers are decoded from JSON as float64 and are
+// formatted without an exponent.
+func reportValueString(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+// reportValueFloat returns a numeric report value.
+func reportValueFloat(value interface{}) (float64, error) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(typed), 64)
+	default:
+		return 0, fmt.Errorf("%v is not a number", value)
+	}
+}
+
+// reportRowString returns a value of a report row as a string.
+func reportRowString(row map[string]interface{}, column string) string {
+	return reportValueString(row[column])
+}
+
+// reportRowInt64 returns a numeric value of a report row, or 0 when it is empty or not a number.
+func reportRowInt64(row map[string]interface{}, column string) int64 {
+	number, _ := reportValueFloat(row[column])
+	return int64(number)
+}
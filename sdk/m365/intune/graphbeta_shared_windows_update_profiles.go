@@ -0,0 +1,207 @@
+// graphbeta_shared_windows_update_profiles.go
+// Graph Beta Api - Assignments, update catalog and deployment state shared by Windows update rings and Windows feature, quality and driver update profiles.
+// Documentation: https://learn.microsoft.com/en-us/mem/intune/protect/windows-update-for-business-configure
+// Intune location: https://intune.microsoft.com/#view/Microsoft_Intune_DeviceSettings/DevicesWindowsMenu/~/windows10UpdateRings
+// API reference: https://learn.microsoft.com/en-us/graph/api/resources/intune-softwareupdate-windowsupdatecatalogitem?view=graph-rest-beta
+// Microsoft Graph requires the structs to support a JSON data structure.
+// The deployment state of feature, quality and driver update profiles is only available from the Windows
+// Update reports, so it is read with a report export job filtered to the profile.
+
+package intune
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	shared "github.com/deploymenttheory/go-api-sdk-m365/sdk/shared"
+)
+
+const (
+	uriBetaWindowsUpdateCatalogItems = "/beta/deviceManagement/windowsUpdateCatalogItems"
+
+	ODataTypeWindowsFeatureUpdateCatalogItem = "#microsoft.graph.windowsFeatureUpdateCatalogItem"
+	ODataTypeWindowsQualityUpdateCatalogItem = "#microsoft.graph.windowsQualityUpdateCatalogItem"
+
+	WindowsQualityUpdateClassificationAll         = "all"
+	WindowsQualityUpdateClassificationSecurity    = "security"
+	WindowsQualityUpdateClassificationNonSecurity = "nonSecurity"
+)
+
+// windowsUpdateDeviceStateReportColumns are the columns requested from the Windows Update device state reports.
+var windowsUpdateDeviceStateReportColumns = []string{
+	"PolicyId", "PolicyName", "DeviceId", "AADDeviceId", "DeviceName", "UPN", "UpdateCategory",
+	"AggregateState", "CurrentDeviceUpdateStatus", "CurrentDeviceUpdateSubstatus", "LatestAlertMessage",
+	"LastSuccessfulDeviceUpdateStateTime", "EventDateTimeUTC",
+}
+
+// WindowsUpdateProfileAssignment represents the assignment of a Windows update ring or a feature, quality or
+// driver update profile to a group.
+type WindowsUpdateProfileAssignment struct {
+	ODataType string                                 `json:"@odata.type,omitempty"`
+	ID        string                                 `json:"id,omitempty"`
+	Target    DeviceAndAppManagementAssignmentTarget `json:"target"`
+}
+
+// ResponseWindowsUpdateProfileAssignmentsList represents the assignments of a Windows update ring or profile.
+type ResponseWindowsUpdateProfileAssignmentsList struct {
+	ODataContext string                           `json:"@odata.context"`
+	Value        []WindowsUpdateProfileAssignment `json:"value"`
+}
+
+// AssignmentWindowsUpdateProfile represents the request body of the Windows update ring and profile assign actions.
+type AssignmentWindowsUpdateProfile struct {
+	Assignments []WindowsUpdateProfileAssignment `json:"assignments"`
+}
+
+// ResponseWindowsUpdateCatalogItemsList represents a list of Windows update catalog items.
+type ResponseWindowsUpdateCatalogItemsList struct {
+	ODataContext  string                     `json:"@odata.context"`
+	ODataNextLink string                     `json:"@odata.nextLink,omitempty"`
+	Value         []WindowsUpdateCatalogItem `json:"value"`
+}
+
+// WindowsUpdateCatalogItem represents a feature or quality update that can be deployed by a Windows update
+// profile. Version is set for feature updates; the remaining fields are set for quality updates.
+type WindowsUpdateCatalogItem struct {
+	ODataType        string     `json:"@odata.type"`
+	ID               string     `json:"id"`
+	DisplayName      string     `json:"displayName"`
+	ReleaseDateTime  *time.Time `json:"releaseDateTime,omitempty"`
+	EndOfSupportDate *time.Time `json:"endOfSupportDate,omitempty"`
+
+	// Fields for windowsFeatureUpdateCatalogItem
+	Version string `json:"version,omitempty"`
+
+	// Fields for windowsQualityUpdateCatalogItem
+	KbArticleID          string `json:"kbArticleId,omitempty"`
+	Classification       string `json:"classification,omitempty"`
+	IsExpeditable        bool   `json:"isExpeditable,omitempty"`
+	QualityUpdateCadence string `json:"qualityUpdateCadence,omitempty"`
+}
+
+// WindowsUpdateDeviceState represents a row of the Windows Update device state reports, the state of a feature
+// or quality update profile on a device.
+type WindowsUpdateDeviceState struct {
+	PolicyID                            string     `report:"PolicyId"`
+	PolicyName                          string     `report:"PolicyName"`
+	DeviceID                            string     `report:"DeviceId"`
+	AzureADDeviceID                     string     `report:"AADDeviceId"`
+	DeviceName                          string     `report:"DeviceName"`
+	UserPrincipalName                   string     `report:"UPN"`
+	UpdateCategory                      string     `report:"UpdateCategory"`
+	AggregateState                      string     `report:"AggregateState"`
+	CurrentDeviceUpdateStatus           string     `report:"CurrentDeviceUpdateStatus"`
+	CurrentDeviceUpdateSubstatus        string     `report:"CurrentDeviceUpdateSubstatus"`
+	LatestAlertMessage                  string     `report:"LatestAlertMessage"`
+	LastSuccessfulDeviceUpdateStateTime *time.Time `report:"LastSuccessfulDeviceUpdateStateTime"`
+	EventDateTime                       *time.Time `report:"EventDateTimeUTC"`
+}
+
+// GetWindowsUpdateCatalogItems retrieves the feature or quality updates that Windows update profiles can
+// deploy. odataType is ODataTypeWindowsFeatureUpdateCatalogItem or ODataTypeWindowsQualityUpdateCatalogItem;
+// an empty odataType returns both.
+func (c *Client) GetWindowsUpdateCatalogItems(odataType string) (*ResponseWindowsUpdateCatalogItemsList, error) {
+	endpoint := uriBetaWindowsUpdateCatalogItems
+	if odataType != "" {
+		endpoint = fmt.Sprintf("%s?$filter=isof('%s')", uriBetaWindowsUpdateCatalogItems, strings.TrimPrefix(odataType, "#"))
+	}
+
+	var items ResponseWindowsUpdateCatalogItemsList
+	for endpoint != "" {
+		var page ResponseWindowsUpdateCatalogItemsList
+		resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update catalog items", err)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if items.ODataContext == "" {
+			items.ODataContext = page.ODataContext
+		}
+		items.Value = append(items.Value, page.Value...)
+
+		endpoint = ""
+		if page.ODataNextLink != "" {
+			if endpoint, err = relativeGraphEndpoint(page.ODataNextLink); err != nil {
+				return nil, fmt.Errorf(shared.ErrorMsgFailedPaginatedGet, "windows update catalog items", err)
+			}
+		}
+	}
+
+	return &items, nil
+}
+
+// getWindowsUpdateProfileAssignments retrieves the assignments of a Windows update ring or profile.
+func (c *Client) getWindowsUpdateProfileAssignments(resourceTypeURI, resourceName, profileID string) (*ResponseWindowsUpdateProfileAssignmentsList, error) {
+	endpoint := fmt.Sprintf("%s/%s/assignments", resourceTypeURI, profileID)
+
+	var assignments ResponseWindowsUpdateProfileAssignmentsList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" assignments", profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &assignments, nil
+}
+
+// assignWindowsUpdateProfile assigns a Windows update ring or profile using the assign action. The supplied
+// assignments replace any existing assignments of the profile.
+func (c *Client) assignWindowsUpdateProfile(resourceTypeURI, resourceName, profileID, assignmentODataType string, assignment *AssignmentWindowsUpdateProfile) error {
+	endpoint := fmt.Sprintf("%s/%s/assign", resourceTypeURI, profileID)
+
+	// Set graph metadata values
+	for i := range assignment.Assignments {
+		assignment.Assignments[i].ODataType = assignmentODataType
+	}
+	if assignment.Assignments == nil {
+		assignment.Assignments = []WindowsUpdateProfileAssignment{}
+	}
+
+	resp, err := c.HTTP.DoRequest("POST", endpoint, assignment, nil)
+	if err != nil {
+		return fmt.Errorf(shared.ErrorMsgFailedAssign, resourceName, profileID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// getWindowsUpdateDeviceStates exports a Windows Update device state report filtered to a profile and decodes
+// its rows.
+func (c *Client) getWindowsUpdateDeviceStates(reportName, resourceName, profileID string, options *ReportExportJobOptions) ([]WindowsUpdateDeviceState, error) {
+	iterator, err := c.ExportReport(&ResourceDeviceManagementExportJob{
+		ReportName: reportName,
+		Filter:     fmt.Sprintf("(PolicyId eq '%s')", odataEscapeString(profileID)),
+		Select:     windowsUpdateDeviceStateReportColumns,
+	}, options)
+	if err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" device states", profileID, err)
+	}
+	defer iterator.Close()
+
+	var states []WindowsUpdateDeviceState
+	for iterator.Next() {
+		var state WindowsUpdateDeviceState
+		if err := iterator.Decode(&state); err != nil {
+			return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" device states", profileID, err)
+		}
+		states = append(states, state)
+	}
+
+	if err := iterator.Err(); err != nil {
+		return nil, fmt.Errorf(shared.ErrorMsgFailedGetByID, resourceName+" device states", profileID, err)
+	}
+
+	return states, nil
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // UnmarshalJSON is a custom unmarshaler for DynamicValue, allowing it to
@@ -60,6 +62,10 @@ func (c *Client) GetDecryptedOmaSetting(baseURL, profileId, secretReferenceValue
 		return "", fmt.Errorf("failed to get decrypted OMA setting: %v", err)
 	}
 
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
 	// Check if the HTTP request was successful
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("HTTP request failed with status code: %d", resp.StatusCode)
@@ -67,3 +73,39 @@ func (c *Client) GetDecryptedOmaSetting(baseURL, profileId, secretReferenceValue
 
 	return decryptedValue.Value, nil
 }
+
+// relativeGraphEndpoint converts an absolute @odata.nextLink URL returned by a paged Graph collection into
+// the path and query form expected by the HTTP client, which prepends the Graph base domain itself.
+func relativeGraphEndpoint(nextLink string) (string, error) {
+	parsed, err := url.Parse(nextLink)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next link %s: %v", nextLink, err)
+	}
+
+	if parsed.RawQuery == "" {
+		return parsed.EscapedPath(), nil
+	}
+
+	return parsed.EscapedPath() + "?" + parsed.RawQuery, nil
+}
+
+// graphResourceURL returns the absolute URL of a Graph resource path, as required by @odata.bind and @odata.id
+// references, using the base domain configured on the HTTP client so that national clouds are honoured.
+// Empty values and values that are already absolute are returned unchanged.
+func (c *Client) graphResourceURL(endpointPath string) string {
+	if !strings.HasPrefix(endpointPath, "/") {
+		return endpointPath
+	}
+
+	return c.HTTP.APIHandler.ConstructAPIResourceEndpoint(endpointPath, c.HTTP.Logger)
+}
+
+// Bool returns a pointer to v, for setting the optional boolean fields of request structs.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for setting the optional integer fields of request structs.
+func Int(v int) *int {
+	return &v
+}
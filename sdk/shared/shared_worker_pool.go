@@ -0,0 +1,82 @@
+// shared_worker_pool.go
+package shared
+
+import (
+	"errors"
+	"sync"
+)
+
+// RunWorkerPool calls task once for each index in [0, count) using at most concurrency goroutines and returns
+// the results in index order, regardless of the order in which the tasks complete.
+//
+// The first failing task stops further tasks from being started; tasks already running are allowed to finish,
+// as requests cannot be interrupted. All errors returned by tasks are aggregated with errors.Join, in index
+// order, and no results are returned when any task fails.
+func RunWorkerPool[T any](concurrency, count int, task func(index int) (T, error)) ([]T, error) {
+	results := make([]T, count)
+	if count == 0 {
+		return results, nil
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > count {
+		concurrency = count
+	}
+
+	failed := make(chan struct{})
+	var failOnce sync.Once
+
+	errs := make([]error, count)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				result, err := task(index)
+				if err != nil {
+					errs[index] = err
+					failOnce.Do(func() { close(failed) })
+					continue
+				}
+				results[index] = result
+			}
+		}()
+	}
+
+dispatch:
+	for index := 0; index < count; index++ {
+		select {
+		case <-failed:
+			break dispatch
+		default:
+		}
+		select {
+		case indexes <- index:
+		case <-failed:
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// RunConcurrently runs independent tasks using at most concurrency goroutines, with the same failure and
+// error aggregation behaviour as RunWorkerPool. It suits fetching several unrelated parts of a resource at once.
+func RunConcurrently(concurrency int, tasks ...func() error) error {
+	_, err := RunWorkerPool(concurrency, len(tasks), func(index int) (struct{}, error) {
+		return struct{}{}, tasks[index]()
+	})
+
+	return err
+}